@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"ocitaskprovider"
 
@@ -17,12 +18,17 @@ import (
 func main() {
 	log.Println("OCI Task Management Service Terraform Provider Start")
 
+	prov := ocitaskprovider.MakeOciTaskServProvider()
+
 	plugin.Serve(&plugin.ServeOpts{
 		ProviderFunc: func() *schema.Provider {
-			prov := ocitaskprovider.MakeOciTaskServProvider()
 			return prov.Provider()
 		},
 	})
 
+	if err := prov.Shutdown(context.Background()); err != nil {
+		log.Printf("Failed to shut down provider - error=%s", err)
+	}
+
 	log.Println("All Done")
 }
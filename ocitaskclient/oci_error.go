@@ -2,8 +2,6 @@ package ocitaskclient
 
 import (
 	"encoding/json"
-	"fmt"
-	"log"
 )
 
 /**
@@ -22,9 +20,7 @@ type OciError struct {
 func (ociError *OciError) Serialize() (string, error) {
 	result := ""
 	data, err := json.Marshal(ociError)
-	if err != nil {
-		log.Println(fmt.Sprintf("Failed to serialize OciError - error=%s", err))
-	} else {
+	if err == nil {
 		result = string(data)
 	}
 
@@ -37,10 +33,5 @@ func (ociError *OciError) Serialize() (string, error) {
  * @return Instance of error if failed
  */
 func (ociError *OciError) Deserialize(data []byte) error {
-	err := json.Unmarshal(data, ociError)
-	if err != nil {
-		log.Println(fmt.Sprintf("Failed to deserialize OciError - error=%s", err))
-	}
-
-	return err
+	return json.Unmarshal(data, ociError)
 }
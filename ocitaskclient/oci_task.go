@@ -2,8 +2,6 @@ package ocitaskclient
 
 import (
 	"encoding/json"
-	"fmt"
-	"log"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -13,15 +11,27 @@ import (
  * @brief Container for Task resource in OCI Task System
  */
 type OciTask struct {
-	Id          *int64  `json:"id,omitempty"`
-	Title       *string `json:"title,omitempty"`
-	Description *string `json:"description,omitempty"`
-	Priority    *int    `json:"priority,omitempty"`
-	Completed   *bool   `json:"completed,omitempty"`
-	StartDate   *int64  `json:"startDate,omitempty"`
-	DueDate     *int64  `json:"dueDate,omitempty"`
-	TimeUpdated *int64  `json:"timeUpdated,omitempty"`
-	TimeCreated *int64  `json:"timeCreated,omitempty"`
+	Id              *int64                 `json:"id,omitempty"`
+	Title           *string                `json:"title,omitempty"`
+	Description     *string                `json:"description,omitempty"`
+	Priority        *int                   `json:"priority,omitempty"`
+	Completed       *bool                  `json:"completed,omitempty"`
+	Status          *string                `json:"status,omitempty"`
+	StatusChangedAt *int64                 `json:"statusChangedAt,omitempty"`
+	StartDate       *int64                 `json:"startDate,omitempty"`
+	DueDate         *int64                 `json:"dueDate,omitempty"`
+	TimeUpdated     *int64                 `json:"timeUpdated,omitempty"`
+	TimeCreated     *int64                 `json:"timeCreated,omitempty"`
+	Tags            map[string]string      `json:"tags,omitempty"`
+	ParentId        *int64                 `json:"parentId,omitempty"`
+	ProjectId       *int64                 `json:"projectId,omitempty"`
+	Assignee        *string                `json:"assignee,omitempty"`
+	Watchers        []string               `json:"watchers,omitempty"`
+	EstimateMinutes *int64                 `json:"estimateMinutes,omitempty"`
+	Checklist       []OciTaskChecklistItem `json:"checklist,omitempty"`
+	CustomFields    map[string]string      `json:"customFields,omitempty"`
+	Archived        *bool                  `json:"archived,omitempty"`
+	TimeArchived    *int64                 `json:"timeArchived,omitempty"`
 }
 
 /**
@@ -41,6 +51,27 @@ func FlattenOciTask(srcTask *OciTask) ([]interface{}, diag.Diagnostics) {
 		destTask["description"] = srcTask.Description
 		destTask["priority"] = srcTask.Priority
 		destTask["completed"] = srcTask.Completed
+		destTask["status"] = srcTask.GetStatus()
+		destTask["status_changed_at"] = formatOciTaskTimestamp(srcTask.StatusChangedAt)
+		destTask["tags"] = FlattenOciTaskStringMap(srcTask.Tags)
+		destTask["custom_fields"] = FlattenOciTaskStringMap(srcTask.CustomFields)
+		destTask["parent_id"] = 0
+		if srcTask.ParentId != nil {
+			destTask["parent_id"] = int(*srcTask.ParentId)
+		}
+		destTask["project_id"] = 0
+		if srcTask.ProjectId != nil {
+			destTask["project_id"] = int(*srcTask.ProjectId)
+		}
+		destTask["estimate_minutes"] = 0
+		if srcTask.EstimateMinutes != nil {
+			destTask["estimate_minutes"] = int(*srcTask.EstimateMinutes)
+		}
+		destTask["assignee"] = ""
+		if srcTask.Assignee != nil {
+			destTask["assignee"] = *srcTask.Assignee
+		}
+		destTask["watchers"] = FlattenOciTaskStringList(NormalizeOciTaskUserEmails(srcTask.Watchers))
 
 		startDate := time.UnixMilli(*srcTask.StartDate)
 		destTask["start_date"] = startDate.Format("yyyy-MM-dd")
@@ -66,6 +97,34 @@ func FlattenOciTask(srcTask *OciTask) ([]interface{}, diag.Diagnostics) {
 	return items, diags
 }
 
+/**
+ * @brief Getter function for status. Falls back to completed flag for Tasks stored before statuses existed.
+ * @return Status of Task
+ */
+func (ociTask *OciTask) GetStatus() string {
+	if ociTask.Status != nil && *ociTask.Status != "" {
+		return *ociTask.Status
+	}
+
+	return OciTaskStatusFromCompleted(ociTask.Completed != nil && *ociTask.Completed)
+}
+
+/**
+ * @brief Check if Task is archived. Archived Tasks keep their history and can be restored.
+ * @return True if Task is archived
+ */
+func (ociTask *OciTask) IsArchived() bool {
+	return ociTask.Archived != nil && *ociTask.Archived
+}
+
+/**
+ * @brief Getter function for time Task was archived
+ * @return Time Task was archived in RFC3339 format, empty if Task is not archived
+ */
+func (ociTask *OciTask) GetTimeArchived() string {
+	return formatOciTaskTimestamp(ociTask.TimeArchived)
+}
+
 /**
  * @brief Convert OciTask object into JSON String
  * @return JSON String equivalent to OciTask object if succeeded
@@ -74,9 +133,7 @@ func FlattenOciTask(srcTask *OciTask) ([]interface{}, diag.Diagnostics) {
 func (ociTask *OciTask) Serialize() (string, error) {
 	result := ""
 	data, err := json.Marshal(ociTask)
-	if err != nil {
-		log.Println(fmt.Sprintf("Failed to serialize OciTask - error=%s", err))
-	} else {
+	if err == nil {
 		result = string(data)
 	}
 
@@ -89,10 +146,5 @@ func (ociTask *OciTask) Serialize() (string, error) {
  * @return Instance of error if failed
  */
 func (ociTask *OciTask) Deserialize(data []byte) error {
-	err := json.Unmarshal(data, ociTask)
-	if err != nil {
-		log.Println(fmt.Sprintf("Failed to deserialize OciTask - error=%s", err))
-	}
-
-	return err
+	return json.Unmarshal(data, ociTask)
 }
@@ -0,0 +1,93 @@
+package ocitaskclient
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+)
+
+/**
+ * @brief Container for file attached to Task in OCI Task System. Content itself is transferred separately.
+ */
+type OciTaskAttachment struct {
+	Id          *int64  `json:"id,omitempty"`
+	TaskId      *int64  `json:"taskId,omitempty"`
+	FileName    *string `json:"fileName,omitempty"`
+	ContentType *string `json:"contentType,omitempty"`
+	Size        *int64  `json:"size,omitempty"`
+	Sha256      *string `json:"sha256,omitempty"`
+	TimeCreated *int64  `json:"timeCreated,omitempty"`
+}
+
+/**
+ * @brief Convert OciTaskAttachment instance into generic map for Terraform resource data
+ * @return Generic map equivalent to OciTaskAttachment. Timestamps are in RFC3339 format, empty if not set.
+ */
+func (ociTaskAttachment *OciTaskAttachment) Flatten() map[string]interface{} {
+	result := make(map[string]interface{})
+	result["file_name"] = ""
+	if ociTaskAttachment.FileName != nil {
+		result["file_name"] = *ociTaskAttachment.FileName
+	}
+
+	result["content_type"] = ""
+	if ociTaskAttachment.ContentType != nil {
+		result["content_type"] = *ociTaskAttachment.ContentType
+	}
+
+	result["size"] = 0
+	if ociTaskAttachment.Size != nil {
+		result["size"] = int(*ociTaskAttachment.Size)
+	}
+
+	result["sha256"] = ""
+	if ociTaskAttachment.Sha256 != nil {
+		result["sha256"] = *ociTaskAttachment.Sha256
+	}
+
+	result["time_created"] = formatOciTaskTimestamp(ociTaskAttachment.TimeCreated)
+
+	return result
+}
+
+/**
+ * @brief Convert OciTaskAttachment object into JSON String
+ * @return JSON String equivalent to OciTaskAttachment object if succeeded
+ * @return Instance of error if failed
+ */
+func (ociTaskAttachment *OciTaskAttachment) Serialize() (string, error) {
+	result := ""
+	data, err := json.Marshal(ociTaskAttachment)
+	if err == nil {
+		result = string(data)
+	}
+
+	return result, err
+}
+
+/**
+ * @brief Convert JSON String into OciTaskAttachment object
+ * @param data JSON String equivalent to OciTaskAttachment object
+ * @return Instance of error if failed
+ */
+func (ociTaskAttachment *OciTaskAttachment) Deserialize(data []byte) error {
+	return json.Unmarshal(data, ociTaskAttachment)
+}
+
+/**
+ * @brief Compute SHA-256 digest of attachment content without holding it in memory
+ * @param content Attachment content
+ * @return Hex encoded SHA-256 digest
+ * @return Number of bytes read
+ * @return Instance of error if content couldn't be read
+ */
+func HashOciTaskAttachment(content io.Reader) (string, int64, error) {
+	hash := sha256.New()
+	size, err := io.Copy(hash, content)
+	if err != nil {
+		return "", size, err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), size, nil
+}
@@ -0,0 +1,50 @@
+package ocitaskclient
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOciTaskAttachmentFlatten(test *testing.T) {
+	fileName := "notes.txt"
+	contentType := "text/plain"
+	size := int64(12)
+	digest := "abc123"
+	timeCreated := time.Date(2023, 2, 11, 10, 30, 0, 0, time.UTC).UnixMilli()
+	attachment := OciTaskAttachment{FileName: &fileName, ContentType: &contentType, Size: &size, Sha256: &digest, TimeCreated: &timeCreated}
+
+	data := attachment.Flatten()
+
+	assert.Equal(test, "notes.txt", data["file_name"], "TestOciTaskAttachmentFlatten Failed: Wrong File Name")
+	assert.Equal(test, "text/plain", data["content_type"], "TestOciTaskAttachmentFlatten Failed: Wrong Content Type")
+	assert.Equal(test, 12, data["size"], "TestOciTaskAttachmentFlatten Failed: Wrong Size")
+	assert.Equal(test, "abc123", data["sha256"], "TestOciTaskAttachmentFlatten Failed: Wrong Digest")
+	assert.Equal(test, "2023-02-11T10:30:00Z", data["time_created"], "TestOciTaskAttachmentFlatten Failed: Wrong Time Created")
+}
+
+func TestOciTaskAttachmentDeserializeFailed(test *testing.T) {
+	attachment := OciTaskAttachment{}
+
+	err := attachment.Deserialize([]byte("\"Test Error Message\""))
+
+	assert.Error(test, err, "TestOciTaskAttachmentDeserializeFailed Failed")
+}
+
+func TestHashOciTaskAttachment(test *testing.T) {
+	digest, size, err := HashOciTaskAttachment(strings.NewReader("Test Content"))
+
+	assert.NoError(test, err, "TestHashOciTaskAttachment Failed: No error expected")
+	assert.Equal(test, int64(12), size, "TestHashOciTaskAttachment Failed: Wrong Size")
+	assert.Equal(test, "60c9b75f15144a088fd7800e1049c6c80a92e76de588c2b21b30ff42f6694ce2", digest, "TestHashOciTaskAttachment Failed: Wrong Digest")
+}
+
+func TestHashOciTaskAttachmentFailedRead(test *testing.T) {
+	_, _, err := HashOciTaskAttachment(iotest.ErrReader(errors.New("Read Failed")))
+
+	assert.Error(test, err, "TestHashOciTaskAttachmentFailedRead Failed: Error expected")
+}
@@ -0,0 +1,109 @@
+package ocitaskclient
+
+/**
+ * @brief Container for checklist item of Task in OCI Task System. Items without Identifier are added by OCI Task Service,
+ *			items with Identifier keep it, so moving or editing item doesn't replace it.
+ */
+type OciTaskChecklistItem struct {
+	Id   *int64  `json:"id,omitempty"`
+	Text *string `json:"text,omitempty"`
+	Done *bool   `json:"done,omitempty"`
+}
+
+/**
+ * @brief Convert OciTaskChecklistItem instance into generic map for Terraform resource data
+ * @return Generic map equivalent to OciTaskChecklistItem
+ */
+func (ociTaskChecklistItem *OciTaskChecklistItem) Flatten() map[string]interface{} {
+	result := make(map[string]interface{})
+	result["id"] = 0
+	if ociTaskChecklistItem.Id != nil {
+		result["id"] = int(*ociTaskChecklistItem.Id)
+	}
+
+	result["text"] = ""
+	if ociTaskChecklistItem.Text != nil {
+		result["text"] = *ociTaskChecklistItem.Text
+	}
+
+	result["done"] = ociTaskChecklistItem.Done != nil && *ociTaskChecklistItem.Done
+
+	return result
+}
+
+/**
+ * @brief Convert checklist of Task into generic list for Terraform resource data
+ * @param checklist Checklist items in order
+ * @return Generic list of maps equivalent to checklist items, same order
+ */
+func FlattenOciTaskChecklist(checklist []OciTaskChecklistItem) []interface{} {
+	result := make([]interface{}, 0, len(checklist))
+	for _, item := range checklist {
+		result = append(result, item.Flatten())
+	}
+
+	return result
+}
+
+/**
+ * @brief Compute share of checklist items which are done
+ * @param checklist Checklist items of Task
+ * @return Percentage of done items from 0 to 100, rounded down. 0 for empty checklist.
+ */
+func OciTaskChecklistProgress(checklist []OciTaskChecklistItem) int {
+	if len(checklist) == 0 {
+		return 0
+	}
+
+	done := 0
+	for _, item := range checklist {
+		if item.Done != nil && *item.Done {
+			done++
+		}
+	}
+
+	return done * 100 / len(checklist)
+}
+
+/**
+ * @brief Carry Identifiers of previous checklist items over to new checklist, so reordered or edited items keep identity.
+ *			Items are matched by text first, in order, so moved items keep their Identifier. Items left over are matched
+ *			by position, so item with edited text keeps its Identifier. Items left without match get none and are added as new.
+ * @param previous Checklist items with Identifiers, e.g. from last read
+ * @param checklist New checklist items, Identifiers are set in place
+ */
+func MatchOciTaskChecklist(previous []OciTaskChecklistItem, checklist []OciTaskChecklistItem) {
+	used := make([]bool, len(previous))
+	matched := make([]bool, len(checklist))
+
+	for i := range checklist {
+		checklist[i].Id = nil
+		for j := range previous {
+			if !used[j] && previous[j].Id != nil && ociTaskChecklistText(previous[j]) == ociTaskChecklistText(checklist[i]) {
+				checklist[i].Id = previous[j].Id
+				used[j], matched[i] = true, true
+				break
+			}
+		}
+	}
+
+	for i := range checklist {
+		if !matched[i] && i < len(previous) && !used[i] && previous[i].Id != nil {
+			checklist[i].Id = previous[i].Id
+			used[i], matched[i] = true, true
+		}
+	}
+}
+
+/**
+ * @brief Private method to get text of checklist item
+ * @param item Checklist item
+ * @return Text of item, empty if not set
+ */
+func ociTaskChecklistText(item OciTaskChecklistItem) string {
+	if item.Text == nil {
+		return ""
+	}
+
+	return *item.Text
+}
@@ -0,0 +1,73 @@
+package ocitaskclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func makeTestOciTaskChecklistItem(id int64, text string, done bool) OciTaskChecklistItem {
+	item := OciTaskChecklistItem{Text: &text, Done: &done}
+	if id != 0 {
+		item.Id = &id
+	}
+
+	return item
+}
+
+func TestOciTaskChecklistProgress(test *testing.T) {
+	checklist := []OciTaskChecklistItem{
+		makeTestOciTaskChecklistItem(1, "Write", true),
+		makeTestOciTaskChecklistItem(2, "Review", false),
+		makeTestOciTaskChecklistItem(3, "Merge", false),
+	}
+
+	assert.Equal(test, 33, OciTaskChecklistProgress(checklist), "TestOciTaskChecklistProgress Failed: Progress expected to round down")
+	assert.Equal(test, 0, OciTaskChecklistProgress(nil), "TestOciTaskChecklistProgress Failed: Empty checklist expected to have no progress")
+}
+
+func TestOciTaskChecklistItemFlatten(test *testing.T) {
+	item := makeTestOciTaskChecklistItem(7, "Write", true)
+
+	assert.Equal(test, map[string]interface{}{"id": 7, "text": "Write", "done": true}, item.Flatten(), "TestOciTaskChecklistItemFlatten Failed: Wrong flattened item")
+	assert.Equal(test, map[string]interface{}{"id": 0, "text": "", "done": false}, (&OciTaskChecklistItem{}).Flatten(), "TestOciTaskChecklistItemFlatten Failed: Defaults expected for empty item")
+}
+
+func TestMatchOciTaskChecklistReorder(test *testing.T) {
+	previous := []OciTaskChecklistItem{
+		makeTestOciTaskChecklistItem(1, "Write", true),
+		makeTestOciTaskChecklistItem(2, "Review", false),
+		makeTestOciTaskChecklistItem(3, "Merge", false),
+	}
+	checklist := []OciTaskChecklistItem{
+		makeTestOciTaskChecklistItem(0, "Merge", false),
+		makeTestOciTaskChecklistItem(0, "Write", true),
+		makeTestOciTaskChecklistItem(0, "Review", false),
+	}
+
+	MatchOciTaskChecklist(previous, checklist)
+
+	assert.Equal(test, int64(3), *checklist[0].Id, "TestMatchOciTaskChecklistReorder Failed: Moved item expected to keep Identifier")
+	assert.Equal(test, int64(1), *checklist[1].Id, "TestMatchOciTaskChecklistReorder Failed: Moved item expected to keep Identifier")
+	assert.Equal(test, int64(2), *checklist[2].Id, "TestMatchOciTaskChecklistReorder Failed: Moved item expected to keep Identifier")
+}
+
+func TestMatchOciTaskChecklistEditAndAdd(test *testing.T) {
+	previous := []OciTaskChecklistItem{
+		makeTestOciTaskChecklistItem(1, "Write", true),
+		makeTestOciTaskChecklistItem(2, "Review", false),
+	}
+	checklist := []OciTaskChecklistItem{
+		makeTestOciTaskChecklistItem(0, "Write", true),
+		makeTestOciTaskChecklistItem(0, "Review twice", false),
+		makeTestOciTaskChecklistItem(0, "Merge", false),
+		makeTestOciTaskChecklistItem(0, "Write", false),
+	}
+
+	MatchOciTaskChecklist(previous, checklist)
+
+	assert.Equal(test, int64(1), *checklist[0].Id, "TestMatchOciTaskChecklistEditAndAdd Failed: Unchanged item expected to keep Identifier")
+	assert.Equal(test, int64(2), *checklist[1].Id, "TestMatchOciTaskChecklistEditAndAdd Failed: Edited item expected to keep Identifier of its position")
+	assert.Nil(test, checklist[2].Id, "TestMatchOciTaskChecklistEditAndAdd Failed: Added item expected to have no Identifier")
+	assert.Nil(test, checklist[3].Id, "TestMatchOciTaskChecklistEditAndAdd Failed: Duplicate text expected to match only once")
+}
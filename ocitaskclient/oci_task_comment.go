@@ -0,0 +1,111 @@
+package ocitaskclient
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+)
+
+/**
+ * @brief Container for comment on Task in OCI Task System
+ */
+type OciTaskComment struct {
+	Id          *int64  `json:"id,omitempty"`
+	TaskId      *int64  `json:"taskId,omitempty"`
+	Author      *string `json:"author,omitempty"`
+	Body        *string `json:"body,omitempty"`
+	TimeCreated *int64  `json:"timeCreated,omitempty"`
+	TimeUpdated *int64  `json:"timeUpdated,omitempty"`
+}
+
+/**
+ * @brief Convert OciTaskComment instance into generic map for Terraform resource data
+ * @return Generic map equivalent to OciTaskComment. Timestamps are in RFC3339 format, empty if not set.
+ */
+func (ociTaskComment *OciTaskComment) Flatten() map[string]interface{} {
+	result := make(map[string]interface{})
+	result["id"] = 0
+	if ociTaskComment.Id != nil {
+		result["id"] = int(*ociTaskComment.Id)
+	}
+
+	result["author"] = ""
+	if ociTaskComment.Author != nil {
+		result["author"] = *ociTaskComment.Author
+	}
+
+	result["body"] = ""
+	if ociTaskComment.Body != nil {
+		result["body"] = *ociTaskComment.Body
+	}
+
+	result["time_created"] = formatOciTaskTimestamp(ociTaskComment.TimeCreated)
+	result["time_updated"] = formatOciTaskTimestamp(ociTaskComment.TimeUpdated)
+
+	return result
+}
+
+/**
+ * @brief Convert OciTaskComment object into JSON String
+ * @return JSON String equivalent to OciTaskComment object if succeeded
+ * @return Instance of error if failed
+ */
+func (ociTaskComment *OciTaskComment) Serialize() (string, error) {
+	result := ""
+	data, err := json.Marshal(ociTaskComment)
+	if err == nil {
+		result = string(data)
+	}
+
+	return result, err
+}
+
+/**
+ * @brief Convert JSON String into OciTaskComment object
+ * @param data JSON String equivalent to OciTaskComment object
+ * @return Instance of error if failed
+ */
+func (ociTaskComment *OciTaskComment) Deserialize(data []byte) error {
+	return json.Unmarshal(data, ociTaskComment)
+}
+
+/**
+ * @brief Sort comments into thread order: oldest first, ties broken by Identifier
+ * @param comments Comments of Task, sorted in place
+ */
+func SortOciTaskComments(comments []OciTaskComment) {
+	sort.SliceStable(comments, func(i, j int) bool {
+		left, right := int64(0), int64(0)
+		if comments[i].TimeCreated != nil {
+			left = *comments[i].TimeCreated
+		}
+		if comments[j].TimeCreated != nil {
+			right = *comments[j].TimeCreated
+		}
+		if left != right {
+			return left < right
+		}
+
+		leftId, rightId := int64(0), int64(0)
+		if comments[i].Id != nil {
+			leftId = *comments[i].Id
+		}
+		if comments[j].Id != nil {
+			rightId = *comments[j].Id
+		}
+		return leftId < rightId
+	})
+}
+
+/**
+ * @brief Format epoch milliseconds in RFC3339 format
+ * @param timestamp Epoch milliseconds
+ * @return Timestamp in RFC3339 format in UTC, empty if not set
+ */
+func formatOciTaskTimestamp(timestamp *int64) string {
+	if timestamp == nil {
+		return ""
+	}
+
+	return time.UnixMilli(*timestamp).UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,45 @@
+package ocitaskclient
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func makeTestOciTaskComment(id int64, timeCreated int64) OciTaskComment {
+	body := "Test Comment"
+	return OciTaskComment{Id: &id, Body: &body, TimeCreated: &timeCreated}
+}
+
+func TestOciTaskCommentFlatten(test *testing.T) {
+	author := "jdoe@example.com"
+	comment := makeTestOciTaskComment(1, time.Date(2023, 2, 11, 10, 30, 0, 0, time.UTC).UnixMilli())
+	comment.Author = &author
+
+	data := comment.Flatten()
+
+	assert.Equal(test, 1, data["id"], "TestOciTaskCommentFlatten Failed: Wrong Comment Id")
+	assert.Equal(test, "jdoe@example.com", data["author"], "TestOciTaskCommentFlatten Failed: Wrong Comment Author")
+	assert.Equal(test, "Test Comment", data["body"], "TestOciTaskCommentFlatten Failed: Wrong Comment Body")
+	assert.Equal(test, "2023-02-11T10:30:00Z", data["time_created"], "TestOciTaskCommentFlatten Failed: Wrong Comment Time Created")
+	assert.Equal(test, "", data["time_updated"], "TestOciTaskCommentFlatten Failed: Empty Comment Time Updated expected")
+}
+
+func TestOciTaskCommentDeserializeFailed(test *testing.T) {
+	comment := OciTaskComment{}
+
+	err := comment.Deserialize([]byte("\"Test Error Message\""))
+
+	assert.Error(test, err, "TestOciTaskCommentDeserializeFailed Failed")
+}
+
+func TestSortOciTaskComments(test *testing.T) {
+	comments := []OciTaskComment{makeTestOciTaskComment(3, 2000), makeTestOciTaskComment(2, 1000), makeTestOciTaskComment(1, 2000)}
+
+	SortOciTaskComments(comments)
+
+	assert.Equal(test, int64(2), *comments[0].Id, "TestSortOciTaskComments Failed: Oldest comment expected first")
+	assert.Equal(test, int64(1), *comments[1].Id, "TestSortOciTaskComments Failed: Ties expected in Id order")
+	assert.Equal(test, int64(3), *comments[2].Id, "TestSortOciTaskComments Failed: Newest comment expected last")
+}
@@ -0,0 +1,228 @@
+package ocitaskclient
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/**
+ * @brief Number of years searched for next match before cron expression is considered to never match, e.g. 0 0 30 2 *
+ */
+const ociTaskCronMaxYears int = 5
+
+var ociTaskCronMacros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var ociTaskCronMonthNames = []string{"JAN", "FEB", "MAR", "APR", "MAY", "JUN", "JUL", "AUG", "SEP", "OCT", "NOV", "DEC"}
+
+var ociTaskCronWeekdayNames = []string{"SUN", "MON", "TUE", "WED", "THU", "FRI", "SAT"}
+
+/**
+ * @brief Field of cron expression with range of allowed values
+ */
+type ociTaskCronField struct {
+	name  string
+	min   int
+	max   int
+	names []string // Names of values starting at min, e.g. JAN for 1
+}
+
+var ociTaskCronFields = []ociTaskCronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: ociTaskCronMonthNames},
+	{name: "day of week", min: 0, max: 7, names: ociTaskCronWeekdayNames},
+}
+
+/**
+ * @brief Parsed cron expression in standard five field format: minute hour day-of-month month day-of-week.
+ *			Supports *, lists, ranges, steps, month and weekday names and macros such as @daily.
+ *			Like Vixie cron, a day matches either day field if both are restricted.
+ */
+type OciTaskCron struct {
+	minutes    uint64
+	hours      uint64
+	days       uint64
+	months     uint64
+	weekdays   uint64
+	anyDay     bool
+	anyWeekday bool
+}
+
+/**
+ * @brief Parse cron expression
+ * @param expression Cron expression, e.g. 30 9 * * MON-FRI or @weekly
+ * @return Instance of OciTaskCron if succeeded
+ * @return Instance of error if expression is malformed
+ */
+func ParseOciTaskCron(expression string) (*OciTaskCron, error) {
+	expression = strings.TrimSpace(expression)
+	if macro, ok := ociTaskCronMacros[strings.ToLower(expression)]; ok {
+		expression = macro
+	}
+
+	fields := strings.Fields(expression)
+	if len(fields) != len(ociTaskCronFields) {
+		return nil, fmt.Errorf("Invalid cron expression %q - expected 5 fields: minute hour day-of-month month day-of-week", expression)
+	}
+
+	values := make([]uint64, len(fields))
+	for i, field := range fields {
+		bits, err := parseOciTaskCronField(field, ociTaskCronFields[i])
+		if err != nil {
+			return nil, fmt.Errorf("Invalid cron expression %q: %w", expression, err)
+		}
+		values[i] = bits
+	}
+
+	// Sunday may be written as 0 or 7
+	weekdays := values[4]
+	if weekdays&(1<<7) != 0 {
+		weekdays = (weekdays | 1) &^ (1 << 7)
+	}
+
+	return &OciTaskCron{
+		minutes:    values[0],
+		hours:      values[1],
+		days:       values[2],
+		months:     values[3],
+		weekdays:   weekdays,
+		anyDay:     fields[2] == "*",
+		anyWeekday: fields[4] == "*",
+	}, nil
+}
+
+/**
+ * @brief Compute next time cron expression matches
+ * @param after Matches at or before this time are skipped. Result is computed in its time zone.
+ * @return Next matching time, truncated to minute
+ * @return False if expression doesn't match within the next years
+ */
+func (ociTaskCron *OciTaskCron) Next(after time.Time) (time.Time, bool) {
+	location := after.Location()
+	next := after.Truncate(time.Minute).Add(time.Minute)
+	limit := next.Year() + ociTaskCronMaxYears
+
+	for next.Year() <= limit {
+		if ociTaskCron.months&(1<<uint(next.Month())) == 0 {
+			next = time.Date(next.Year(), next.Month()+1, 1, 0, 0, 0, 0, location)
+			continue
+		}
+		if !ociTaskCron.matchesDay(next) {
+			next = time.Date(next.Year(), next.Month(), next.Day()+1, 0, 0, 0, 0, location)
+			continue
+		}
+		if ociTaskCron.hours&(1<<uint(next.Hour())) == 0 {
+			next = time.Date(next.Year(), next.Month(), next.Day(), next.Hour()+1, 0, 0, 0, location)
+			continue
+		}
+		if ociTaskCron.minutes&(1<<uint(next.Minute())) == 0 {
+			next = next.Add(time.Minute)
+			continue
+		}
+
+		return next, true
+	}
+
+	return time.Time{}, false
+}
+
+/**
+ * @brief Private method to check day of month and day of week fields
+ * @param day Day to check
+ * @return True if day matches
+ */
+func (ociTaskCron *OciTaskCron) matchesDay(day time.Time) bool {
+	dayMatches := ociTaskCron.days&(1<<uint(day.Day())) != 0
+	weekdayMatches := ociTaskCron.weekdays&(1<<uint(day.Weekday())) != 0
+
+	if ociTaskCron.anyDay || ociTaskCron.anyWeekday {
+		return dayMatches && weekdayMatches
+	}
+
+	return dayMatches || weekdayMatches
+}
+
+/**
+ * @brief Parse field of cron expression into bit set of allowed values
+ * @param value Field of cron expression, e.g. 1-5, 0-30/15 or MON,WED
+ * @param field Description of field
+ * @return Bit set with bit n set if value n is allowed
+ * @return Instance of error if field is malformed
+ */
+func parseOciTaskCronField(value string, field ociTaskCronField) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(value, ",") {
+		rangeValue, stepValue, hasStep := strings.Cut(item, "/")
+
+		step := 1
+		if hasStep {
+			number, err := strconv.Atoi(stepValue)
+			if err != nil || number <= 0 {
+				return 0, fmt.Errorf("%s step %q is not a positive number", field.name, stepValue)
+			}
+			step = number
+		}
+
+		var low, high int
+		if rangeValue == "*" {
+			low, high = field.min, field.max
+		} else {
+			lowValue, highValue, isRange := strings.Cut(rangeValue, "-")
+			number, err := parseOciTaskCronValue(lowValue, field)
+			if err != nil {
+				return 0, err
+			}
+			low, high = number, number
+			if isRange {
+				high, err = parseOciTaskCronValue(highValue, field)
+				if err != nil {
+					return 0, err
+				}
+			} else if hasStep {
+				high = field.max
+			}
+			if low > high {
+				return 0, fmt.Errorf("%s range %q is reversed", field.name, rangeValue)
+			}
+		}
+
+		for number := low; number <= high; number += step {
+			bits |= 1 << uint(number)
+		}
+	}
+
+	return bits, nil
+}
+
+/**
+ * @brief Parse single value of cron expression field, given as number or name
+ * @param value Value, e.g. 5 or FRI
+ * @param field Description of field
+ * @return Parsed value
+ * @return Instance of error if value is malformed or out of range
+ */
+func parseOciTaskCronValue(value string, field ociTaskCronField) (int, error) {
+	for i, name := range field.names {
+		if strings.EqualFold(value, name) {
+			return field.min + i, nil
+		}
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil || number < field.min || number > field.max {
+		return 0, fmt.Errorf("%s value %q out of range %d-%d", field.name, value, field.min, field.max)
+	}
+
+	return number, nil
+}
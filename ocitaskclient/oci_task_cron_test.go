@@ -0,0 +1,70 @@
+package ocitaskclient
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseOciTaskCronSuccess(test *testing.T) {
+	expressions := []string{"* * * * *", "*/15 9-17 * * MON-FRI", "0 0 1,15 * *", "30 6 * JAN-MAR 7", "@daily", "@Weekly", "0 12 L * *"}
+	valid := []bool{true, true, true, true, true, true, false}
+
+	for i, expression := range expressions {
+		_, err := ParseOciTaskCron(expression)
+		assert.Equal(test, valid[i], err == nil, "TestParseOciTaskCronSuccess Failed: Unexpected result for "+expression)
+	}
+}
+
+func TestParseOciTaskCronFailed(test *testing.T) {
+	expressions := []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8", "5-1 * * * *", "*/0 * * * *", "* * * FOO *"}
+
+	for _, expression := range expressions {
+		_, err := ParseOciTaskCron(expression)
+		assert.Error(test, err, "TestParseOciTaskCronFailed Failed: Error expected for "+expression)
+	}
+}
+
+func TestOciTaskCronNext(test *testing.T) {
+	// Wednesday
+	after := time.Date(2023, 3, 15, 10, 7, 30, 0, time.UTC)
+	cases := map[string]time.Time{
+		"* * * * *":              time.Date(2023, 3, 15, 10, 8, 0, 0, time.UTC),
+		"*/15 * * * *":           time.Date(2023, 3, 15, 10, 15, 0, 0, time.UTC),
+		"0 9 * * *":              time.Date(2023, 3, 16, 9, 0, 0, 0, time.UTC),
+		"0 9 * * MON":            time.Date(2023, 3, 20, 9, 0, 0, 0, time.UTC),
+		"0 0 * * 7":              time.Date(2023, 3, 19, 0, 0, 0, 0, time.UTC),
+		"@monthly":               time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC),
+		"0 0 29 2 *":             time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		"0 8 1 * FRI":            time.Date(2023, 3, 17, 8, 0, 0, 0, time.UTC),
+		"0 10-12/2 15 MAR-DEC *": time.Date(2023, 3, 15, 12, 0, 0, 0, time.UTC),
+	}
+
+	for expression, expected := range cases {
+		cron, err := ParseOciTaskCron(expression)
+		assert.NoError(test, err, "TestOciTaskCronNext Failed: No error expected for "+expression)
+
+		next, ok := cron.Next(after)
+		assert.True(test, ok, "TestOciTaskCronNext Failed: Match expected for "+expression)
+		assert.Equal(test, expected, next, "TestOciTaskCronNext Failed: Wrong next time for "+expression)
+	}
+}
+
+func TestOciTaskCronNextInTimeZone(test *testing.T) {
+	location, _ := time.LoadLocation("Europe/Berlin")
+	cron, _ := ParseOciTaskCron("0 9 * * *")
+
+	next, ok := cron.Next(time.Date(2023, 3, 15, 10, 0, 0, 0, time.UTC).In(location))
+
+	assert.True(test, ok, "TestOciTaskCronNextInTimeZone Failed: Match expected")
+	assert.Equal(test, time.Date(2023, 3, 16, 8, 0, 0, 0, time.UTC), next.UTC(), "TestOciTaskCronNextInTimeZone Failed: Next time expected in Berlin time")
+}
+
+func TestOciTaskCronNextNeverMatches(test *testing.T) {
+	cron, _ := ParseOciTaskCron("0 0 30 2 *")
+
+	_, ok := cron.Next(time.Date(2023, 3, 15, 10, 0, 0, 0, time.UTC))
+
+	assert.False(test, ok, "TestOciTaskCronNextNeverMatches Failed: No match expected")
+}
@@ -0,0 +1,222 @@
+package ocitaskclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+/**
+ * @brief Types of custom fields
+ */
+const (
+	OciTaskCustomFieldString string = "string"
+	OciTaskCustomFieldNumber string = "number"
+	OciTaskCustomFieldBool   string = "bool"
+	OciTaskCustomFieldEnum   string = "enum"
+	OciTaskCustomFieldDate   string = "date"
+)
+
+/**
+ * @brief Types of custom fields OCI Task Service supports
+ */
+var OciTaskCustomFieldTypes = []string{OciTaskCustomFieldString, OciTaskCustomFieldNumber, OciTaskCustomFieldBool, OciTaskCustomFieldEnum, OciTaskCustomFieldDate}
+
+/**
+ * @brief Container for custom field defined on Project in OCI Task System.
+ *			Tasks of the Project carry values of custom field as strings in the format of its type.
+ */
+type OciTaskCustomField struct {
+	Id          *int64   `json:"id,omitempty"`
+	ProjectId   *int64   `json:"projectId,omitempty"`
+	Name        *string  `json:"name,omitempty"`
+	Type        *string  `json:"type,omitempty"`
+	Options     []string `json:"options,omitempty"`
+	Required    *bool    `json:"required,omitempty"`
+	Description *string  `json:"description,omitempty"`
+}
+
+/**
+ * @brief Convert OciTaskCustomField instance into generic map for Terraform resource data
+ * @return Generic map equivalent to OciTaskCustomField
+ */
+func (ociTaskCustomField *OciTaskCustomField) Flatten() map[string]interface{} {
+	result := make(map[string]interface{})
+	result["name"] = ""
+	if ociTaskCustomField.Name != nil {
+		result["name"] = *ociTaskCustomField.Name
+	}
+
+	result["type"] = ""
+	if ociTaskCustomField.Type != nil {
+		result["type"] = *ociTaskCustomField.Type
+	}
+
+	result["options"] = FlattenOciTaskStringList(ociTaskCustomField.Options)
+	result["required"] = ociTaskCustomField.Required != nil && *ociTaskCustomField.Required
+
+	result["description"] = ""
+	if ociTaskCustomField.Description != nil {
+		result["description"] = *ociTaskCustomField.Description
+	}
+
+	return result
+}
+
+/**
+ * @brief Check value of custom field against its type
+ * @param value Value of custom field, e.g. 42 for number or 2023-03-15 for date
+ * @return Instance of error if value doesn't match type of custom field
+ */
+func (ociTaskCustomField *OciTaskCustomField) Validate(value string) error {
+	name, fieldType := "", ""
+	if ociTaskCustomField.Name != nil {
+		name = *ociTaskCustomField.Name
+	}
+	if ociTaskCustomField.Type != nil {
+		fieldType = *ociTaskCustomField.Type
+	}
+
+	switch fieldType {
+	case OciTaskCustomFieldString:
+		return nil
+	case OciTaskCustomFieldNumber:
+		if _, err := strconv.ParseFloat(value, 64); err != nil {
+			return fmt.Errorf("Invalid value %q of custom field %q - expected number", value, name)
+		}
+	case OciTaskCustomFieldBool:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("Invalid value %q of custom field %q - expected true or false", value, name)
+		}
+	case OciTaskCustomFieldEnum:
+		for _, option := range ociTaskCustomField.Options {
+			if option == value {
+				return nil
+			}
+		}
+		return fmt.Errorf("Invalid value %q of custom field %q - expected one of %s", value, name, strings.Join(ociTaskCustomField.Options, ", "))
+	case OciTaskCustomFieldDate:
+		if _, err := time.Parse(OciTaskTimeEntryDateFormat, value); err != nil {
+			return fmt.Errorf("Invalid value %q of custom field %q - expected date in YYYY-MM-DD format", value, name)
+		}
+	default:
+		return fmt.Errorf("Custom field %q has unknown type %q", name, fieldType)
+	}
+
+	return nil
+}
+
+/**
+ * @brief Convert OciTaskCustomField object into JSON String
+ * @return JSON String equivalent to OciTaskCustomField object if succeeded
+ * @return Instance of error if failed
+ */
+func (ociTaskCustomField *OciTaskCustomField) Serialize() (string, error) {
+	result := ""
+	data, err := json.Marshal(ociTaskCustomField)
+	if err == nil {
+		result = string(data)
+	}
+
+	return result, err
+}
+
+/**
+ * @brief Convert JSON String into OciTaskCustomField object
+ * @param data JSON String equivalent to OciTaskCustomField object
+ * @return Instance of error if failed
+ */
+func (ociTaskCustomField *OciTaskCustomField) Deserialize(data []byte) error {
+	return json.Unmarshal(data, ociTaskCustomField)
+}
+
+/**
+ * @brief Check values of custom fields of Task against custom fields defined on its Project.
+ *			Every value needs a definition and must match its type, every required field needs a value.
+ * @param definitions Custom fields defined on Project of Task
+ * @param values Values of custom fields by name
+ * @return Instance of error listing all violations, nil if values are valid
+ */
+func ValidateOciTaskCustomFields(definitions []OciTaskCustomField, values map[string]string) error {
+	return validateOciTaskCustomFields(definitions, values, true)
+}
+
+/**
+ * @brief Check values of custom fields of Task against those custom fields already defined on its Project.
+ *			Values without definition and missing required values are accepted, their definitions may still be pending,
+ *			e.g. when custom field and Task using it are planned together.
+ * @param definitions Custom fields defined on Project of Task so far
+ * @param values Values of custom fields by name
+ * @return Instance of error listing all violations, nil if values are valid
+ */
+func ValidateOciTaskDefinedCustomFields(definitions []OciTaskCustomField, values map[string]string) error {
+	return validateOciTaskCustomFields(definitions, values, false)
+}
+
+/**
+ * @brief Check values of custom fields of Task against custom fields defined on its Project
+ * @param definitions Custom fields defined on Project of Task
+ * @param values Values of custom fields by name
+ * @param complete True if definitions are final, so undefined and missing required values are violations too
+ * @return Instance of error listing all violations, nil if values are valid
+ */
+func validateOciTaskCustomFields(definitions []OciTaskCustomField, values map[string]string, complete bool) error {
+	byName := make(map[string]*OciTaskCustomField, len(definitions))
+	for i := range definitions {
+		if definitions[i].Name != nil {
+			byName[*definitions[i].Name] = &definitions[i]
+		}
+	}
+
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	problems := make([]string, 0)
+	for _, name := range names {
+		definition, ok := byName[name]
+		if !ok && complete {
+			problems = append(problems, fmt.Sprintf("Custom field %q is not defined on project", name))
+		} else if !ok {
+			continue
+		} else if err := definition.Validate(values[name]); err != nil {
+			problems = append(problems, err.Error())
+		}
+	}
+
+	for i := range definitions {
+		if complete && definitions[i].Name != nil && definitions[i].Required != nil && *definitions[i].Required {
+			if _, ok := values[*definitions[i].Name]; !ok {
+				problems = append(problems, fmt.Sprintf("Custom field %q is required", *definitions[i].Name))
+			}
+		}
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%s", strings.Join(problems, "; "))
+	}
+
+	return nil
+}
+
+/**
+ * @brief Sort custom fields by name
+ * @param customFields Custom fields of Project, sorted in place
+ */
+func SortOciTaskCustomFields(customFields []OciTaskCustomField) {
+	sort.SliceStable(customFields, func(i, j int) bool {
+		left, right := "", ""
+		if customFields[i].Name != nil {
+			left = *customFields[i].Name
+		}
+		if customFields[j].Name != nil {
+			right = *customFields[j].Name
+		}
+		return left < right
+	})
+}
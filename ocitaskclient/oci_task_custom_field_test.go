@@ -0,0 +1,96 @@
+package ocitaskclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func makeTestOciTaskCustomField(name string, fieldType string, required bool, options ...string) OciTaskCustomField {
+	return OciTaskCustomField{Name: &name, Type: &fieldType, Required: &required, Options: options}
+}
+
+func TestOciTaskCustomFieldValidate(test *testing.T) {
+	valid := map[string][]string{
+		OciTaskCustomFieldString: {"", "anything"},
+		OciTaskCustomFieldNumber: {"42", "-1.5"},
+		OciTaskCustomFieldBool:   {"true", "false"},
+		OciTaskCustomFieldEnum:   {"dev", "prod"},
+		OciTaskCustomFieldDate:   {"2023-03-15"},
+	}
+	invalid := map[string][]string{
+		OciTaskCustomFieldNumber: {"", "forty-two"},
+		OciTaskCustomFieldBool:   {"yes"},
+		OciTaskCustomFieldEnum:   {"staging", "Prod"},
+		OciTaskCustomFieldDate:   {"15.03.2023", "2023-02-30"},
+	}
+
+	for fieldType, values := range valid {
+		customField := makeTestOciTaskCustomField("field", fieldType, false, "dev", "prod")
+		for _, value := range values {
+			assert.NoError(test, customField.Validate(value), "TestOciTaskCustomFieldValidate Failed: %q expected to be valid %s", value, fieldType)
+		}
+	}
+
+	for fieldType, values := range invalid {
+		customField := makeTestOciTaskCustomField("field", fieldType, false, "dev", "prod")
+		for _, value := range values {
+			assert.Error(test, customField.Validate(value), "TestOciTaskCustomFieldValidate Failed: %q expected to be invalid %s", value, fieldType)
+		}
+	}
+
+	assert.Error(test, (&OciTaskCustomField{}).Validate("value"), "TestOciTaskCustomFieldValidate Failed: Error expected for unknown type")
+}
+
+func TestValidateOciTaskCustomFields(test *testing.T) {
+	definitions := []OciTaskCustomField{
+		makeTestOciTaskCustomField("cost_center", OciTaskCustomFieldString, true),
+		makeTestOciTaskCustomField("environment", OciTaskCustomFieldEnum, false, "dev", "prod"),
+	}
+
+	assert.NoError(test, ValidateOciTaskCustomFields(definitions, map[string]string{"cost_center": "CC-42", "environment": "prod"}), "TestValidateOciTaskCustomFields Failed: Values expected to be valid")
+
+	err := ValidateOciTaskCustomFields(definitions, map[string]string{"environment": "staging", "ticket_url": "https://example.com/1"})
+
+	assert.Error(test, err, "TestValidateOciTaskCustomFields Failed: Error expected")
+	assert.Contains(test, err.Error(), `"environment"`, "TestValidateOciTaskCustomFields Failed: Invalid enum value expected in error")
+	assert.Contains(test, err.Error(), `"ticket_url" is not defined`, "TestValidateOciTaskCustomFields Failed: Undefined field expected in error")
+	assert.Contains(test, err.Error(), `"cost_center" is required`, "TestValidateOciTaskCustomFields Failed: Missing required field expected in error")
+}
+
+func TestValidateOciTaskDefinedCustomFields(test *testing.T) {
+	definitions := []OciTaskCustomField{
+		makeTestOciTaskCustomField("cost_center", OciTaskCustomFieldString, true),
+		makeTestOciTaskCustomField("environment", OciTaskCustomFieldEnum, false, "dev", "prod"),
+	}
+
+	assert.NoError(test, ValidateOciTaskDefinedCustomFields(definitions, map[string]string{"ticket_url": "https://example.com/1"}), "TestValidateOciTaskDefinedCustomFields Failed: Undefined and missing required fields expected to be accepted")
+
+	err := ValidateOciTaskDefinedCustomFields(definitions, map[string]string{"environment": "staging", "ticket_url": "https://example.com/1"})
+
+	assert.Error(test, err, "TestValidateOciTaskDefinedCustomFields Failed: Error expected")
+	assert.Contains(test, err.Error(), `"environment"`, "TestValidateOciTaskDefinedCustomFields Failed: Invalid enum value expected in error")
+	assert.NotContains(test, err.Error(), "ticket_url", "TestValidateOciTaskDefinedCustomFields Failed: Undefined field not expected in error")
+}
+
+func TestOciTaskCustomFieldFlatten(test *testing.T) {
+	customField := makeTestOciTaskCustomField("environment", OciTaskCustomFieldEnum, true, "dev", "prod")
+
+	result := customField.Flatten()
+
+	assert.Equal(test, "environment", result["name"], "TestOciTaskCustomFieldFlatten Failed: Wrong name")
+	assert.Equal(test, []interface{}{"dev", "prod"}, result["options"], "TestOciTaskCustomFieldFlatten Failed: Wrong options")
+	assert.Equal(test, true, result["required"], "TestOciTaskCustomFieldFlatten Failed: Wrong required flag")
+	assert.Equal(test, "", result["description"], "TestOciTaskCustomFieldFlatten Failed: Empty description expected")
+}
+
+func TestSortOciTaskCustomFields(test *testing.T) {
+	customFields := []OciTaskCustomField{
+		makeTestOciTaskCustomField("ticket_url", OciTaskCustomFieldString, false),
+		makeTestOciTaskCustomField("cost_center", OciTaskCustomFieldString, false),
+	}
+
+	SortOciTaskCustomFields(customFields)
+
+	assert.Equal(test, "cost_center", *customFields[0].Name, "TestSortOciTaskCustomFields Failed: Custom fields expected in order of name")
+}
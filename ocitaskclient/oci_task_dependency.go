@@ -0,0 +1,68 @@
+package ocitaskclient
+
+import (
+	"encoding/json"
+)
+
+/**
+ * @brief Dependency types. Blocked Task of blocks dependency can't start before blocker Task is done.
+ *			relates_to dependency is informational and doesn't order Tasks.
+ */
+const (
+	OciTaskDependencyBlocks    string = "blocks"
+	OciTaskDependencyRelatesTo string = "relates_to"
+)
+
+/**
+ * @brief All valid dependency types
+ */
+var OciTaskDependencyTypes = []string{
+	OciTaskDependencyBlocks,
+	OciTaskDependencyRelatesTo,
+}
+
+/**
+ * @brief Container for dependency between two Tasks in OCI Task System
+ */
+type OciTaskDependency struct {
+	Id        *int64  `json:"id,omitempty"`
+	BlockerId *int64  `json:"blockerId,omitempty"`
+	BlockedId *int64  `json:"blockedId,omitempty"`
+	Type      *string `json:"type,omitempty"`
+}
+
+/**
+ * @brief Getter function for dependency type
+ * @return Dependency type, blocks if not set
+ */
+func (ociTaskDependency *OciTaskDependency) GetType() string {
+	if ociTaskDependency.Type == nil || *ociTaskDependency.Type == "" {
+		return OciTaskDependencyBlocks
+	}
+
+	return *ociTaskDependency.Type
+}
+
+/**
+ * @brief Convert OciTaskDependency object into JSON String
+ * @return JSON String equivalent to OciTaskDependency object if succeeded
+ * @return Instance of error if failed
+ */
+func (ociTaskDependency *OciTaskDependency) Serialize() (string, error) {
+	result := ""
+	data, err := json.Marshal(ociTaskDependency)
+	if err == nil {
+		result = string(data)
+	}
+
+	return result, err
+}
+
+/**
+ * @brief Convert JSON String into OciTaskDependency object
+ * @param data JSON String equivalent to OciTaskDependency object
+ * @return Instance of error if failed
+ */
+func (ociTaskDependency *OciTaskDependency) Deserialize(data []byte) error {
+	return json.Unmarshal(data, ociTaskDependency)
+}
@@ -0,0 +1,354 @@
+package ocitaskclient
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+/**
+ * @brief Directed graph of blocks dependencies. Edge leads from blocker Task to blocked Task.
+ */
+type OciTaskDependencyGraph struct {
+	nodes map[int64]bool
+	edges map[int64]map[int64]bool
+}
+
+/**
+ * @brief Constructor for OciTaskDependencyGraph
+ * @return Instance of OciTaskDependencyGraph without Tasks
+ */
+func MakeOciTaskDependencyGraph() *OciTaskDependencyGraph {
+	return &OciTaskDependencyGraph{
+		nodes: make(map[int64]bool),
+		edges: make(map[int64]map[int64]bool),
+	}
+}
+
+/**
+ * @brief Add Task to graph
+ * @param taskId Identifier of Task
+ */
+func (ociTaskDependencyGraph *OciTaskDependencyGraph) AddTask(taskId int64) {
+	ociTaskDependencyGraph.nodes[taskId] = true
+}
+
+/**
+ * @brief Add dependency to graph. Adds both Tasks, ignores dependencies which don't order Tasks.
+ * @param dependency Instance of OciTaskDependency
+ */
+func (ociTaskDependencyGraph *OciTaskDependencyGraph) AddDependency(dependency OciTaskDependency) {
+	if dependency.BlockerId == nil || dependency.BlockedId == nil || dependency.GetType() != OciTaskDependencyBlocks {
+		return
+	}
+
+	ociTaskDependencyGraph.AddEdge(*dependency.BlockerId, *dependency.BlockedId)
+}
+
+/**
+ * @brief Add edge from blocker Task to blocked Task. Adds both Tasks.
+ * @param blockerId Identifier of blocker Task
+ * @param blockedId Identifier of blocked Task
+ */
+func (ociTaskDependencyGraph *OciTaskDependencyGraph) AddEdge(blockerId int64, blockedId int64) {
+	ociTaskDependencyGraph.AddTask(blockerId)
+	ociTaskDependencyGraph.AddTask(blockedId)
+
+	if ociTaskDependencyGraph.edges[blockerId] == nil {
+		ociTaskDependencyGraph.edges[blockerId] = make(map[int64]bool)
+	}
+	ociTaskDependencyGraph.edges[blockerId][blockedId] = true
+}
+
+/**
+ * @brief Find path between two Tasks
+ * @param fromId Identifier of first Task
+ * @param toId Identifier of last Task
+ * @return Identifiers of Tasks on path from first to last Task, nil if last Task not reachable
+ */
+func (ociTaskDependencyGraph *OciTaskDependencyGraph) FindPath(fromId int64, toId int64) []int64 {
+	previous := map[int64]int64{fromId: fromId}
+	queue := []int64{fromId}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if current == toId {
+			path := []int64{toId}
+			for current != fromId {
+				current = previous[current]
+				path = append([]int64{current}, path...)
+			}
+			return path
+		}
+
+		for _, next := range ociTaskDependencyGraph.successors(current) {
+			if _, seen := previous[next]; !seen {
+				previous[next] = current
+				queue = append(queue, next)
+			}
+		}
+	}
+
+	return nil
+}
+
+/**
+ * @brief Order Tasks so that every blocker Task comes before Tasks it blocks.
+ *			Ties are broken by Task Identifier so result is stable.
+ * @return Identifiers of Tasks in topological order if succeeded
+ * @return Instance of error if graph contains cycle
+ */
+func (ociTaskDependencyGraph *OciTaskDependencyGraph) TopologicalOrder() ([]int64, error) {
+	inDegree := make(map[int64]int)
+	for taskId := range ociTaskDependencyGraph.nodes {
+		inDegree[taskId] += 0
+		for _, next := range ociTaskDependencyGraph.successors(taskId) {
+			inDegree[next]++
+		}
+	}
+
+	ready := make([]int64, 0)
+	for taskId, degree := range inDegree {
+		if degree == 0 {
+			ready = append(ready, taskId)
+		}
+	}
+
+	order := make([]int64, 0, len(ociTaskDependencyGraph.nodes))
+	for len(ready) > 0 {
+		sort.Slice(ready, func(i, j int) bool { return ready[i] < ready[j] })
+		current := ready[0]
+		ready = ready[1:]
+		order = append(order, current)
+
+		for _, next := range ociTaskDependencyGraph.successors(current) {
+			inDegree[next]--
+			if inDegree[next] == 0 {
+				ready = append(ready, next)
+			}
+		}
+	}
+
+	if len(order) != len(ociTaskDependencyGraph.nodes) {
+		return nil, fmt.Errorf("Dependency cycle detected between Tasks %v", ociTaskDependencyGraph.cycleMembers(inDegree))
+	}
+
+	return order, nil
+}
+
+/**
+ * @brief Find longest chain of blocking Tasks weighted by Task duration
+ * @param durations Duration of each Task, Tasks without duration count as 0
+ * @return Identifiers of Tasks on critical path in order if succeeded
+ * @return Total duration of critical path
+ * @return Instance of error if graph contains cycle
+ */
+func (ociTaskDependencyGraph *OciTaskDependencyGraph) CriticalPath(durations map[int64]int64) ([]int64, int64, error) {
+	order, err := ociTaskDependencyGraph.TopologicalOrder()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	finish := make(map[int64]int64)
+	previous := make(map[int64]int64)
+	for _, taskId := range order {
+		finish[taskId] += durations[taskId]
+		for _, next := range ociTaskDependencyGraph.successors(taskId) {
+			if _, ok := previous[next]; !ok || finish[taskId] > finish[next] {
+				finish[next] = finish[taskId]
+				previous[next] = taskId
+			}
+		}
+	}
+
+	var last int64
+	var total int64 = -1
+	for _, taskId := range order {
+		if finish[taskId] > total {
+			last = taskId
+			total = finish[taskId]
+		}
+	}
+
+	if total < 0 {
+		return []int64{}, 0, nil
+	}
+
+	path := []int64{last}
+	for {
+		prev, ok := previous[path[0]]
+		if !ok {
+			break
+		}
+		path = append([]int64{prev}, path...)
+	}
+
+	return path, total, nil
+}
+
+/**
+ * @brief Duration of Task used as weight of critical path
+ * @param ociTask Instance of OciTask
+ * @return Milliseconds between start date and due date, 0 if either is missing or due date is before start date
+ */
+func OciTaskDuration(ociTask *OciTask) int64 {
+	if ociTask == nil || ociTask.StartDate == nil || ociTask.DueDate == nil || *ociTask.DueDate < *ociTask.StartDate {
+		return 0
+	}
+
+	return *ociTask.DueDate - *ociTask.StartDate
+}
+
+/**
+ * @brief List Tasks blocked by Task in stable order
+ * @param taskId Identifier of blocker Task
+ * @return Identifiers of blocked Tasks
+ */
+func (ociTaskDependencyGraph *OciTaskDependencyGraph) successors(taskId int64) []int64 {
+	result := make([]int64, 0, len(ociTaskDependencyGraph.edges[taskId]))
+	for next := range ociTaskDependencyGraph.edges[taskId] {
+		result = append(result, next)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+
+	return result
+}
+
+/**
+ * @brief List Tasks left with incoming edges after topological sort, i.e. Tasks on or behind a cycle
+ * @param inDegree Remaining count of incoming edges per Task
+ * @return Identifiers of Tasks in ascending order
+ */
+func (ociTaskDependencyGraph *OciTaskDependencyGraph) cycleMembers(inDegree map[int64]int) []int64 {
+	members := make([]int64, 0)
+	for taskId, degree := range inDegree {
+		if degree > 0 {
+			members = append(members, taskId)
+		}
+	}
+	sort.Slice(members, func(i, j int) bool { return members[i] < members[j] })
+
+	return members
+}
+
+/**
+ * @brief Error returned when blocks dependency closes a cycle
+ */
+type OciTaskDependencyCycleError struct {
+	BlockerId int64
+	BlockedId int64
+	Cycle     []int64
+}
+
+/**
+ * @brief Build error message
+ * @return Error message with Tasks forming the cycle
+ */
+func (ociTaskDependencyCycleError *OciTaskDependencyCycleError) Error() string {
+	return fmt.Sprintf("Dependency of Task %d on Task %d would create cycle %v", ociTaskDependencyCycleError.BlockedId, ociTaskDependencyCycleError.BlockerId, ociTaskDependencyCycleError.Cycle)
+}
+
+/**
+ * @brief Check if new blocks dependency would close a cycle with dependencies stored in OCI Task Service.
+ *			Walks Tasks blocked, directly or indirectly, by blocked Task and looks for blocker Task.
+ * @param ctx Context for logging and cancellation
+ * @param ociClient Client to OCI Task Service
+ * @param blockerId Identifier of blocker Task of new dependency
+ * @param blockedId Identifier of blocked Task of new dependency
+ * @return Instance of OciTaskDependencyCycleError if dependency would close a cycle, other error if dependencies can't be read
+ */
+func ValidateOciTaskDependency(ctx context.Context, ociClient OciTaskServClientInterface, blockerId int64, blockedId int64) error {
+	dependencyType := OciTaskDependencyBlocks
+	return ValidateOciTaskDependencies(ctx, ociClient, []OciTaskDependency{{BlockerId: &blockerId, BlockedId: &blockedId, Type: &dependencyType}})
+}
+
+/**
+ * @brief Check if set of new dependencies would close a cycle, among themselves or with dependencies stored in OCI Task Service.
+ *			Walks Tasks blocked, directly or indirectly, by blocked Tasks of new dependencies, following stored and new dependencies.
+ * @param ctx Context for logging and cancellation
+ * @param ociClient Client to OCI Task Service
+ * @param dependencies New dependencies, only blocks dependencies are checked
+ * @return Instance of OciTaskDependencyCycleError for first new dependency closing a cycle, other error if dependencies can't be read
+ */
+func ValidateOciTaskDependencies(ctx context.Context, ociClient OciTaskServClientInterface, dependencies []OciTaskDependency) error {
+	graph := MakeOciTaskDependencyGraph()
+	visited := make(map[int64]bool)
+	frontier := make([]int64, 0)
+
+	for _, dependency := range dependencies {
+		if dependency.BlockerId == nil || dependency.BlockedId == nil || dependency.GetType() != OciTaskDependencyBlocks {
+			continue
+		}
+
+		if *dependency.BlockerId == *dependency.BlockedId {
+			return fmt.Errorf("Task %d can't block itself", *dependency.BlockerId)
+		}
+
+		graph.AddDependency(dependency)
+		if !visited[*dependency.BlockedId] {
+			visited[*dependency.BlockedId] = true
+			frontier = append(frontier, *dependency.BlockedId)
+		}
+	}
+
+	for len(frontier) > 0 {
+		if err := findOciTaskDependencyCycle(graph, visited, dependencies); err != nil {
+			return err
+		}
+
+		ociResponse, err := ociClient.ListTaskDependencies(ctx, frontier)
+		if err != nil {
+			return err
+		}
+
+		err = ociTaskResponseError("ListTaskDependencies", ociResponse)
+		if err != nil {
+			return err
+		}
+
+		inFrontier := make(map[int64]bool)
+		for _, taskId := range frontier {
+			inFrontier[taskId] = true
+		}
+
+		frontier = nil
+		for _, dependency := range ociResponse.Dependencies {
+			if dependency.BlockerId == nil || dependency.BlockedId == nil || !inFrontier[*dependency.BlockerId] {
+				continue
+			}
+
+			graph.AddDependency(dependency)
+			if dependency.GetType() == OciTaskDependencyBlocks && !visited[*dependency.BlockedId] {
+				visited[*dependency.BlockedId] = true
+				frontier = append(frontier, *dependency.BlockedId)
+			}
+		}
+	}
+
+	return findOciTaskDependencyCycle(graph, visited, dependencies)
+}
+
+/**
+ * @brief Look for new blocks dependency whose blocker Task is reachable from its blocked Task
+ * @param graph Graph of new dependencies and stored dependencies walked so far
+ * @param visited Tasks reached from blocked Tasks of new dependencies so far
+ * @param dependencies New dependencies
+ * @return Instance of OciTaskDependencyCycleError for first new dependency closing a cycle, nil if none found
+ */
+func findOciTaskDependencyCycle(graph *OciTaskDependencyGraph, visited map[int64]bool, dependencies []OciTaskDependency) error {
+	for _, dependency := range dependencies {
+		if dependency.BlockerId == nil || dependency.BlockedId == nil || dependency.GetType() != OciTaskDependencyBlocks || !visited[*dependency.BlockerId] {
+			continue
+		}
+
+		blockerId, blockedId := *dependency.BlockerId, *dependency.BlockedId
+		if path := graph.FindPath(blockedId, blockerId); path != nil {
+			cycle := append([]int64{blockerId}, path...)
+			return &OciTaskDependencyCycleError{BlockerId: blockerId, BlockedId: blockedId, Cycle: cycle}
+		}
+	}
+
+	return nil
+}
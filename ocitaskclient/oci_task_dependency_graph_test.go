@@ -0,0 +1,165 @@
+package ocitaskclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func makeTestOciTaskDependency(blockerId int64, blockedId int64, dependencyType string) OciTaskDependency {
+	return OciTaskDependency{BlockerId: &blockerId, BlockedId: &blockedId, Type: &dependencyType}
+}
+
+func matchTaskIds(ids ...int64) interface{} {
+	return mock.MatchedBy(func(taskIds []int64) bool { return assert.ObjectsAreEqual(ids, taskIds) })
+}
+
+func TestOciTaskDependencyGraphTopologicalOrder(test *testing.T) {
+	graph := MakeOciTaskDependencyGraph()
+	graph.AddTask(5)
+	graph.AddDependency(makeTestOciTaskDependency(3, 1, OciTaskDependencyBlocks))
+	graph.AddDependency(makeTestOciTaskDependency(2, 1, OciTaskDependencyBlocks))
+	graph.AddDependency(makeTestOciTaskDependency(1, 4, OciTaskDependencyBlocks))
+	graph.AddDependency(makeTestOciTaskDependency(4, 2, OciTaskDependencyRelatesTo))
+
+	order, err := graph.TopologicalOrder()
+
+	assert.NoError(test, err, "TestOciTaskDependencyGraphTopologicalOrder Failed: No error expected")
+	assert.Equal(test, []int64{2, 3, 1, 4, 5}, order, "TestOciTaskDependencyGraphTopologicalOrder Failed: Wrong order")
+}
+
+func TestOciTaskDependencyGraphTopologicalOrderFailedCycle(test *testing.T) {
+	graph := MakeOciTaskDependencyGraph()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(2, 3)
+	graph.AddEdge(3, 1)
+	graph.AddEdge(4, 1)
+
+	order, err := graph.TopologicalOrder()
+
+	assert.Error(test, err, "TestOciTaskDependencyGraphTopologicalOrderFailedCycle Failed: Error expected")
+	assert.Contains(test, err.Error(), "[1 2 3]", "TestOciTaskDependencyGraphTopologicalOrderFailedCycle Failed: Wrong Tasks in cycle")
+	assert.Nil(test, order, "TestOciTaskDependencyGraphTopologicalOrderFailedCycle Failed: No order expected")
+}
+
+func TestOciTaskDependencyGraphCriticalPath(test *testing.T) {
+	graph := MakeOciTaskDependencyGraph()
+	graph.AddEdge(1, 2)
+	graph.AddEdge(1, 3)
+	graph.AddEdge(2, 4)
+	graph.AddEdge(3, 4)
+
+	path, total, err := graph.CriticalPath(map[int64]int64{1: 2, 2: 1, 3: 5, 4: 1})
+
+	assert.NoError(test, err, "TestOciTaskDependencyGraphCriticalPath Failed: No error expected")
+	assert.Equal(test, []int64{1, 3, 4}, path, "TestOciTaskDependencyGraphCriticalPath Failed: Wrong path")
+	assert.Equal(test, int64(8), total, "TestOciTaskDependencyGraphCriticalPath Failed: Wrong duration")
+}
+
+func TestOciTaskDependencyGraphCriticalPathEmpty(test *testing.T) {
+	path, total, err := MakeOciTaskDependencyGraph().CriticalPath(nil)
+
+	assert.NoError(test, err, "TestOciTaskDependencyGraphCriticalPathEmpty Failed: No error expected")
+	assert.Equal(test, 0, len(path), "TestOciTaskDependencyGraphCriticalPathEmpty Failed: Empty path expected")
+	assert.Equal(test, int64(0), total, "TestOciTaskDependencyGraphCriticalPathEmpty Failed: Zero duration expected")
+}
+
+func TestOciTaskDuration(test *testing.T) {
+	startDate := int64(1000)
+	dueDate := int64(5000)
+
+	assert.Equal(test, int64(4000), OciTaskDuration(&OciTask{StartDate: &startDate, DueDate: &dueDate}), "TestOciTaskDuration Failed: Wrong duration")
+	assert.Equal(test, int64(0), OciTaskDuration(&OciTask{StartDate: &dueDate, DueDate: &startDate}), "TestOciTaskDuration Failed: Negative duration not expected")
+	assert.Equal(test, int64(0), OciTaskDuration(&OciTask{StartDate: &startDate}), "TestOciTaskDuration Failed: Zero duration expected without due date")
+}
+
+func TestValidateOciTaskDependencySuccess(test *testing.T) {
+	ociTaskServClientMock := OciTaskServClientMock{}
+
+	ociTaskServClientMock.On("ListTaskDependencies", mock.Anything, matchTaskIds(2)).Return(&OciTaskServResponse{Dependencies: []OciTaskDependency{
+		makeTestOciTaskDependency(2, 3, OciTaskDependencyBlocks),
+		makeTestOciTaskDependency(2, 1, OciTaskDependencyRelatesTo),
+		makeTestOciTaskDependency(1, 2, OciTaskDependencyBlocks),
+	}}, nil).Once()
+	ociTaskServClientMock.On("ListTaskDependencies", mock.Anything, matchTaskIds(3)).Return(&OciTaskServResponse{}, nil).Once()
+
+	err := ValidateOciTaskDependency(context.Background(), &ociTaskServClientMock, 1, 2)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestValidateOciTaskDependencySuccess Failed: No error expected")
+}
+
+func TestValidateOciTaskDependencyFailedCycle(test *testing.T) {
+	ociTaskServClientMock := OciTaskServClientMock{}
+
+	ociTaskServClientMock.On("ListTaskDependencies", mock.Anything, matchTaskIds(2)).Return(&OciTaskServResponse{Dependencies: []OciTaskDependency{
+		makeTestOciTaskDependency(2, 3, OciTaskDependencyBlocks),
+	}}, nil).Once()
+	ociTaskServClientMock.On("ListTaskDependencies", mock.Anything, matchTaskIds(3)).Return(&OciTaskServResponse{Dependencies: []OciTaskDependency{
+		makeTestOciTaskDependency(3, 1, OciTaskDependencyBlocks),
+	}}, nil).Once()
+
+	err := ValidateOciTaskDependency(context.Background(), &ociTaskServClientMock, 1, 2)
+
+	assert.Error(test, err, "TestValidateOciTaskDependencyFailedCycle Failed: Error expected")
+	assert.Contains(test, err.Error(), "[1 2 3 1]", "TestValidateOciTaskDependencyFailedCycle Failed: Wrong cycle")
+
+	var cycleErr *OciTaskDependencyCycleError
+	assert.True(test, errors.As(err, &cycleErr), "TestValidateOciTaskDependencyFailedCycle Failed: OciTaskDependencyCycleError expected")
+	assert.Equal(test, []int64{1, 2, 3, 1}, cycleErr.Cycle, "TestValidateOciTaskDependencyFailedCycle Failed: Wrong cycle Tasks")
+}
+
+func TestValidateOciTaskDependencyFailedSelf(test *testing.T) {
+	err := ValidateOciTaskDependency(context.Background(), &OciTaskServClientMock{}, 1, 1)
+
+	assert.Error(test, err, "TestValidateOciTaskDependencyFailedSelf Failed: Error expected")
+}
+
+func TestValidateOciTaskDependencyFailedList(test *testing.T) {
+	ociTaskServClientMock := OciTaskServClientMock{}
+
+	ociTaskServClientMock.On("ListTaskDependencies", mock.Anything, mock.Anything).Return(nil, errors.New("List Failed")).Once()
+
+	err := ValidateOciTaskDependency(context.Background(), &ociTaskServClientMock, 1, 2)
+
+	assert.Error(test, err, "TestValidateOciTaskDependencyFailedList Failed: Error expected")
+}
+
+func TestValidateOciTaskDependenciesFailedPlannedCycle(test *testing.T) {
+	ociTaskServClientMock := OciTaskServClientMock{}
+
+	// 1 blocks 2 and 2 blocks 3 are planned together, 3 blocks 1 is stored
+	ociTaskServClientMock.On("ListTaskDependencies", mock.Anything, matchTaskIds(2, 3)).Return(&OciTaskServResponse{Dependencies: []OciTaskDependency{
+		makeTestOciTaskDependency(3, 1, OciTaskDependencyBlocks),
+	}}, nil).Once()
+
+	err := ValidateOciTaskDependencies(context.Background(), &ociTaskServClientMock, []OciTaskDependency{
+		makeTestOciTaskDependency(1, 2, OciTaskDependencyBlocks),
+		makeTestOciTaskDependency(2, 3, OciTaskDependencyBlocks),
+	})
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	var cycleErr *OciTaskDependencyCycleError
+	assert.True(test, errors.As(err, &cycleErr), "TestValidateOciTaskDependenciesFailedPlannedCycle Failed: OciTaskDependencyCycleError expected")
+	assert.Equal(test, []int64{1, 2, 3, 1}, cycleErr.Cycle, "TestValidateOciTaskDependenciesFailedPlannedCycle Failed: Wrong cycle Tasks")
+}
+
+func TestValidateOciTaskDependenciesFailedCycleAmongPlanned(test *testing.T) {
+	ociTaskServClientMock := OciTaskServClientMock{}
+
+	err := ValidateOciTaskDependencies(context.Background(), &ociTaskServClientMock, []OciTaskDependency{
+		makeTestOciTaskDependency(1, 2, OciTaskDependencyBlocks),
+		makeTestOciTaskDependency(2, 1, OciTaskDependencyBlocks),
+		makeTestOciTaskDependency(3, 1, OciTaskDependencyRelatesTo),
+	})
+
+	ociTaskServClientMock.AssertNotCalled(test, "ListTaskDependencies", mock.Anything, mock.Anything)
+
+	assert.Error(test, err, "TestValidateOciTaskDependenciesFailedCycleAmongPlanned Failed: Error expected")
+	assert.Contains(test, err.Error(), "[1 2 1]", "TestValidateOciTaskDependenciesFailedCycleAmongPlanned Failed: Wrong cycle")
+}
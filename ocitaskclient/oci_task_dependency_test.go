@@ -0,0 +1,46 @@
+package ocitaskclient
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOciTaskDependencySerializeSuccess(test *testing.T) {
+	dependencyId := int64(1)
+	blockerId := int64(1001)
+	blockedId := int64(1002)
+	dependencyType := OciTaskDependencyBlocks
+
+	dependency := OciTaskDependency{Id: &dependencyId, BlockerId: &blockerId, BlockedId: &blockedId, Type: &dependencyType}
+
+	dataJson, err := dependency.Serialize()
+
+	assert.NoError(test, err, "TestOciTaskDependencySerializeSuccess Failed: Unable to serialize OciTaskDependency")
+
+	data := make(map[string]interface{})
+	err = json.Unmarshal([]byte(dataJson), &data)
+
+	assert.NoError(test, err, "TestOciTaskDependencySerializeSuccess Failed: Unable to deserialize OciTaskDependency")
+	assert.Equal(test, 1001, int(data["blockerId"].(float64)), "TestOciTaskDependencySerializeSuccess Failed: Wrong Blocker Id")
+	assert.Equal(test, 1002, int(data["blockedId"].(float64)), "TestOciTaskDependencySerializeSuccess Failed: Wrong Blocked Id")
+	assert.Equal(test, "blocks", data["type"].(string), "TestOciTaskDependencySerializeSuccess Failed: Wrong Type")
+}
+
+func TestOciTaskDependencyDeserializeFailed(test *testing.T) {
+	dependency := OciTaskDependency{}
+
+	err := dependency.Deserialize([]byte("\"Test Error Message\""))
+
+	assert.Error(test, err, "TestOciTaskDependencyDeserializeFailed Failed")
+}
+
+func TestOciTaskDependencyGetType(test *testing.T) {
+	dependency := OciTaskDependency{}
+	assert.Equal(test, OciTaskDependencyBlocks, dependency.GetType(), "TestOciTaskDependencyGetType Failed: blocks expected by default")
+
+	dependencyType := OciTaskDependencyRelatesTo
+	dependency.Type = &dependencyType
+	assert.Equal(test, OciTaskDependencyRelatesTo, dependency.GetType(), "TestOciTaskDependencyGetType Failed: Wrong Type")
+}
@@ -0,0 +1,241 @@
+package ocitaskclient
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+/**
+ * @brief Container for one revision of Task in OCI Task System. Each change to Task stores a snapshot of Task as it was after the change.
+ */
+type OciTaskRevision struct {
+	Revision    *int64   `json:"revision,omitempty"`
+	TaskId      *int64   `json:"taskId,omitempty"`
+	Actor       *string  `json:"actor,omitempty"`
+	TimeChanged *int64   `json:"timeChanged,omitempty"`
+	Task        *OciTask `json:"task,omitempty"`
+}
+
+/**
+ * @brief Change of one field of Task between two revisions
+ */
+type OciTaskFieldChange struct {
+	Field    string
+	OldValue string
+	NewValue string
+}
+
+/**
+ * @brief Fields of Task which change on every revision and carry no information about the change itself
+ */
+var ociTaskHistoryIgnoredFields = map[string]bool{
+	"time_updated": true,
+}
+
+/**
+ * @brief Convert OciTaskRevision instance into generic map for Terraform resource data
+ * @param changes Field changes this revision made, see DiffOciTaskRevisions
+ * @return Generic map equivalent to OciTaskRevision. Timestamps are in RFC3339 format, empty if not set.
+ */
+func (ociTaskRevision *OciTaskRevision) Flatten(changes []OciTaskFieldChange) map[string]interface{} {
+	result := make(map[string]interface{})
+	result["revision"] = 0
+	if ociTaskRevision.Revision != nil {
+		result["revision"] = int(*ociTaskRevision.Revision)
+	}
+
+	result["actor"] = ""
+	if ociTaskRevision.Actor != nil {
+		result["actor"] = *ociTaskRevision.Actor
+	}
+
+	result["time_changed"] = formatOciTaskTimestamp(ociTaskRevision.TimeChanged)
+
+	changedFields := make([]interface{}, 0, len(changes))
+	flatChanges := make([]interface{}, 0, len(changes))
+	for _, change := range changes {
+		changedFields = append(changedFields, change.Field)
+		flatChanges = append(flatChanges, map[string]interface{}{
+			"field":     change.Field,
+			"old_value": change.OldValue,
+			"new_value": change.NewValue,
+		})
+	}
+	result["changed_fields"] = changedFields
+	result["changes"] = flatChanges
+
+	return result
+}
+
+/**
+ * @brief Convert OciTaskRevision object into JSON String
+ * @return JSON String equivalent to OciTaskRevision object if succeeded
+ * @return Instance of error if failed
+ */
+func (ociTaskRevision *OciTaskRevision) Serialize() (string, error) {
+	result := ""
+	data, err := json.Marshal(ociTaskRevision)
+	if err == nil {
+		result = string(data)
+	}
+
+	return result, err
+}
+
+/**
+ * @brief Convert JSON String into OciTaskRevision object
+ * @param data JSON String equivalent to OciTaskRevision object
+ * @return Instance of error if failed
+ */
+func (ociTaskRevision *OciTaskRevision) Deserialize(data []byte) error {
+	return json.Unmarshal(data, ociTaskRevision)
+}
+
+/**
+ * @brief Sort revisions of Task into history order: oldest revision first
+ * @param revisions Revisions of Task, sorted in place
+ */
+func SortOciTaskRevisions(revisions []OciTaskRevision) {
+	sort.SliceStable(revisions, func(i, j int) bool {
+		left, right := int64(0), int64(0)
+		if revisions[i].Revision != nil {
+			left = *revisions[i].Revision
+		}
+		if revisions[j].Revision != nil {
+			right = *revisions[j].Revision
+		}
+		return left < right
+	})
+}
+
+/**
+ * @brief Compute field level changes between two revisions of Task.
+ *			Fields are named as in Terraform schema, e.g. status_changed_at. Values are JSON encoded except strings which are kept as is.
+ *			Fields not set in a revision have empty value. time_updated is left out as it changes on every revision.
+ * @param from Earlier revision, nil to diff against empty Task e.g. for first revision
+ * @param to Later revision
+ * @return Changes sorted by field name, empty if revisions do not differ
+ * @return Instance of error if snapshot of Task could not be encoded
+ */
+func DiffOciTaskRevisions(from *OciTaskRevision, to *OciTaskRevision) ([]OciTaskFieldChange, error) {
+	changes := make([]OciTaskFieldChange, 0)
+
+	oldFields, err := ociTaskRevisionFields(from)
+	if err != nil {
+		return changes, err
+	}
+
+	newFields, err := ociTaskRevisionFields(to)
+	if err != nil {
+		return changes, err
+	}
+
+	names := make(map[string]bool)
+	for name := range oldFields {
+		names[name] = true
+	}
+	for name := range newFields {
+		names[name] = true
+	}
+
+	for name := range names {
+		field := ociTaskHistoryFieldName(name)
+		if ociTaskHistoryIgnoredFields[field] || bytes.Equal(oldFields[name], newFields[name]) {
+			continue
+		}
+
+		changes = append(changes, OciTaskFieldChange{
+			Field:    field,
+			OldValue: ociTaskHistoryFieldValue(oldFields[name]),
+			NewValue: ociTaskHistoryFieldValue(newFields[name]),
+		})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+
+	return changes, nil
+}
+
+/**
+ * @brief Flatten history of Task with changes each revision made compared to revision before it
+ * @param revisions Revisions of Task in history order, see SortOciTaskRevisions
+ * @return Instance of Interface array, one element per revision
+ * @return Instance of error if changes could not be computed
+ */
+func FlattenOciTaskHistory(revisions []OciTaskRevision) ([]interface{}, error) {
+	items := make([]interface{}, 0, len(revisions))
+
+	var previous *OciTaskRevision
+	for i := range revisions {
+		changes, err := DiffOciTaskRevisions(previous, &revisions[i])
+		if err != nil {
+			return items, err
+		}
+
+		items = append(items, revisions[i].Flatten(changes))
+		previous = &revisions[i]
+	}
+
+	return items, nil
+}
+
+/**
+ * @brief Encode each field of Task snapshot in revision separately
+ * @param revision Revision of Task, may be nil
+ * @return JSON encoded value of each field set in snapshot keyed by JSON name
+ * @return Instance of error if snapshot could not be encoded
+ */
+func ociTaskRevisionFields(revision *OciTaskRevision) (map[string]json.RawMessage, error) {
+	fields := make(map[string]json.RawMessage)
+	if revision == nil || revision.Task == nil {
+		return fields, nil
+	}
+
+	data, err := json.Marshal(revision.Task)
+	if err == nil {
+		err = json.Unmarshal(data, &fields)
+	}
+
+	return fields, err
+}
+
+/**
+ * @brief Convert JSON name of Task field into name used in Terraform schema
+ * @param name JSON name, e.g. statusChangedAt
+ * @return Terraform name, e.g. status_changed_at
+ */
+func ociTaskHistoryFieldName(name string) string {
+	var builder strings.Builder
+	for _, char := range name {
+		if unicode.IsUpper(char) {
+			builder.WriteByte('_')
+			char = unicode.ToLower(char)
+		}
+		builder.WriteRune(char)
+	}
+
+	return builder.String()
+}
+
+/**
+ * @brief Render JSON encoded value of Task field for display
+ * @param value JSON encoded value, nil if field not set
+ * @return Strings unquoted, any other value as JSON, empty if not set
+ */
+func ociTaskHistoryFieldValue(value json.RawMessage) string {
+	if value == nil {
+		return ""
+	}
+
+	var text string
+	if json.Unmarshal(value, &text) == nil {
+		return text
+	}
+
+	return string(value)
+}
@@ -0,0 +1,79 @@
+package ocitaskclient
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func makeTestOciTaskRevision(revision int64, title string, status string) OciTaskRevision {
+	actor := "jdoe@example.com"
+	timeChanged := time.Date(2023, 3, 15, 17, 0, 0, 0, time.UTC).UnixMilli()
+	timeUpdated := timeChanged + revision
+	return OciTaskRevision{Revision: &revision, Actor: &actor, TimeChanged: &timeChanged, Task: &OciTask{Title: &title, Status: &status, TimeUpdated: &timeUpdated}}
+}
+
+func TestDiffOciTaskRevisions(test *testing.T) {
+	from := makeTestOciTaskRevision(1, "Write docs", "todo")
+	to := makeTestOciTaskRevision(2, "Write docs", "in_progress")
+	changedAt := int64(1678899600000)
+	to.Task.StatusChangedAt = &changedAt
+
+	changes, err := DiffOciTaskRevisions(&from, &to)
+
+	assert.NoError(test, err, "TestDiffOciTaskRevisions Failed: No error expected")
+	assert.Equal(test, []OciTaskFieldChange{
+		{Field: "status", OldValue: "todo", NewValue: "in_progress"},
+		{Field: "status_changed_at", OldValue: "", NewValue: "1678899600000"},
+	}, changes, "TestDiffOciTaskRevisions Failed: Wrong changes")
+}
+
+func TestDiffOciTaskRevisionsFirstRevision(test *testing.T) {
+	to := makeTestOciTaskRevision(1, "Write docs", "todo")
+	to.Task.Tags = map[string]string{"team": "docs"}
+
+	changes, err := DiffOciTaskRevisions(nil, &to)
+
+	assert.NoError(test, err, "TestDiffOciTaskRevisionsFirstRevision Failed: No error expected")
+	assert.Equal(test, []OciTaskFieldChange{
+		{Field: "status", OldValue: "", NewValue: "todo"},
+		{Field: "tags", OldValue: "", NewValue: `{"team":"docs"}`},
+		{Field: "title", OldValue: "", NewValue: "Write docs"},
+	}, changes, "TestDiffOciTaskRevisionsFirstRevision Failed: Wrong changes")
+}
+
+func TestDiffOciTaskRevisionsNoChange(test *testing.T) {
+	from := makeTestOciTaskRevision(1, "Write docs", "todo")
+	to := makeTestOciTaskRevision(2, "Write docs", "todo")
+
+	changes, err := DiffOciTaskRevisions(&from, &to)
+
+	assert.NoError(test, err, "TestDiffOciTaskRevisionsNoChange Failed: No error expected")
+	assert.Equal(test, 0, len(changes), "TestDiffOciTaskRevisionsNoChange Failed: time_updated should be ignored")
+}
+
+func TestSortOciTaskRevisions(test *testing.T) {
+	revisions := []OciTaskRevision{makeTestOciTaskRevision(3, "c", "todo"), makeTestOciTaskRevision(1, "a", "todo"), makeTestOciTaskRevision(2, "b", "todo")}
+
+	SortOciTaskRevisions(revisions)
+
+	assert.Equal(test, int64(1), *revisions[0].Revision, "TestSortOciTaskRevisions Failed: Oldest revision expected first")
+	assert.Equal(test, int64(3), *revisions[2].Revision, "TestSortOciTaskRevisions Failed: Latest revision expected last")
+}
+
+func TestFlattenOciTaskHistory(test *testing.T) {
+	revisions := []OciTaskRevision{makeTestOciTaskRevision(1, "Write docs", "todo"), makeTestOciTaskRevision(2, "Write user docs", "todo")}
+
+	items, err := FlattenOciTaskHistory(revisions)
+
+	assert.NoError(test, err, "TestFlattenOciTaskHistory Failed: No error expected")
+	assert.Equal(test, 2, len(items), "TestFlattenOciTaskHistory Failed: One item per revision expected")
+
+	second := items[1].(map[string]interface{})
+	assert.Equal(test, 2, second["revision"], "TestFlattenOciTaskHistory Failed: Wrong revision")
+	assert.Equal(test, "jdoe@example.com", second["actor"], "TestFlattenOciTaskHistory Failed: Wrong actor")
+	assert.Equal(test, "2023-03-15T17:00:00Z", second["time_changed"], "TestFlattenOciTaskHistory Failed: Wrong time changed")
+	assert.Equal(test, []interface{}{"title"}, second["changed_fields"], "TestFlattenOciTaskHistory Failed: Wrong changed fields")
+	assert.Equal(test, []interface{}{map[string]interface{}{"field": "title", "old_value": "Write docs", "new_value": "Write user docs"}}, second["changes"], "TestFlattenOciTaskHistory Failed: Wrong changes")
+}
@@ -0,0 +1,106 @@
+package ocitaskclient
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+)
+
+/**
+ * @brief Filter for listing Tasks in OCI Task Service
+ */
+type OciTaskListFilter struct {
+	Tags      map[string]string
+	ProjectId *int64
+	Assignee  string
+	// Values of custom fields by name
+	CustomFields map[string]string
+	// True to list archived Tasks instead of active ones
+	Archived bool
+}
+
+/**
+ * @brief Constructor for OciTaskListFilter
+ * @return Instance of OciTaskListFilter matching all Tasks
+ */
+func MakeOciTaskListFilter() *OciTaskListFilter {
+	return &OciTaskListFilter{}
+}
+
+/**
+ * @brief Build URL query parameters for filter
+ * @return Encoded URL query, empty if filter matches all Tasks
+ */
+func (ociTaskListFilter *OciTaskListFilter) QueryString() string {
+	if ociTaskListFilter == nil {
+		return ""
+	}
+
+	query := url.Values{}
+
+	tags := make([]string, 0, len(ociTaskListFilter.Tags))
+	for key, value := range ociTaskListFilter.Tags {
+		tags = append(tags, fmt.Sprintf("%s:%s", NormalizeOciTaskTagKey(key), value))
+	}
+	sort.Strings(tags)
+
+	for _, tag := range tags {
+		query.Add("tag", tag)
+	}
+
+	customFields := make([]string, 0, len(ociTaskListFilter.CustomFields))
+	for name, value := range ociTaskListFilter.CustomFields {
+		customFields = append(customFields, fmt.Sprintf("%s:%s", name, value))
+	}
+	sort.Strings(customFields)
+
+	for _, customField := range customFields {
+		query.Add("customField", customField)
+	}
+
+	if ociTaskListFilter.ProjectId != nil {
+		query.Set("projectId", strconv.FormatInt(*ociTaskListFilter.ProjectId, 10))
+	}
+
+	if ociTaskListFilter.Archived {
+		query.Set("archived", "true")
+	}
+
+	if ociTaskListFilter.Assignee != "" {
+		query.Set("assignee", NormalizeOciTaskUserEmail(ociTaskListFilter.Assignee))
+	}
+
+	return query.Encode()
+}
+
+/**
+ * @brief Check if Task matches filter
+ * @param ociTask Instance of OciTask
+ * @return True if Task matches filter
+ */
+func (ociTaskListFilter *OciTaskListFilter) Match(ociTask *OciTask) bool {
+	if ociTaskListFilter == nil {
+		return ociTask != nil
+	}
+
+	if ociTask != nil && ociTask.IsArchived() != ociTaskListFilter.Archived {
+		return false
+	}
+
+	if ociTaskListFilter.ProjectId != nil && (ociTask == nil || ociTask.ProjectId == nil || *ociTask.ProjectId != *ociTaskListFilter.ProjectId) {
+		return false
+	}
+
+	if ociTaskListFilter.Assignee != "" && (ociTask == nil || ociTask.Assignee == nil || NormalizeOciTaskUserEmail(*ociTask.Assignee) != NormalizeOciTaskUserEmail(ociTaskListFilter.Assignee)) {
+		return false
+	}
+
+	for name, value := range ociTaskListFilter.CustomFields {
+		if ociTask == nil || ociTask.CustomFields[name] != value {
+			return false
+		}
+	}
+
+	return MatchOciTaskTags(ociTask, ociTaskListFilter.Tags)
+}
@@ -0,0 +1,88 @@
+package ocitaskclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOciTaskListFilterQueryString(test *testing.T) {
+	filter := MakeOciTaskListFilter()
+	filter.Tags = map[string]string{"Team": "Platform", "environment": "prod"}
+
+	assert.Equal(test, "tag=environment%3Aprod&tag=team%3APlatform", filter.QueryString(), "TestOciTaskListFilterQueryString Failed: Wrong query string")
+}
+
+func TestOciTaskListFilterEmpty(test *testing.T) {
+	var filter *OciTaskListFilter
+	ociTask := OciTask{}
+
+	assert.Equal(test, "", filter.QueryString(), "TestOciTaskListFilterEmpty Failed: Empty query string expected")
+	assert.Equal(test, "", MakeOciTaskListFilter().QueryString(), "TestOciTaskListFilterEmpty Failed: Empty query string expected")
+	assert.True(test, filter.Match(&ociTask), "TestOciTaskListFilterEmpty Failed: Task should match nil filter")
+}
+
+func TestOciTaskListFilterMatch(test *testing.T) {
+	filter := MakeOciTaskListFilter()
+	filter.Tags = map[string]string{"team": "Platform"}
+
+	platformTask := OciTask{Tags: map[string]string{"team": "Platform"}}
+	storageTask := OciTask{Tags: map[string]string{"team": "Storage"}}
+
+	assert.True(test, filter.Match(&platformTask), "TestOciTaskListFilterMatch Failed: Task should match")
+	assert.False(test, filter.Match(&storageTask), "TestOciTaskListFilterMatch Failed: Task should not match")
+}
+
+func TestOciTaskListFilterProject(test *testing.T) {
+	projectId := int64(42)
+	otherProjectId := int64(43)
+	filter := MakeOciTaskListFilter()
+	filter.ProjectId = &projectId
+
+	assert.Equal(test, "projectId=42", filter.QueryString(), "TestOciTaskListFilterProject Failed: Wrong query string")
+	assert.True(test, filter.Match(&OciTask{ProjectId: &projectId}), "TestOciTaskListFilterProject Failed: Task should match")
+	assert.False(test, filter.Match(&OciTask{ProjectId: &otherProjectId}), "TestOciTaskListFilterProject Failed: Task of other project should not match")
+	assert.False(test, filter.Match(&OciTask{}), "TestOciTaskListFilterProject Failed: Task without project should not match")
+}
+
+func TestOciTaskListFilterAssignee(test *testing.T) {
+	assignee := "jdoe@example.com"
+	otherAssignee := "asmith@example.com"
+	filter := MakeOciTaskListFilter()
+	filter.Assignee = "JDoe@Example.com"
+
+	assert.Equal(test, "assignee=jdoe%40example.com", filter.QueryString(), "TestOciTaskListFilterAssignee Failed: Wrong query string")
+	assert.True(test, filter.Match(&OciTask{Assignee: &assignee}), "TestOciTaskListFilterAssignee Failed: Task should match")
+	assert.False(test, filter.Match(&OciTask{Assignee: &otherAssignee}), "TestOciTaskListFilterAssignee Failed: Task of other assignee should not match")
+	assert.False(test, filter.Match(&OciTask{}), "TestOciTaskListFilterAssignee Failed: Unassigned Task should not match")
+}
+
+func TestOciTaskListFilterCustomFields(test *testing.T) {
+	filter := MakeOciTaskListFilter()
+	filter.CustomFields = map[string]string{"environment": "prod", "cost_center": "CC-42"}
+
+	matching := OciTask{CustomFields: map[string]string{"environment": "prod", "cost_center": "CC-42", "ticket_url": "https://example.com/1"}}
+	other := OciTask{CustomFields: map[string]string{"environment": "dev", "cost_center": "CC-42"}}
+
+	assert.Equal(test, "customField=cost_center%3ACC-42&customField=environment%3Aprod", filter.QueryString(), "TestOciTaskListFilterCustomFields Failed: Wrong query string")
+	assert.True(test, filter.Match(&matching), "TestOciTaskListFilterCustomFields Failed: Task should match")
+	assert.False(test, filter.Match(&other), "TestOciTaskListFilterCustomFields Failed: Task with other value should not match")
+	assert.False(test, filter.Match(&OciTask{}), "TestOciTaskListFilterCustomFields Failed: Task without custom fields should not match")
+}
+
+func TestOciTaskListFilterArchived(test *testing.T) {
+	archived := true
+	archivedTask := OciTask{Archived: &archived}
+	activeTask := OciTask{}
+
+	filter := MakeOciTaskListFilter()
+
+	assert.True(test, filter.Match(&activeTask), "TestOciTaskListFilterArchived Failed: Active task should match")
+	assert.False(test, filter.Match(&archivedTask), "TestOciTaskListFilterArchived Failed: Archived task should not match by default")
+
+	filter.Archived = true
+
+	assert.Equal(test, "archived=true", filter.QueryString(), "TestOciTaskListFilterArchived Failed: Wrong query string")
+	assert.True(test, filter.Match(&archivedTask), "TestOciTaskListFilterArchived Failed: Archived task should match")
+	assert.False(test, filter.Match(&activeTask), "TestOciTaskListFilterArchived Failed: Active task should not match")
+}
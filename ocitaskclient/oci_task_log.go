@@ -0,0 +1,90 @@
+package ocitaskclient
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+/**
+ * @brief Name of the tflog subsystem used by OCI Task Service Client.
+ *			Log level can be controlled with TF_LOG_PROVIDER_OCITASKCLIENT.
+ */
+const OciTaskLogSubsystem string = "ocitaskclient"
+
+/**
+ * @brief Replacement text for sensitive values
+ */
+const ociTaskLogMask string = "***"
+
+/**
+ * @brief HTTP headers which must never appear in logs
+ */
+var ociTaskSensitiveHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Api-Key",
+}
+
+/**
+ * @brief Log field keys and JSON attributes which must never appear in logs
+ */
+var ociTaskSensitiveFields = []string{
+	"authorization",
+	"password",
+	"secret",
+	"token",
+	"api_key",
+	"apiKey",
+}
+
+/**
+ * @brief Matches values of sensitive JSON attributes in request and response bodies
+ */
+var ociTaskSensitiveBodyRegex = regexp.MustCompile(`(?i)("(?:` + strings.Join(ociTaskSensitiveFields, "|") + `)"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+
+/**
+ * @brief Build logging context for OCI Task Service Client.
+ *			Registers tflog subsystem and masks sensitive field values.
+ * @param ctx Context passed in by Terraform Provider
+ * @return Context with OCI Task Service Client subsystem logger
+ */
+func MakeOciTaskLogContext(ctx context.Context) context.Context {
+	ctx = tflog.NewSubsystem(ctx, OciTaskLogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER", OciTaskLogSubsystem))
+	ctx = tflog.SubsystemMaskFieldValuesWithFieldKeys(ctx, OciTaskLogSubsystem, ociTaskSensitiveFields...)
+	return ctx
+}
+
+/**
+ * @brief Convert HTTP headers into log friendly map with sensitive values masked
+ * @param header HTTP headers
+ * @return Map of header name and value with sensitive values masked
+ */
+func RedactOciTaskHeaders(header http.Header) map[string]string {
+	result := make(map[string]string)
+	for name, values := range header {
+		result[name] = strings.Join(values, ", ")
+	}
+
+	for _, name := range ociTaskSensitiveHeaders {
+		if _, ok := result[name]; ok {
+			result[name] = ociTaskLogMask
+		}
+	}
+
+	return result
+}
+
+/**
+ * @brief Mask values of sensitive JSON attributes in request or response body
+ * @param body Request or response body
+ * @return Body with sensitive values masked
+ */
+func RedactOciTaskBody(body []byte) string {
+	return ociTaskSensitiveBodyRegex.ReplaceAllString(string(body), `$1"`+ociTaskLogMask+`"`)
+}
@@ -0,0 +1,50 @@
+package ocitaskclient
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRedactOciTaskHeadersSuccess(test *testing.T) {
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	header.Set("Authorization", "Bearer abc123")
+	header.Set("X-Api-Key", "key123")
+
+	result := RedactOciTaskHeaders(header)
+
+	assert.Equal(test, "application/json", result["Content-Type"], "TestRedactOciTaskHeadersSuccess Failed: Content-Type should not be masked")
+	assert.Equal(test, "***", result["Authorization"], "TestRedactOciTaskHeadersSuccess Failed: Authorization should be masked")
+	assert.Equal(test, "***", result["X-Api-Key"], "TestRedactOciTaskHeadersSuccess Failed: X-Api-Key should be masked")
+}
+
+func TestRedactOciTaskHeadersEmpty(test *testing.T) {
+	result := RedactOciTaskHeaders(nil)
+
+	assert.Equal(test, 0, len(result), "TestRedactOciTaskHeadersEmpty Failed: Empty result expected")
+}
+
+func TestRedactOciTaskBodySuccess(test *testing.T) {
+	body := `{"title":"Test Task","password":"p@ss\"word","Token": "abc","nested":{"secret":"xyz"}}`
+
+	result := RedactOciTaskBody([]byte(body))
+
+	assert.Equal(test, `{"title":"Test Task","password":"***","Token": "***","nested":{"secret":"***"}}`, result, "TestRedactOciTaskBodySuccess Failed: Sensitive values should be masked")
+}
+
+func TestRedactOciTaskBodyNoSensitiveFields(test *testing.T) {
+	body := `{"title":"Test Task","description":"Test Task Desc"}`
+
+	result := RedactOciTaskBody([]byte(body))
+
+	assert.Equal(test, body, result, "TestRedactOciTaskBodyNoSensitiveFields Failed: Body should not be changed")
+}
+
+func TestMakeOciTaskLogContextWithoutLogger(test *testing.T) {
+	ctx := MakeOciTaskLogContext(context.Background())
+
+	assert.NotNil(test, ctx, "TestMakeOciTaskLogContextWithoutLogger Failed: Valid context expected")
+}
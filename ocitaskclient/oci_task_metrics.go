@@ -0,0 +1,199 @@
+package ocitaskclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+/**
+ * @brief Upper bounds in milliseconds of latency histogram buckets. Last bucket is unbounded.
+ */
+var OciTaskLatencyBucketsMs = []int64{50, 100, 250, 500, 1000, 2500, 5000, 10000}
+
+/**
+ * @brief Container for latency histogram bucket
+ */
+type OciTaskLatencyBucket struct {
+	UpperBoundMs *int64 `json:"upperBoundMs,omitempty"`
+	Count        int64  `json:"count"`
+}
+
+/**
+ * @brief Container for metrics of one OCI Task Service operation
+ */
+type OciTaskOperationMetrics struct {
+	Count            int64                  `json:"count"`
+	ErrorCount       int64                  `json:"errorCount"`
+	Errors           map[string]int64       `json:"errors,omitempty"`
+	TotalLatencyMs   int64                  `json:"totalLatencyMs"`
+	MaxLatencyMs     int64                  `json:"maxLatencyMs"`
+	LatencyHistogram []OciTaskLatencyBucket `json:"latencyHistogram"`
+}
+
+/**
+ * @brief Collects request counts, error counts by status class and latency histograms per operation.
+ *			Safe for concurrent use.
+ */
+type OciTaskMetrics struct {
+	mutex      sync.Mutex
+	operations map[string]*OciTaskOperationMetrics
+}
+
+/**
+ * @brief Constructor for OciTaskMetrics
+ * @return Instance of OciTaskMetrics
+ */
+func MakeOciTaskMetrics() *OciTaskMetrics {
+	return &OciTaskMetrics{
+		operations: make(map[string]*OciTaskOperationMetrics),
+	}
+}
+
+/**
+ * @brief Record outcome of one call to OCI Task Service
+ * @param operation Name of the operation, e.g. CreateTask
+ * @param statusCode HTTP status code, 0 if no response received
+ * @param latency Time taken by the call
+ * @param err Instance of error if call failed before response received
+ */
+func (ociTaskMetrics *OciTaskMetrics) Record(operation string, statusCode int, latency time.Duration, err error) {
+	if ociTaskMetrics == nil {
+		return
+	}
+
+	ociTaskMetrics.mutex.Lock()
+	defer ociTaskMetrics.mutex.Unlock()
+
+	opMetrics, ok := ociTaskMetrics.operations[operation]
+	if !ok {
+		opMetrics = &OciTaskOperationMetrics{
+			Errors:           make(map[string]int64),
+			LatencyHistogram: makeOciTaskLatencyHistogram(),
+		}
+		ociTaskMetrics.operations[operation] = opMetrics
+	}
+
+	latencyMs := latency.Milliseconds()
+
+	opMetrics.Count++
+	opMetrics.TotalLatencyMs += latencyMs
+	if latencyMs > opMetrics.MaxLatencyMs {
+		opMetrics.MaxLatencyMs = latencyMs
+	}
+
+	bucket := len(OciTaskLatencyBucketsMs)
+	for i, upperBound := range OciTaskLatencyBucketsMs {
+		if latencyMs <= upperBound {
+			bucket = i
+			break
+		}
+	}
+	opMetrics.LatencyHistogram[bucket].Count++
+
+	errorClass := ociTaskErrorClass(statusCode, err)
+	if errorClass != "" {
+		opMetrics.ErrorCount++
+		opMetrics.Errors[errorClass]++
+	}
+}
+
+/**
+ * @brief Take snapshot of collected metrics
+ * @return Map of operation name and copy of its metrics
+ */
+func (ociTaskMetrics *OciTaskMetrics) Snapshot() map[string]OciTaskOperationMetrics {
+	result := make(map[string]OciTaskOperationMetrics)
+	if ociTaskMetrics == nil {
+		return result
+	}
+
+	ociTaskMetrics.mutex.Lock()
+	defer ociTaskMetrics.mutex.Unlock()
+
+	for operation, opMetrics := range ociTaskMetrics.operations {
+		opCopy := *opMetrics
+		opCopy.Errors = make(map[string]int64)
+		for errorClass, count := range opMetrics.Errors {
+			opCopy.Errors[errorClass] = count
+		}
+		opCopy.LatencyHistogram = append([]OciTaskLatencyBucket(nil), opMetrics.LatencyHistogram...)
+		result[operation] = opCopy
+	}
+
+	return result
+}
+
+/**
+ * @brief Build one line summary of collected metrics
+ * @return Summary with total calls and count, errors and average latency per operation
+ */
+func (ociTaskMetrics *OciTaskMetrics) Summary() string {
+	snapshot := ociTaskMetrics.Snapshot()
+
+	operations := make([]string, 0, len(snapshot))
+	for operation := range snapshot {
+		operations = append(operations, operation)
+	}
+	sort.Strings(operations)
+
+	total := int64(0)
+	parts := make([]string, 0, len(operations))
+	for _, operation := range operations {
+		opMetrics := snapshot[operation]
+		total += opMetrics.Count
+		parts = append(parts, fmt.Sprintf("%s(count=%d errors=%d avg_ms=%d max_ms=%d)",
+			operation, opMetrics.Count, opMetrics.ErrorCount, opMetrics.TotalLatencyMs/opMetrics.Count, opMetrics.MaxLatencyMs))
+	}
+
+	return fmt.Sprintf("OCI Task Service API calls: total=%d %s", total, strings.Join(parts, " "))
+}
+
+/**
+ * @brief Convert collected metrics into JSON String
+ * @return JSON String with metrics per operation if succeeded
+ * @return Instance of error if failed
+ */
+func (ociTaskMetrics *OciTaskMetrics) Serialize() (string, error) {
+	result := ""
+	data, err := json.Marshal(ociTaskMetrics.Snapshot())
+	if err == nil {
+		result = string(data)
+	}
+
+	return result, err
+}
+
+/**
+ * @brief Build empty latency histogram
+ * @return Latency histogram with one bucket per entry in OciTaskLatencyBucketsMs plus unbounded bucket
+ */
+func makeOciTaskLatencyHistogram() []OciTaskLatencyBucket {
+	histogram := make([]OciTaskLatencyBucket, 0, len(OciTaskLatencyBucketsMs)+1)
+	for i := range OciTaskLatencyBucketsMs {
+		histogram = append(histogram, OciTaskLatencyBucket{UpperBoundMs: &OciTaskLatencyBucketsMs[i]})
+	}
+
+	return append(histogram, OciTaskLatencyBucket{})
+}
+
+/**
+ * @brief Classify outcome of call to OCI Task Service
+ * @param statusCode HTTP status code, 0 if no response received
+ * @param err Instance of error if call failed before response received
+ * @return Error class (network, 4xx or 5xx), empty if call succeeded
+ */
+func ociTaskErrorClass(statusCode int, err error) string {
+	if err != nil {
+		return "network"
+	} else if statusCode >= 500 {
+		return "5xx"
+	} else if statusCode >= 400 {
+		return "4xx"
+	}
+
+	return ""
+}
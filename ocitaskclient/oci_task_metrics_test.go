@@ -0,0 +1,74 @@
+package ocitaskclient
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOciTaskMetricsRecordSuccess(test *testing.T) {
+	metrics := MakeOciTaskMetrics()
+
+	metrics.Record("GetTask", 200, 20*time.Millisecond, nil)
+	metrics.Record("GetTask", 404, 120*time.Millisecond, nil)
+	metrics.Record("GetTask", 503, 30*time.Second, nil)
+	metrics.Record("CreateTask", 0, 10*time.Millisecond, errors.New("SendRequest Failed"))
+
+	snapshot := metrics.Snapshot()
+
+	getTask := snapshot["GetTask"]
+
+	assert.Equal(test, int64(3), getTask.Count, "TestOciTaskMetricsRecordSuccess Failed: Wrong GetTask count")
+	assert.Equal(test, int64(2), getTask.ErrorCount, "TestOciTaskMetricsRecordSuccess Failed: Wrong GetTask error count")
+	assert.Equal(test, int64(1), getTask.Errors["4xx"], "TestOciTaskMetricsRecordSuccess Failed: Wrong GetTask 4xx count")
+	assert.Equal(test, int64(1), getTask.Errors["5xx"], "TestOciTaskMetricsRecordSuccess Failed: Wrong GetTask 5xx count")
+	assert.Equal(test, int64(30000), getTask.MaxLatencyMs, "TestOciTaskMetricsRecordSuccess Failed: Wrong GetTask max latency")
+	assert.Equal(test, int64(1), getTask.LatencyHistogram[0].Count, "TestOciTaskMetricsRecordSuccess Failed: Wrong count in 50ms bucket")
+	assert.Equal(test, int64(1), getTask.LatencyHistogram[2].Count, "TestOciTaskMetricsRecordSuccess Failed: Wrong count in 250ms bucket")
+	assert.Equal(test, int64(1), getTask.LatencyHistogram[len(OciTaskLatencyBucketsMs)].Count, "TestOciTaskMetricsRecordSuccess Failed: Wrong count in unbounded bucket")
+	assert.Nil(test, getTask.LatencyHistogram[len(OciTaskLatencyBucketsMs)].UpperBoundMs, "TestOciTaskMetricsRecordSuccess Failed: Unbounded bucket expected")
+
+	createTask := snapshot["CreateTask"]
+
+	assert.Equal(test, int64(1), createTask.Count, "TestOciTaskMetricsRecordSuccess Failed: Wrong CreateTask count")
+	assert.Equal(test, int64(1), createTask.Errors["network"], "TestOciTaskMetricsRecordSuccess Failed: Wrong CreateTask network error count")
+}
+
+func TestOciTaskMetricsSummary(test *testing.T) {
+	metrics := MakeOciTaskMetrics()
+
+	metrics.Record("GetTask", 200, 20*time.Millisecond, nil)
+	metrics.Record("CreateTask", 201, 40*time.Millisecond, nil)
+
+	summary := metrics.Summary()
+
+	assert.Equal(test, "OCI Task Service API calls: total=2 CreateTask(count=1 errors=0 avg_ms=40 max_ms=40) GetTask(count=1 errors=0 avg_ms=20 max_ms=20)", summary, "TestOciTaskMetricsSummary Failed: Wrong summary")
+}
+
+func TestOciTaskMetricsSerializeSuccess(test *testing.T) {
+	metrics := MakeOciTaskMetrics()
+
+	metrics.Record("DeleteTask", 500, 20*time.Millisecond, nil)
+
+	dataJson, err := metrics.Serialize()
+
+	assert.NoError(test, err, "TestOciTaskMetricsSerializeSuccess Failed: Unable to serialize OciTaskMetrics")
+
+	data := make(map[string]OciTaskOperationMetrics)
+	err = json.Unmarshal([]byte(dataJson), &data)
+
+	assert.NoError(test, err, "TestOciTaskMetricsSerializeSuccess Failed: Unable to deserialize OciTaskMetrics")
+	assert.Equal(test, int64(1), data["DeleteTask"].Count, "TestOciTaskMetricsSerializeSuccess Failed: Wrong DeleteTask count")
+	assert.Equal(test, int64(1), data["DeleteTask"].Errors["5xx"], "TestOciTaskMetricsSerializeSuccess Failed: Wrong DeleteTask 5xx count")
+}
+
+func TestOciTaskMetricsNilSafe(test *testing.T) {
+	var metrics *OciTaskMetrics
+
+	metrics.Record("GetTask", 200, time.Millisecond, nil)
+
+	assert.Equal(test, 0, len(metrics.Snapshot()), "TestOciTaskMetricsNilSafe Failed: Empty snapshot expected")
+}
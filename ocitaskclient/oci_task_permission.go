@@ -0,0 +1,259 @@
+package ocitaskclient
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+/**
+ * @brief Roles which can be granted on Task or Project, from least to most privileged
+ */
+const (
+	OciTaskRoleViewer string = "viewer"
+	OciTaskRoleEditor string = "editor"
+	OciTaskRoleOwner  string = "owner"
+)
+
+/**
+ * @brief Roles OCI Task Service supports, from least to most privileged
+ */
+var OciTaskRoles = []string{OciTaskRoleViewer, OciTaskRoleEditor, OciTaskRoleOwner}
+
+/**
+ * @brief Kinds of principals roles can be granted to
+ */
+const (
+	OciTaskPrincipalUser  string = "user"
+	OciTaskPrincipalGroup string = "group"
+)
+
+/**
+ * @brief Kinds of principals OCI Task Service supports
+ */
+var OciTaskPrincipalTypes = []string{OciTaskPrincipalUser, OciTaskPrincipalGroup}
+
+/**
+ * @brief Kinds of objects roles can be granted on. Roles granted on Project apply to all its Tasks.
+ */
+const (
+	OciTaskPermissionTargetTask    string = "task"
+	OciTaskPermissionTargetProject string = "project"
+)
+
+/**
+ * @brief Actions each role allows. Each role allows all actions of less privileged roles.
+ */
+var OciTaskRoleActions = map[string][]string{
+	OciTaskRoleViewer: {"read"},
+	OciTaskRoleEditor: {"read", "comment", "update"},
+	OciTaskRoleOwner:  {"read", "comment", "update", "delete", "share"},
+}
+
+/**
+ * @brief Container for role granted to user or group on Task or Project in OCI Task System
+ */
+type OciTaskPermission struct {
+	Id            *int64  `json:"id,omitempty"`
+	TargetType    *string `json:"targetType,omitempty"`
+	TargetId      *int64  `json:"targetId,omitempty"`
+	PrincipalType *string `json:"principalType,omitempty"`
+	Principal     *string `json:"principal,omitempty"`
+	Role          *string `json:"role,omitempty"`
+	TimeCreated   *int64  `json:"timeCreated,omitempty"`
+}
+
+/**
+ * @brief Role a principal effectively holds on Task or Project, combining all grants which apply to it
+ */
+type OciTaskEffectivePermission struct {
+	Role    string
+	Actions []string
+	Sources []OciTaskPermission
+}
+
+/**
+ * @brief Convert OciTaskPermission instance into generic map for Terraform resource data
+ * @return Generic map equivalent to OciTaskPermission. Timestamps are in RFC3339 format, empty if not set.
+ */
+func (ociTaskPermission *OciTaskPermission) Flatten() map[string]interface{} {
+	result := make(map[string]interface{})
+	result["id"] = 0
+	if ociTaskPermission.Id != nil {
+		result["id"] = int(*ociTaskPermission.Id)
+	}
+
+	result["target_type"] = ""
+	if ociTaskPermission.TargetType != nil {
+		result["target_type"] = *ociTaskPermission.TargetType
+	}
+
+	result["target_id"] = 0
+	if ociTaskPermission.TargetId != nil {
+		result["target_id"] = int(*ociTaskPermission.TargetId)
+	}
+
+	result["principal_type"] = ""
+	if ociTaskPermission.PrincipalType != nil {
+		result["principal_type"] = *ociTaskPermission.PrincipalType
+	}
+
+	result["principal"] = ""
+	if ociTaskPermission.Principal != nil {
+		result["principal"] = *ociTaskPermission.Principal
+	}
+
+	result["role"] = ""
+	if ociTaskPermission.Role != nil {
+		result["role"] = *ociTaskPermission.Role
+	}
+
+	result["time_created"] = formatOciTaskTimestamp(ociTaskPermission.TimeCreated)
+
+	return result
+}
+
+/**
+ * @brief Check that permission names known target, principal and role
+ * @return Instance of error describing first problem, nil if permission is valid
+ */
+func (ociTaskPermission *OciTaskPermission) Validate() error {
+	if ociTaskPermission.TargetType == nil || ociTaskPermission.TargetId == nil {
+		return fmt.Errorf("Permission needs target type and Id")
+	}
+	if *ociTaskPermission.TargetType != OciTaskPermissionTargetTask && *ociTaskPermission.TargetType != OciTaskPermissionTargetProject {
+		return fmt.Errorf("Invalid target type %q - expected %s or %s", *ociTaskPermission.TargetType, OciTaskPermissionTargetTask, OciTaskPermissionTargetProject)
+	}
+	if ociTaskPermission.PrincipalType == nil || ociTaskPermission.Principal == nil || *ociTaskPermission.Principal == "" {
+		return fmt.Errorf("Permission needs principal type and principal")
+	}
+	if *ociTaskPermission.PrincipalType != OciTaskPrincipalUser && *ociTaskPermission.PrincipalType != OciTaskPrincipalGroup {
+		return fmt.Errorf("Invalid principal type %q - expected %s or %s", *ociTaskPermission.PrincipalType, OciTaskPrincipalUser, OciTaskPrincipalGroup)
+	}
+	if ociTaskPermission.Role == nil || OciTaskRoleRank(*ociTaskPermission.Role) < 0 {
+		role := ""
+		if ociTaskPermission.Role != nil {
+			role = *ociTaskPermission.Role
+		}
+		return fmt.Errorf("Invalid role %q - expected one of %v", role, OciTaskRoles)
+	}
+
+	return nil
+}
+
+/**
+ * @brief Convert OciTaskPermission object into JSON String
+ * @return JSON String equivalent to OciTaskPermission object if succeeded
+ * @return Instance of error if failed
+ */
+func (ociTaskPermission *OciTaskPermission) Serialize() (string, error) {
+	result := ""
+	data, err := json.Marshal(ociTaskPermission)
+	if err == nil {
+		result = string(data)
+	}
+
+	return result, err
+}
+
+/**
+ * @brief Convert JSON String into OciTaskPermission object
+ * @param data JSON String equivalent to OciTaskPermission object
+ * @return Instance of error if failed
+ */
+func (ociTaskPermission *OciTaskPermission) Deserialize(data []byte) error {
+	return json.Unmarshal(data, ociTaskPermission)
+}
+
+/**
+ * @brief Rank role by privilege
+ * @param role Name of role
+ * @return Position of role in OciTaskRoles, -1 if role is unknown
+ */
+func OciTaskRoleRank(role string) int {
+	for rank, known := range OciTaskRoles {
+		if known == role {
+			return rank
+		}
+	}
+
+	return -1
+}
+
+/**
+ * @brief Combine grants which apply to a principal, directly, through its groups or inherited from Project, into effective permission.
+ *			Most privileged role wins, grants with unknown roles are ignored.
+ * @param grants Grants which apply to principal
+ * @return Effective permission. Role is empty and Actions is empty if no grant applies.
+ */
+func ResolveOciTaskEffectivePermission(grants []OciTaskPermission) OciTaskEffectivePermission {
+	result := OciTaskEffectivePermission{Actions: make([]string, 0), Sources: make([]OciTaskPermission, 0)}
+
+	rank := -1
+	for _, grant := range grants {
+		if grant.Role == nil || OciTaskRoleRank(*grant.Role) < 0 {
+			continue
+		}
+
+		result.Sources = append(result.Sources, grant)
+		if OciTaskRoleRank(*grant.Role) > rank {
+			rank = OciTaskRoleRank(*grant.Role)
+			result.Role = *grant.Role
+		}
+	}
+
+	if rank >= 0 {
+		result.Actions = append(result.Actions, OciTaskRoleActions[result.Role]...)
+		sort.Strings(result.Actions)
+	}
+
+	SortOciTaskPermissions(result.Sources)
+
+	return result
+}
+
+/**
+ * @brief Sort permissions: most privileged role first, ties broken by Identifier
+ * @param permissions Permissions, sorted in place
+ */
+func SortOciTaskPermissions(permissions []OciTaskPermission) {
+	sort.SliceStable(permissions, func(i, j int) bool {
+		left, right := -1, -1
+		if permissions[i].Role != nil {
+			left = OciTaskRoleRank(*permissions[i].Role)
+		}
+		if permissions[j].Role != nil {
+			right = OciTaskRoleRank(*permissions[j].Role)
+		}
+		if left != right {
+			return left > right
+		}
+
+		leftId, rightId := int64(0), int64(0)
+		if permissions[i].Id != nil {
+			leftId = *permissions[i].Id
+		}
+		if permissions[j].Id != nil {
+			rightId = *permissions[j].Id
+		}
+		return leftId < rightId
+	})
+}
+
+/**
+ * @brief Build path of permissions collection of Task or Project
+ * @param targetType Kind of target, task or project
+ * @param targetId Identifier of target
+ * @return Path relative to host URL, e.g. /tasks/1001/permissions
+ * @return Instance of error if target type is unknown
+ */
+func ociTaskPermissionsPath(targetType string, targetId int64) (string, error) {
+	switch targetType {
+	case OciTaskPermissionTargetTask:
+		return fmt.Sprintf("/tasks/%d/permissions", targetId), nil
+	case OciTaskPermissionTargetProject:
+		return fmt.Sprintf("/projects/%d/permissions", targetId), nil
+	}
+
+	return "", fmt.Errorf("Invalid target type %q - expected %s or %s", targetType, OciTaskPermissionTargetTask, OciTaskPermissionTargetProject)
+}
@@ -0,0 +1,51 @@
+package ocitaskclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func makeTestOciTaskPermission(id int64, targetType string, targetId int64, principalType string, principal string, role string) OciTaskPermission {
+	return OciTaskPermission{Id: &id, TargetType: &targetType, TargetId: &targetId, PrincipalType: &principalType, Principal: &principal, Role: &role}
+}
+
+func TestOciTaskPermissionValidate(test *testing.T) {
+	valid := makeTestOciTaskPermission(1, OciTaskPermissionTargetTask, 1001, OciTaskPrincipalUser, "jdoe@example.com", OciTaskRoleEditor)
+	badTarget := makeTestOciTaskPermission(1, "board", 1001, OciTaskPrincipalUser, "jdoe@example.com", OciTaskRoleEditor)
+	badPrincipal := makeTestOciTaskPermission(1, OciTaskPermissionTargetTask, 1001, "robot", "jdoe@example.com", OciTaskRoleEditor)
+	badRole := makeTestOciTaskPermission(1, OciTaskPermissionTargetProject, 42, OciTaskPrincipalGroup, "platform", "admin")
+
+	assert.NoError(test, valid.Validate(), "TestOciTaskPermissionValidate Failed: Valid permission rejected")
+	assert.ErrorContains(test, badTarget.Validate(), "target type", "TestOciTaskPermissionValidate Failed: Unknown target accepted")
+	assert.ErrorContains(test, badPrincipal.Validate(), "principal type", "TestOciTaskPermissionValidate Failed: Unknown principal type accepted")
+	assert.ErrorContains(test, badRole.Validate(), "role", "TestOciTaskPermissionValidate Failed: Unknown role accepted")
+}
+
+func TestResolveOciTaskEffectivePermission(test *testing.T) {
+	grants := []OciTaskPermission{
+		makeTestOciTaskPermission(3, OciTaskPermissionTargetTask, 1001, OciTaskPrincipalUser, "jdoe@example.com", OciTaskRoleViewer),
+		makeTestOciTaskPermission(1, OciTaskPermissionTargetProject, 42, OciTaskPrincipalGroup, "platform", OciTaskRoleEditor),
+		makeTestOciTaskPermission(2, OciTaskPermissionTargetTask, 1001, OciTaskPrincipalGroup, "bots", "admin"),
+	}
+
+	result := ResolveOciTaskEffectivePermission(grants)
+
+	assert.Equal(test, OciTaskRoleEditor, result.Role, "TestResolveOciTaskEffectivePermission Failed: Most privileged role expected")
+	assert.Equal(test, []string{"comment", "read", "update"}, result.Actions, "TestResolveOciTaskEffectivePermission Failed: Wrong actions")
+	assert.Equal(test, 2, len(result.Sources), "TestResolveOciTaskEffectivePermission Failed: Unknown role should be ignored")
+	assert.Equal(test, int64(1), *result.Sources[0].Id, "TestResolveOciTaskEffectivePermission Failed: Most privileged source expected first")
+}
+
+func TestResolveOciTaskEffectivePermissionNone(test *testing.T) {
+	result := ResolveOciTaskEffectivePermission(nil)
+
+	assert.Equal(test, "", result.Role, "TestResolveOciTaskEffectivePermissionNone Failed: No role expected")
+	assert.Equal(test, 0, len(result.Actions), "TestResolveOciTaskEffectivePermissionNone Failed: No actions expected")
+}
+
+func TestOciTaskRoleRank(test *testing.T) {
+	assert.Less(test, OciTaskRoleRank(OciTaskRoleViewer), OciTaskRoleRank(OciTaskRoleEditor), "TestOciTaskRoleRank Failed: Viewer should rank below editor")
+	assert.Less(test, OciTaskRoleRank(OciTaskRoleEditor), OciTaskRoleRank(OciTaskRoleOwner), "TestOciTaskRoleRank Failed: Editor should rank below owner")
+	assert.Equal(test, -1, OciTaskRoleRank("admin"), "TestOciTaskRoleRank Failed: Unknown role should rank -1")
+}
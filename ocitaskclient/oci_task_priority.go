@@ -0,0 +1,121 @@
+package ocitaskclient
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+/**
+ * @brief Lowest priority accepted by OCI Task Service
+ */
+const OciTaskPriorityMin int = 1
+
+/**
+ * @brief Highest priority accepted by OCI Task Service
+ */
+const OciTaskPriorityMax int = 10
+
+/**
+ * @brief Named priority levels
+ */
+const (
+	OciTaskPriorityLow      string = "low"
+	OciTaskPriorityMedium   string = "medium"
+	OciTaskPriorityHigh     string = "high"
+	OciTaskPriorityCritical string = "critical"
+)
+
+/**
+ * @brief Mapping of priority level names to integer priorities of OCI Task Service
+ */
+type OciTaskPriorityLevels map[string]int
+
+/**
+ * @brief Constructor for OciTaskPriorityLevels with default mapping
+ * @return Instance of OciTaskPriorityLevels
+ */
+func MakeOciTaskPriorityLevels() OciTaskPriorityLevels {
+	return OciTaskPriorityLevels{
+		OciTaskPriorityLow:      1,
+		OciTaskPriorityMedium:   5,
+		OciTaskPriorityHigh:     8,
+		OciTaskPriorityCritical: 10,
+	}
+}
+
+/**
+ * @brief Check if integer priority is within range accepted by OCI Task Service
+ * @param priority Integer priority
+ * @return Instance of error if out of range
+ */
+func ValidateOciTaskPriority(priority int) error {
+	if priority < OciTaskPriorityMin || priority > OciTaskPriorityMax {
+		return fmt.Errorf("Priority %d out of range - expected %d to %d", priority, OciTaskPriorityMin, OciTaskPriorityMax)
+	}
+
+	return nil
+}
+
+/**
+ * @brief Convert priority given as integer or level name into integer priority
+ * @param value Integer priority or level name, level names compared case-insensitively
+ * @return Integer priority if succeeded
+ * @return Instance of error if value is unknown level name or out of range
+ */
+func (ociTaskPriorityLevels OciTaskPriorityLevels) Normalize(value string) (int, error) {
+	value = strings.TrimSpace(value)
+
+	priority, err := strconv.Atoi(value)
+	if err != nil {
+		var ok bool
+		priority, ok = ociTaskPriorityLevels[strings.ToLower(value)]
+		if !ok {
+			return 0, fmt.Errorf("Invalid priority %q - expected integer from %d to %d or one of %s",
+				value, OciTaskPriorityMin, OciTaskPriorityMax, strings.Join(ociTaskPriorityLevels.Names(), ", "))
+		}
+	}
+
+	err = ValidateOciTaskPriority(priority)
+	if err != nil {
+		return 0, err
+	}
+
+	return priority, nil
+}
+
+/**
+ * @brief Find level name of integer priority
+ * @param priority Integer priority
+ * @return Level name, lowest sorting name if several levels map to priority, empty if none
+ */
+func (ociTaskPriorityLevels OciTaskPriorityLevels) Name(priority int) string {
+	for _, name := range ociTaskPriorityLevels.Names() {
+		if ociTaskPriorityLevels[name] == priority {
+			return name
+		}
+	}
+
+	return ""
+}
+
+/**
+ * @brief List level names ordered by priority
+ * @return Level names ordered by priority, then by name
+ */
+func (ociTaskPriorityLevels OciTaskPriorityLevels) Names() []string {
+	names := make([]string, 0, len(ociTaskPriorityLevels))
+	for name := range ociTaskPriorityLevels {
+		names = append(names, name)
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		if ociTaskPriorityLevels[names[i]] != ociTaskPriorityLevels[names[j]] {
+			return ociTaskPriorityLevels[names[i]] < ociTaskPriorityLevels[names[j]]
+		}
+		return names[i] < names[j]
+	})
+
+	return names
+}
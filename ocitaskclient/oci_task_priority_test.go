@@ -0,0 +1,42 @@
+package ocitaskclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOciTaskPriorityLevelsNormalizeSuccess(test *testing.T) {
+	levels := MakeOciTaskPriorityLevels()
+
+	priority, err := levels.Normalize("High")
+	assert.NoError(test, err, "TestOciTaskPriorityLevelsNormalizeSuccess Failed: No error expected")
+	assert.Equal(test, 8, priority, "TestOciTaskPriorityLevelsNormalizeSuccess Failed: Wrong priority for level name")
+
+	priority, err = levels.Normalize("3")
+	assert.NoError(test, err, "TestOciTaskPriorityLevelsNormalizeSuccess Failed: No error expected")
+	assert.Equal(test, 3, priority, "TestOciTaskPriorityLevelsNormalizeSuccess Failed: Wrong priority for integer")
+}
+
+func TestOciTaskPriorityLevelsNormalizeFailed(test *testing.T) {
+	levels := MakeOciTaskPriorityLevels()
+
+	_, err := levels.Normalize("urgent")
+	assert.Error(test, err, "TestOciTaskPriorityLevelsNormalizeFailed Failed: Unknown level expected to be rejected")
+	assert.Contains(test, err.Error(), "low, medium, high, critical", "TestOciTaskPriorityLevelsNormalizeFailed Failed: Level names expected in error")
+
+	_, err = levels.Normalize("11")
+	assert.Error(test, err, "TestOciTaskPriorityLevelsNormalizeFailed Failed: Out of range priority expected to be rejected")
+
+	_, err = levels.Normalize("0")
+	assert.Error(test, err, "TestOciTaskPriorityLevelsNormalizeFailed Failed: Out of range priority expected to be rejected")
+}
+
+func TestOciTaskPriorityLevelsName(test *testing.T) {
+	levels := MakeOciTaskPriorityLevels()
+	levels["urgent"] = 10
+
+	assert.Equal(test, "medium", levels.Name(5), "TestOciTaskPriorityLevelsName Failed: Wrong level name")
+	assert.Equal(test, "critical", levels.Name(10), "TestOciTaskPriorityLevelsName Failed: Lowest sorting level name expected")
+	assert.Equal(test, "", levels.Name(4), "TestOciTaskPriorityLevelsName Failed: No level name expected")
+}
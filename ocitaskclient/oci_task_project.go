@@ -0,0 +1,71 @@
+package ocitaskclient
+
+import (
+	"encoding/json"
+)
+
+/**
+ * @brief Container for Project resource in OCI Task System. Project groups Tasks.
+ */
+type OciTaskProject struct {
+	Id          *int64  `json:"id,omitempty"`
+	Name        *string `json:"name,omitempty"`
+	Description *string `json:"description,omitempty"`
+	Owner       *string `json:"owner,omitempty"`
+	Archived    *bool   `json:"archived,omitempty"`
+	TimeCreated *int64  `json:"timeCreated,omitempty"`
+	TimeUpdated *int64  `json:"timeUpdated,omitempty"`
+}
+
+/**
+ * @brief Convert OciTaskProject instance into generic map for Terraform resource data
+ * @return Generic map equivalent to OciTaskProject
+ */
+func (ociTaskProject *OciTaskProject) Flatten() map[string]interface{} {
+	result := make(map[string]interface{})
+	result["name"] = ""
+	if ociTaskProject.Name != nil {
+		result["name"] = *ociTaskProject.Name
+	}
+
+	result["description"] = ""
+	if ociTaskProject.Description != nil {
+		result["description"] = *ociTaskProject.Description
+	}
+
+	result["owner"] = ""
+	if ociTaskProject.Owner != nil {
+		result["owner"] = *ociTaskProject.Owner
+	}
+
+	result["archived"] = false
+	if ociTaskProject.Archived != nil {
+		result["archived"] = *ociTaskProject.Archived
+	}
+
+	return result
+}
+
+/**
+ * @brief Convert OciTaskProject object into JSON String
+ * @return JSON String equivalent to OciTaskProject object if succeeded
+ * @return Instance of error if failed
+ */
+func (ociTaskProject *OciTaskProject) Serialize() (string, error) {
+	result := ""
+	data, err := json.Marshal(ociTaskProject)
+	if err == nil {
+		result = string(data)
+	}
+
+	return result, err
+}
+
+/**
+ * @brief Convert JSON String into OciTaskProject object
+ * @param data JSON String equivalent to OciTaskProject object
+ * @return Instance of error if failed
+ */
+func (ociTaskProject *OciTaskProject) Deserialize(data []byte) error {
+	return json.Unmarshal(data, ociTaskProject)
+}
@@ -0,0 +1,48 @@
+package ocitaskclient
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOciTaskProjectSerializeSuccess(test *testing.T) {
+	projectId := int64(42)
+	name := "Platform"
+	archived := true
+
+	project := OciTaskProject{Id: &projectId, Name: &name, Archived: &archived}
+
+	dataJson, err := project.Serialize()
+
+	assert.NoError(test, err, "TestOciTaskProjectSerializeSuccess Failed: Unable to serialize OciTaskProject")
+
+	data := make(map[string]interface{})
+	err = json.Unmarshal([]byte(dataJson), &data)
+
+	assert.NoError(test, err, "TestOciTaskProjectSerializeSuccess Failed: Unable to deserialize OciTaskProject")
+	assert.Equal(test, 42, int(data["id"].(float64)), "TestOciTaskProjectSerializeSuccess Failed: Wrong Project Id")
+	assert.Equal(test, "Platform", data["name"].(string), "TestOciTaskProjectSerializeSuccess Failed: Wrong Project Name")
+	assert.Equal(test, true, data["archived"].(bool), "TestOciTaskProjectSerializeSuccess Failed: Wrong Project Archived")
+}
+
+func TestOciTaskProjectDeserializeFailed(test *testing.T) {
+	project := OciTaskProject{}
+
+	err := project.Deserialize([]byte("\"Test Error Message\""))
+
+	assert.Error(test, err, "TestOciTaskProjectDeserializeFailed Failed")
+}
+
+func TestOciTaskProjectFlatten(test *testing.T) {
+	name := "Platform"
+	owner := "jdoe"
+
+	data := (&OciTaskProject{Name: &name, Owner: &owner}).Flatten()
+
+	assert.Equal(test, "Platform", data["name"], "TestOciTaskProjectFlatten Failed: Wrong Project Name")
+	assert.Equal(test, "jdoe", data["owner"], "TestOciTaskProjectFlatten Failed: Wrong Project Owner")
+	assert.Equal(test, "", data["description"], "TestOciTaskProjectFlatten Failed: Empty description expected")
+	assert.Equal(test, false, data["archived"], "TestOciTaskProjectFlatten Failed: Project should not be archived")
+}
@@ -0,0 +1,643 @@
+package ocitaskclient
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	// Time zone database is embedded so time zones resolve on hosts without one
+	_ "time/tzdata"
+)
+
+/**
+ * @brief Frequencies of recurrence rule supported by OCI Task System
+ */
+const (
+	OciTaskRecurrenceDaily   string = "DAILY"
+	OciTaskRecurrenceWeekly  string = "WEEKLY"
+	OciTaskRecurrenceMonthly string = "MONTHLY"
+	OciTaskRecurrenceYearly  string = "YEARLY"
+)
+
+/**
+ * @brief Layouts of date and time values in recurrence rules and schedules
+ */
+const (
+	ociTaskRecurrenceUtcLayout   string = "20060102T150405Z"
+	ociTaskRecurrenceLocalLayout string = "20060102T150405"
+	ociTaskRecurrenceDateLayout  string = "20060102"
+)
+
+/**
+ * @brief Number of consecutive periods without occurrence after which rule is considered exhausted, e.g. FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30.
+ *			Large enough for FREQ=DAILY;BYMONTH=2;BYMONTHDAY=29, which skips up to eight years.
+ */
+const ociTaskRecurrenceMaxEmptyPeriods int = 4000
+
+var ociTaskRecurrenceWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+/**
+ * @brief Weekday of BYDAY rule part, e.g. MO, 2TU or -1FR
+ */
+type OciTaskRecurrenceDay struct {
+	Ordinal int // 0 for every matching weekday, n for n-th and -n for n-th last weekday of month or year
+	Weekday time.Weekday
+}
+
+/**
+ * @brief Recurrence rule (RFC 5545 RRULE) of recurring Task.
+ *			Supports FREQ of DAILY, WEEKLY, MONTHLY and YEARLY with INTERVAL, COUNT, UNTIL, BYDAY, BYMONTHDAY, BYMONTH and WKST.
+ */
+type OciTaskRecurrenceRule struct {
+	Frequency  string
+	Interval   int
+	Count      int
+	Until      string
+	ByDay      []OciTaskRecurrenceDay
+	ByMonthDay []int
+	ByMonth    []int
+	WeekStart  time.Weekday
+}
+
+/**
+ * @brief Recurrence rule anchored to start time and time zone, with excluded occurrences (EXDATE)
+ */
+type OciTaskRecurrence struct {
+	rule         *OciTaskRecurrenceRule
+	start        time.Time
+	until        *time.Time
+	excludeTimes map[int64]bool
+	excludeDates map[string]bool
+}
+
+/**
+ * @brief Parse recurrence rule in RFC 5545 RRULE format, e.g. FREQ=MONTHLY;BYDAY=-1FR. RRULE: prefix is optional.
+ * @param value Recurrence rule
+ * @return Instance of OciTaskRecurrenceRule if succeeded
+ * @return Instance of error if rule is malformed or uses unsupported parts
+ */
+func ParseOciTaskRecurrenceRule(value string) (*OciTaskRecurrenceRule, error) {
+	value = strings.TrimSpace(value)
+	if len(value) >= 6 && strings.EqualFold(value[:6], "RRULE:") {
+		value = value[6:]
+	}
+
+	rule := &OciTaskRecurrenceRule{Interval: 1, WeekStart: time.Monday}
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(value, ";") {
+		key, partValue, found := strings.Cut(part, "=")
+		key = strings.ToUpper(strings.TrimSpace(key))
+		partValue = strings.ToUpper(strings.TrimSpace(partValue))
+		if !found || key == "" || partValue == "" {
+			return nil, fmt.Errorf("Invalid recurrence rule part %q - expected <name>=<value>", part)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("Invalid recurrence rule - %s is set more than once", key)
+		}
+		seen[key] = true
+
+		var err error
+		switch key {
+		case "FREQ":
+			rule.Frequency = partValue
+		case "INTERVAL":
+			rule.Interval, err = parseOciTaskRecurrenceInt(key, partValue, 1, 0)
+		case "COUNT":
+			rule.Count, err = parseOciTaskRecurrenceInt(key, partValue, 1, 0)
+		case "UNTIL":
+			rule.Until = partValue
+			_, err = parseOciTaskRecurrenceUntil(partValue, time.UTC)
+		case "BYDAY":
+			rule.ByDay, err = parseOciTaskRecurrenceDays(partValue)
+		case "BYMONTHDAY":
+			rule.ByMonthDay, err = parseOciTaskRecurrenceInts(key, partValue, 31)
+		case "BYMONTH":
+			rule.ByMonth, err = parseOciTaskRecurrenceInts(key, partValue, 12)
+			for _, month := range rule.ByMonth {
+				if month < 0 {
+					err = fmt.Errorf("Invalid recurrence rule - BYMONTH value %d out of range", month)
+				}
+			}
+		case "WKST":
+			weekday, ok := ociTaskRecurrenceWeekdays[partValue]
+			if !ok {
+				err = fmt.Errorf("Invalid recurrence rule - WKST value %q is not a weekday", partValue)
+			}
+			rule.WeekStart = weekday
+		default:
+			err = fmt.Errorf("Unsupported recurrence rule part %s", key)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	switch rule.Frequency {
+	case OciTaskRecurrenceDaily, OciTaskRecurrenceWeekly, OciTaskRecurrenceMonthly, OciTaskRecurrenceYearly:
+	case "":
+		return nil, fmt.Errorf("Invalid recurrence rule - FREQ is required")
+	default:
+		return nil, fmt.Errorf("Unsupported recurrence frequency %q - expected DAILY, WEEKLY, MONTHLY or YEARLY", rule.Frequency)
+	}
+
+	if rule.Count > 0 && rule.Until != "" {
+		return nil, fmt.Errorf("Invalid recurrence rule - COUNT and UNTIL can't be set together")
+	}
+	if rule.Frequency == OciTaskRecurrenceWeekly && len(rule.ByMonthDay) > 0 {
+		return nil, fmt.Errorf("Invalid recurrence rule - BYMONTHDAY can't be set with FREQ=WEEKLY")
+	}
+	if rule.Frequency != OciTaskRecurrenceMonthly && rule.Frequency != OciTaskRecurrenceYearly {
+		for _, day := range rule.ByDay {
+			if day.Ordinal != 0 {
+				return nil, fmt.Errorf("Invalid recurrence rule - BYDAY ordinals are only allowed with FREQ=MONTHLY or FREQ=YEARLY")
+			}
+		}
+	}
+
+	return rule, nil
+}
+
+/**
+ * @brief Parse date or time of recurrence schedule, e.g. DTSTART or EXDATE.
+ *			Accepts RFC3339, local date and time (2006-01-02T15:04:05) or local date (2006-01-02).
+ * @param value Date or time
+ * @param location Time zone of local values. Result is converted into it.
+ * @return Time in given time zone
+ * @return True if value is date without time
+ * @return Instance of error if value is malformed
+ */
+func ParseOciTaskRecurrenceTime(value string, location *time.Location) (time.Time, bool, error) {
+	if result, err := time.Parse(time.RFC3339, value); err == nil {
+		return result.In(location), false, nil
+	}
+	if result, err := time.ParseInLocation("2006-01-02T15:04:05", value, location); err == nil {
+		return result, false, nil
+	}
+	if result, err := time.ParseInLocation("2006-01-02", value, location); err == nil {
+		return result, true, nil
+	}
+
+	return time.Time{}, false, fmt.Errorf("Invalid time %q - expected RFC3339, 2006-01-02T15:04:05 or 2006-01-02", value)
+}
+
+/**
+ * @brief Constructor for OciTaskRecurrence
+ * @param rule Recurrence rule in RFC 5545 RRULE format
+ * @param start Start of recurrence (DTSTART), see ParseOciTaskRecurrenceTime for accepted formats
+ * @param timeZone IANA name of time zone occurrences are computed in, e.g. Europe/Berlin. UTC if empty.
+ * @param exdates Excluded occurrences (EXDATE). Date without time excludes any occurrence on that day.
+ * @return Instance of OciTaskRecurrence if succeeded
+ * @return Instance of error if any argument is malformed
+ */
+func MakeOciTaskRecurrence(rule string, start string, timeZone string, exdates []string) (*OciTaskRecurrence, error) {
+	recurrenceRule, err := ParseOciTaskRecurrenceRule(rule)
+	if err != nil {
+		return nil, err
+	}
+
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid time zone %q: %w", timeZone, err)
+	}
+
+	startTime, _, err := ParseOciTaskRecurrenceTime(start, location)
+	if err != nil {
+		return nil, err
+	}
+
+	ociTaskRecurrence := &OciTaskRecurrence{
+		rule:         recurrenceRule,
+		start:        startTime,
+		excludeTimes: make(map[int64]bool),
+		excludeDates: make(map[string]bool),
+	}
+
+	if recurrenceRule.Until != "" {
+		until, err := parseOciTaskRecurrenceUntil(recurrenceRule.Until, location)
+		if err != nil {
+			return nil, err
+		}
+		ociTaskRecurrence.until = &until
+	}
+
+	for _, exdate := range exdates {
+		excluded, dateOnly, err := ParseOciTaskRecurrenceTime(exdate, location)
+		if err != nil {
+			return nil, err
+		}
+		if dateOnly {
+			ociTaskRecurrence.excludeDates[excluded.Format(ociTaskRecurrenceDateLayout)] = true
+		} else {
+			ociTaskRecurrence.excludeTimes[excluded.UnixNano()] = true
+		}
+	}
+
+	return ociTaskRecurrence, nil
+}
+
+/**
+ * @brief Getter function for start of recurrence
+ * @return Start of recurrence in time zone of recurrence
+ */
+func (ociTaskRecurrence *OciTaskRecurrence) GetStart() time.Time {
+	return ociTaskRecurrence.start
+}
+
+/**
+ * @brief Compute upcoming occurrences
+ * @param from Occurrences before this time are skipped
+ * @param limit Maximum number of occurrences returned
+ * @return Occurrences at or after from in time zone of recurrence, fewer than limit if recurrence ends
+ */
+func (ociTaskRecurrence *OciTaskRecurrence) Occurrences(from time.Time, limit int) []time.Time {
+	result := make([]time.Time, 0)
+	if limit <= 0 {
+		return result
+	}
+
+	ociTaskRecurrence.expand(func(occurrence time.Time) bool {
+		if !occurrence.Before(from) {
+			result = append(result, occurrence)
+		}
+		return len(result) < limit
+	})
+
+	return result
+}
+
+/**
+ * @brief Find occurrence current at given time: the latest one which already started, or the first one if recurrence hasn't started yet
+ * @param now Reference time
+ * @return Current occurrence in time zone of recurrence
+ * @return False if recurrence has no occurrence at all
+ */
+func (ociTaskRecurrence *OciTaskRecurrence) Current(now time.Time) (time.Time, bool) {
+	var current time.Time
+	found := false
+
+	ociTaskRecurrence.expand(func(occurrence time.Time) bool {
+		if found && occurrence.After(now) {
+			return false
+		}
+		current, found = occurrence, true
+		return true
+	})
+
+	return current, found
+}
+
+/**
+ * @brief Private method to walk occurrences in order. COUNT applies before EXDATE as defined by RFC 5545.
+ * @param visit Called for each occurrence, returns false to stop
+ */
+func (ociTaskRecurrence *OciTaskRecurrence) expand(visit func(occurrence time.Time) bool) {
+	rule := ociTaskRecurrence.rule
+	count := 0
+	emptyPeriods := 0
+
+	for period := 0; emptyPeriods < ociTaskRecurrenceMaxEmptyPeriods; period++ {
+		candidates := ociTaskRecurrence.periodCandidates(period * rule.Interval)
+		emitted := false
+		for _, candidate := range candidates {
+			if candidate.Before(ociTaskRecurrence.start) {
+				continue
+			}
+			if ociTaskRecurrence.until != nil && candidate.After(*ociTaskRecurrence.until) {
+				return
+			}
+
+			emitted = true
+			count++
+			if !ociTaskRecurrence.isExcluded(candidate) && !visit(candidate) {
+				return
+			}
+			if rule.Count > 0 && count >= rule.Count {
+				return
+			}
+		}
+
+		if emitted {
+			emptyPeriods = 0
+		} else {
+			emptyPeriods++
+		}
+	}
+}
+
+/**
+ * @brief Private method to list occurrences of one period in order, e.g. all matching days of one month for FREQ=MONTHLY
+ * @param offset Number of frequency units, e.g. months, since period containing start
+ * @return Sorted candidate occurrences of period
+ */
+func (ociTaskRecurrence *OciTaskRecurrence) periodCandidates(offset int) []time.Time {
+	rule := ociTaskRecurrence.rule
+	start := ociTaskRecurrence.start
+	days := make([]time.Time, 0)
+
+	switch rule.Frequency {
+	case OciTaskRecurrenceDaily:
+		day := ociTaskRecurrenceDate(start.Year(), start.Month(), start.Day()+offset, start.Location())
+		if ociTaskRecurrence.matchesWeekday(day) && ociTaskRecurrence.matchesMonthDay(day) {
+			days = append(days, day)
+		}
+	case OciTaskRecurrenceWeekly:
+		shift := (int(start.Weekday()) - int(rule.WeekStart) + 7) % 7
+		weekStart := ociTaskRecurrenceDate(start.Year(), start.Month(), start.Day()-shift+7*offset, start.Location())
+		for i := 0; i < 7; i++ {
+			day := weekStart.AddDate(0, 0, i)
+			if len(rule.ByDay) > 0 && ociTaskRecurrence.matchesWeekday(day) || len(rule.ByDay) == 0 && day.Weekday() == start.Weekday() {
+				days = append(days, day)
+			}
+		}
+	case OciTaskRecurrenceMonthly:
+		month := ociTaskRecurrenceDate(start.Year(), start.Month()+time.Month(offset), 1, start.Location())
+		days = ociTaskRecurrence.monthDays(month.Year(), month.Month())
+	case OciTaskRecurrenceYearly:
+		year := start.Year() + offset
+		if len(rule.ByMonth) == 0 && len(rule.ByMonthDay) == 0 && len(rule.ByDay) > 0 {
+			days = ociTaskRecurrence.yearWeekdays(year)
+		} else {
+			months := rule.ByMonth
+			if len(months) == 0 && len(rule.ByMonthDay) > 0 {
+				months = []int{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}
+			} else if len(months) == 0 {
+				months = []int{int(start.Month())}
+			}
+			for _, month := range months {
+				days = append(days, ociTaskRecurrence.monthDays(year, time.Month(month))...)
+			}
+		}
+	}
+
+	result := make([]time.Time, 0, len(days))
+	for _, day := range days {
+		if !ociTaskRecurrence.matchesMonth(day) {
+			continue
+		}
+		result = append(result, time.Date(day.Year(), day.Month(), day.Day(), start.Hour(), start.Minute(), start.Second(), 0, start.Location()))
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Before(result[j]) })
+
+	return result
+}
+
+/**
+ * @brief Private method to list matching days of month. BYMONTHDAY and BYDAY are intersected if both are set.
+ * @param year Year of month
+ * @param month Month
+ * @return Matching days of month, unsorted
+ */
+func (ociTaskRecurrence *OciTaskRecurrence) monthDays(year int, month time.Month) []time.Time {
+	rule := ociTaskRecurrence.rule
+	location := ociTaskRecurrence.start.Location()
+	daysInMonth := ociTaskRecurrenceDate(year, month+1, 0, location).Day()
+	days := make([]time.Time, 0)
+
+	for dayOfMonth := 1; dayOfMonth <= daysInMonth; dayOfMonth++ {
+		day := ociTaskRecurrenceDate(year, month, dayOfMonth, location)
+		matches := true
+		if len(rule.ByMonthDay) > 0 {
+			matches = ociTaskRecurrence.matchesMonthDay(day)
+		}
+		if matches && len(rule.ByDay) > 0 {
+			matches = ociTaskRecurrenceMatchesDay(rule.ByDay, day, dayOfMonth, daysInMonth)
+		}
+		if len(rule.ByMonthDay) == 0 && len(rule.ByDay) == 0 {
+			matches = dayOfMonth == ociTaskRecurrence.start.Day()
+		}
+		if matches {
+			days = append(days, day)
+		}
+	}
+
+	return days
+}
+
+/**
+ * @brief Private method to list days of year matching BYDAY, ordinals counted within year
+ * @param year Year
+ * @return Matching days of year, unsorted
+ */
+func (ociTaskRecurrence *OciTaskRecurrence) yearWeekdays(year int) []time.Time {
+	location := ociTaskRecurrence.start.Location()
+	daysInYear := ociTaskRecurrenceDate(year, time.December, 31, location).YearDay()
+	days := make([]time.Time, 0)
+
+	for dayOfYear := 1; dayOfYear <= daysInYear; dayOfYear++ {
+		day := ociTaskRecurrenceDate(year, time.January, dayOfYear, location)
+		if ociTaskRecurrenceMatchesDay(ociTaskRecurrence.rule.ByDay, day, dayOfYear, daysInYear) {
+			days = append(days, day)
+		}
+	}
+
+	return days
+}
+
+/**
+ * @brief Private method to check day against BYDAY without ordinals
+ * @param day Day to check
+ * @return True if BYDAY is not set or contains weekday of day
+ */
+func (ociTaskRecurrence *OciTaskRecurrence) matchesWeekday(day time.Time) bool {
+	if len(ociTaskRecurrence.rule.ByDay) == 0 {
+		return true
+	}
+
+	for _, byDay := range ociTaskRecurrence.rule.ByDay {
+		if byDay.Weekday == day.Weekday() {
+			return true
+		}
+	}
+
+	return false
+}
+
+/**
+ * @brief Private method to check day against BYMONTHDAY. Negative values count from end of month.
+ * @param day Day to check
+ * @return True if BYMONTHDAY is not set or contains day
+ */
+func (ociTaskRecurrence *OciTaskRecurrence) matchesMonthDay(day time.Time) bool {
+	if len(ociTaskRecurrence.rule.ByMonthDay) == 0 {
+		return true
+	}
+
+	daysInMonth := ociTaskRecurrenceDate(day.Year(), day.Month()+1, 0, day.Location()).Day()
+	for _, monthDay := range ociTaskRecurrence.rule.ByMonthDay {
+		if monthDay == day.Day() || monthDay < 0 && daysInMonth+monthDay+1 == day.Day() {
+			return true
+		}
+	}
+
+	return false
+}
+
+/**
+ * @brief Private method to check day against BYMONTH
+ * @param day Day to check
+ * @return True if BYMONTH is not set or contains month of day
+ */
+func (ociTaskRecurrence *OciTaskRecurrence) matchesMonth(day time.Time) bool {
+	if len(ociTaskRecurrence.rule.ByMonth) == 0 {
+		return true
+	}
+
+	for _, month := range ociTaskRecurrence.rule.ByMonth {
+		if time.Month(month) == day.Month() {
+			return true
+		}
+	}
+
+	return false
+}
+
+/**
+ * @brief Private method to check occurrence against EXDATE
+ * @param occurrence Occurrence to check
+ * @return True if occurrence is excluded
+ */
+func (ociTaskRecurrence *OciTaskRecurrence) isExcluded(occurrence time.Time) bool {
+	return ociTaskRecurrence.excludeTimes[occurrence.UnixNano()] || ociTaskRecurrence.excludeDates[occurrence.Format(ociTaskRecurrenceDateLayout)]
+}
+
+/**
+ * @brief Check day against BYDAY with ordinals counted within month or year
+ * @param byDay Weekdays of BYDAY
+ * @param day Day to check
+ * @param position Position of day within month or year, starting at 1
+ * @param length Number of days in month or year
+ * @return True if day matches any weekday
+ */
+func ociTaskRecurrenceMatchesDay(byDay []OciTaskRecurrenceDay, day time.Time, position int, length int) bool {
+	for _, weekday := range byDay {
+		if weekday.Weekday != day.Weekday() {
+			continue
+		}
+		if weekday.Ordinal == 0 ||
+			weekday.Ordinal > 0 && (position-1)/7+1 == weekday.Ordinal ||
+			weekday.Ordinal < 0 && (length-position)/7+1 == -weekday.Ordinal {
+			return true
+		}
+	}
+
+	return false
+}
+
+/**
+ * @brief Build midnight of day, normalising overflowing day and month like time.Date
+ * @param year Year
+ * @param month Month
+ * @param day Day of month
+ * @param location Time zone
+ * @return Midnight of day
+ */
+func ociTaskRecurrenceDate(year int, month time.Month, day int, location *time.Location) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, location)
+}
+
+/**
+ * @brief Parse UNTIL value of recurrence rule. Date without time includes whole day.
+ * @param value UNTIL value in UTC (20060102T150405Z), local (20060102T150405) or date (20060102) format
+ * @param location Time zone of local values
+ * @return Last time occurrence may start at
+ * @return Instance of error if value is malformed
+ */
+func parseOciTaskRecurrenceUntil(value string, location *time.Location) (time.Time, error) {
+	if result, err := time.Parse(ociTaskRecurrenceUtcLayout, value); err == nil {
+		return result, nil
+	}
+	if result, err := time.ParseInLocation(ociTaskRecurrenceLocalLayout, value, location); err == nil {
+		return result, nil
+	}
+	if result, err := time.ParseInLocation(ociTaskRecurrenceDateLayout, value, location); err == nil {
+		return result.AddDate(0, 0, 1).Add(-time.Nanosecond), nil
+	}
+
+	return time.Time{}, fmt.Errorf("Invalid recurrence rule - UNTIL value %q is not a date or time", value)
+}
+
+/**
+ * @brief Parse integer value of recurrence rule part
+ * @param key Name of rule part
+ * @param value Value of rule part
+ * @param min Minimum value
+ * @param max Maximum value, 0 for no maximum
+ * @return Parsed value
+ * @return Instance of error if value is malformed or out of range
+ */
+func parseOciTaskRecurrenceInt(key string, value string, min int, max int) (int, error) {
+	result, err := strconv.Atoi(value)
+	if err != nil || result < min || max > 0 && result > max {
+		return 0, fmt.Errorf("Invalid recurrence rule - %s value %q out of range", key, value)
+	}
+
+	return result, nil
+}
+
+/**
+ * @brief Parse comma separated list of non-zero integers, negative values count from end
+ * @param key Name of rule part
+ * @param value Value of rule part
+ * @param max Maximum absolute value
+ * @return Parsed values
+ * @return Instance of error if any value is malformed or out of range
+ */
+func parseOciTaskRecurrenceInts(key string, value string, max int) ([]int, error) {
+	result := make([]int, 0)
+	for _, item := range strings.Split(value, ",") {
+		number, err := strconv.Atoi(item)
+		if err != nil || number == 0 || number > max || number < -max {
+			return nil, fmt.Errorf("Invalid recurrence rule - %s value %q out of range", key, item)
+		}
+		result = append(result, number)
+	}
+
+	return result, nil
+}
+
+/**
+ * @brief Parse BYDAY value of recurrence rule, e.g. MO,WE,FR or 1MO,-1FR
+ * @param value Value of BYDAY rule part
+ * @return Parsed weekdays
+ * @return Instance of error if any weekday is malformed
+ */
+func parseOciTaskRecurrenceDays(value string) ([]OciTaskRecurrenceDay, error) {
+	result := make([]OciTaskRecurrenceDay, 0)
+	for _, item := range strings.Split(value, ",") {
+		if len(item) < 2 {
+			return nil, fmt.Errorf("Invalid recurrence rule - BYDAY value %q is not a weekday", item)
+		}
+
+		weekday, ok := ociTaskRecurrenceWeekdays[item[len(item)-2:]]
+		if !ok {
+			return nil, fmt.Errorf("Invalid recurrence rule - BYDAY value %q is not a weekday", item)
+		}
+
+		ordinal := 0
+		if prefix := item[:len(item)-2]; prefix != "" {
+			number, err := strconv.Atoi(prefix)
+			if err != nil || number == 0 || number > 53 || number < -53 {
+				return nil, fmt.Errorf("Invalid recurrence rule - BYDAY value %q has invalid ordinal", item)
+			}
+			ordinal = number
+		}
+
+		result = append(result, OciTaskRecurrenceDay{Ordinal: ordinal, Weekday: weekday})
+	}
+
+	return result, nil
+}
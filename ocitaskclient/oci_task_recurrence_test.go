@@ -0,0 +1,150 @@
+package ocitaskclient
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func formatTestOccurrences(occurrences []time.Time) []string {
+	result := make([]string, 0, len(occurrences))
+	for _, occurrence := range occurrences {
+		result = append(result, occurrence.Format(time.RFC3339))
+	}
+
+	return result
+}
+
+func TestOciTaskRecurrenceDailyInterval(test *testing.T) {
+	recurrence, err := MakeOciTaskRecurrence("FREQ=DAILY;INTERVAL=2;COUNT=3", "2023-01-30T09:00:00", "UTC", nil)
+
+	assert.NoError(test, err, "TestOciTaskRecurrenceDailyInterval Failed: No error expected")
+	assert.Equal(test, []string{"2023-01-30T09:00:00Z", "2023-02-01T09:00:00Z", "2023-02-03T09:00:00Z"},
+		formatTestOccurrences(recurrence.Occurrences(recurrence.GetStart(), 10)), "TestOciTaskRecurrenceDailyInterval Failed: Wrong occurrences")
+}
+
+func TestOciTaskRecurrenceWeeklyByDay(test *testing.T) {
+	recurrence, err := MakeOciTaskRecurrence("RRULE:FREQ=WEEKLY;BYDAY=MO,WE,FR", "2023-02-01T08:30:00Z", "", nil)
+
+	assert.NoError(test, err, "TestOciTaskRecurrenceWeeklyByDay Failed: No error expected")
+	assert.Equal(test, []string{"2023-02-01T08:30:00Z", "2023-02-03T08:30:00Z", "2023-02-06T08:30:00Z", "2023-02-08T08:30:00Z"},
+		formatTestOccurrences(recurrence.Occurrences(recurrence.GetStart(), 4)), "TestOciTaskRecurrenceWeeklyByDay Failed: Wrong occurrences")
+}
+
+func TestOciTaskRecurrenceMonthlyLastFriday(test *testing.T) {
+	recurrence, err := MakeOciTaskRecurrence("FREQ=MONTHLY;BYDAY=-1FR", "2023-01-01T10:00:00", "UTC", nil)
+
+	assert.NoError(test, err, "TestOciTaskRecurrenceMonthlyLastFriday Failed: No error expected")
+	assert.Equal(test, []string{"2023-01-27T10:00:00Z", "2023-02-24T10:00:00Z", "2023-03-31T10:00:00Z"},
+		formatTestOccurrences(recurrence.Occurrences(recurrence.GetStart(), 3)), "TestOciTaskRecurrenceMonthlyLastFriday Failed: Wrong occurrences")
+}
+
+func TestOciTaskRecurrenceMonthlySkipsShortMonths(test *testing.T) {
+	recurrence, err := MakeOciTaskRecurrence("FREQ=MONTHLY", "2023-01-31T10:00:00", "UTC", nil)
+
+	assert.NoError(test, err, "TestOciTaskRecurrenceMonthlySkipsShortMonths Failed: No error expected")
+	assert.Equal(test, []string{"2023-01-31T10:00:00Z", "2023-03-31T10:00:00Z", "2023-05-31T10:00:00Z"},
+		formatTestOccurrences(recurrence.Occurrences(recurrence.GetStart(), 3)), "TestOciTaskRecurrenceMonthlySkipsShortMonths Failed: Months without day 31 expected to be skipped")
+}
+
+func TestOciTaskRecurrenceQuarterlyLastDay(test *testing.T) {
+	recurrence, err := MakeOciTaskRecurrence("FREQ=MONTHLY;INTERVAL=3;BYMONTHDAY=-1", "2023-01-01T00:00:00", "UTC", nil)
+
+	assert.NoError(test, err, "TestOciTaskRecurrenceQuarterlyLastDay Failed: No error expected")
+	assert.Equal(test, []string{"2023-01-31T00:00:00Z", "2023-04-30T00:00:00Z", "2023-07-31T00:00:00Z"},
+		formatTestOccurrences(recurrence.Occurrences(recurrence.GetStart(), 3)), "TestOciTaskRecurrenceQuarterlyLastDay Failed: Wrong occurrences")
+}
+
+func TestOciTaskRecurrenceYearlyByMonthAndDay(test *testing.T) {
+	recurrence, err := MakeOciTaskRecurrence("FREQ=YEARLY;BYMONTH=3;BYDAY=2SU", "2023-01-01T02:00:00", "UTC", nil)
+
+	assert.NoError(test, err, "TestOciTaskRecurrenceYearlyByMonthAndDay Failed: No error expected")
+	assert.Equal(test, []string{"2023-03-12T02:00:00Z", "2024-03-10T02:00:00Z"},
+		formatTestOccurrences(recurrence.Occurrences(recurrence.GetStart(), 2)), "TestOciTaskRecurrenceYearlyByMonthAndDay Failed: Wrong occurrences")
+}
+
+func TestOciTaskRecurrenceTimeZone(test *testing.T) {
+	recurrence, err := MakeOciTaskRecurrence("FREQ=DAILY", "2023-03-25T09:00:00", "Europe/Berlin", nil)
+
+	assert.NoError(test, err, "TestOciTaskRecurrenceTimeZone Failed: No error expected")
+	assert.Equal(test, []string{"2023-03-25T09:00:00+01:00", "2023-03-26T09:00:00+02:00"},
+		formatTestOccurrences(recurrence.Occurrences(recurrence.GetStart(), 2)), "TestOciTaskRecurrenceTimeZone Failed: Local time of day expected to be kept across DST change")
+}
+
+func TestOciTaskRecurrenceExdate(test *testing.T) {
+	recurrence, err := MakeOciTaskRecurrence("FREQ=DAILY;COUNT=4", "2023-02-01T09:00:00Z", "UTC", []string{"2023-02-02T09:00:00Z", "2023-02-03"})
+
+	assert.NoError(test, err, "TestOciTaskRecurrenceExdate Failed: No error expected")
+	assert.Equal(test, []string{"2023-02-01T09:00:00Z", "2023-02-04T09:00:00Z"},
+		formatTestOccurrences(recurrence.Occurrences(recurrence.GetStart(), 10)), "TestOciTaskRecurrenceExdate Failed: Excluded occurrences expected to count towards COUNT")
+}
+
+func TestOciTaskRecurrenceUntil(test *testing.T) {
+	recurrence, err := MakeOciTaskRecurrence("FREQ=WEEKLY;UNTIL=20230215", "2023-02-01T23:00:00", "UTC", nil)
+
+	assert.NoError(test, err, "TestOciTaskRecurrenceUntil Failed: No error expected")
+	assert.Equal(test, []string{"2023-02-01T23:00:00Z", "2023-02-08T23:00:00Z", "2023-02-15T23:00:00Z"},
+		formatTestOccurrences(recurrence.Occurrences(recurrence.GetStart(), 10)), "TestOciTaskRecurrenceUntil Failed: UNTIL date expected to be inclusive")
+}
+
+func TestOciTaskRecurrenceOccurrencesFrom(test *testing.T) {
+	recurrence, _ := MakeOciTaskRecurrence("FREQ=MONTHLY;BYMONTHDAY=1", "2023-01-01T00:00:00Z", "UTC", nil)
+
+	occurrences := recurrence.Occurrences(time.Date(2023, 5, 15, 0, 0, 0, 0, time.UTC), 2)
+
+	assert.Equal(test, []string{"2023-06-01T00:00:00Z", "2023-07-01T00:00:00Z"}, formatTestOccurrences(occurrences), "TestOciTaskRecurrenceOccurrencesFrom Failed: Wrong occurrences")
+}
+
+func TestOciTaskRecurrenceCurrent(test *testing.T) {
+	recurrence, _ := MakeOciTaskRecurrence("FREQ=MONTHLY;BYMONTHDAY=1;COUNT=6", "2023-01-01T00:00:00Z", "UTC", nil)
+
+	before, foundBefore := recurrence.Current(time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC))
+	during, foundDuring := recurrence.Current(time.Date(2023, 3, 15, 0, 0, 0, 0, time.UTC))
+	after, foundAfter := recurrence.Current(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	assert.True(test, foundBefore && foundDuring && foundAfter, "TestOciTaskRecurrenceCurrent Failed: Current occurrence expected")
+	assert.Equal(test, "2023-01-01T00:00:00Z", before.Format(time.RFC3339), "TestOciTaskRecurrenceCurrent Failed: First occurrence expected before start")
+	assert.Equal(test, "2023-03-01T00:00:00Z", during.Format(time.RFC3339), "TestOciTaskRecurrenceCurrent Failed: Latest started occurrence expected")
+	assert.Equal(test, "2023-06-01T00:00:00Z", after.Format(time.RFC3339), "TestOciTaskRecurrenceCurrent Failed: Last occurrence expected after end")
+}
+
+func TestOciTaskRecurrenceNeverMatches(test *testing.T) {
+	recurrence, _ := MakeOciTaskRecurrence("FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30", "2023-01-01T00:00:00Z", "UTC", nil)
+
+	_, found := recurrence.Current(time.Date(2023, 3, 15, 0, 0, 0, 0, time.UTC))
+
+	assert.False(test, found, "TestOciTaskRecurrenceNeverMatches Failed: No occurrence expected")
+	assert.Empty(test, recurrence.Occurrences(recurrence.GetStart(), 1), "TestOciTaskRecurrenceNeverMatches Failed: No occurrence expected")
+}
+
+func TestParseOciTaskRecurrenceRuleFailed(test *testing.T) {
+	rules := map[string]string{
+		"missing FREQ":       "INTERVAL=2",
+		"unsupported FREQ":   "FREQ=HOURLY",
+		"COUNT and UNTIL":    "FREQ=DAILY;COUNT=2;UNTIL=20230101",
+		"unsupported part":   "FREQ=MONTHLY;BYSETPOS=-1",
+		"weekly ordinal":     "FREQ=WEEKLY;BYDAY=1MO",
+		"bad weekday":        "FREQ=WEEKLY;BYDAY=XX",
+		"bad month day":      "FREQ=MONTHLY;BYMONTHDAY=32",
+		"duplicate part":     "FREQ=DAILY;FREQ=WEEKLY",
+		"malformed part":     "FREQ=DAILY;COUNT",
+		"non-positive count": "FREQ=DAILY;COUNT=0",
+	}
+
+	for name, rule := range rules {
+		_, err := ParseOciTaskRecurrenceRule(rule)
+
+		assert.Error(test, err, "TestParseOciTaskRecurrenceRuleFailed Failed: Error expected for "+name)
+	}
+}
+
+func TestMakeOciTaskRecurrenceFailed(test *testing.T) {
+	_, errZone := MakeOciTaskRecurrence("FREQ=DAILY", "2023-01-01T00:00:00", "Mars/Olympus_Mons", nil)
+	_, errStart := MakeOciTaskRecurrence("FREQ=DAILY", "01/01/2023", "UTC", nil)
+	_, errExdate := MakeOciTaskRecurrence("FREQ=DAILY", "2023-01-01T00:00:00", "UTC", []string{"tomorrow"})
+
+	assert.Error(test, errZone, "TestMakeOciTaskRecurrenceFailed Failed: Error expected for unknown time zone")
+	assert.Error(test, errStart, "TestMakeOciTaskRecurrenceFailed Failed: Error expected for malformed start")
+	assert.Error(test, errExdate, "TestMakeOciTaskRecurrenceFailed Failed: Error expected for malformed exdate")
+}
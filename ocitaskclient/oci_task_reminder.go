@@ -0,0 +1,146 @@
+package ocitaskclient
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+	"time"
+)
+
+/**
+ * @brief Channels OCI Task Service can deliver reminders to
+ */
+var OciTaskReminderChannels = []string{"email", "slack", "webhook"}
+
+/**
+ * @brief Container for reminder on Task in OCI Task System.
+ *			Reminder either fires on cron schedule or once at offset before due date of the Task.
+ */
+type OciTaskReminder struct {
+	Id            *int64  `json:"id,omitempty"`
+	TaskId        *int64  `json:"taskId,omitempty"`
+	Cron          *string `json:"cron,omitempty"`
+	OffsetSeconds *int64  `json:"offsetSeconds,omitempty"`
+	TimeZone      *string `json:"timeZone,omitempty"`
+	Channel       *string `json:"channel,omitempty"`
+}
+
+/**
+ * @brief Convert OciTaskReminder instance into generic map for Terraform resource data
+ * @return Generic map equivalent to OciTaskReminder. Offset is formatted as duration, e.g. 1h0m0s.
+ */
+func (ociTaskReminder *OciTaskReminder) Flatten() map[string]interface{} {
+	result := make(map[string]interface{})
+	result["id"] = 0
+	if ociTaskReminder.Id != nil {
+		result["id"] = int(*ociTaskReminder.Id)
+	}
+
+	result["cron"] = ""
+	if ociTaskReminder.Cron != nil {
+		result["cron"] = *ociTaskReminder.Cron
+	}
+
+	result["offset"] = ""
+	if ociTaskReminder.OffsetSeconds != nil {
+		result["offset"] = (time.Duration(*ociTaskReminder.OffsetSeconds) * time.Second).String()
+	}
+
+	result["timezone"] = "UTC"
+	if ociTaskReminder.TimeZone != nil && *ociTaskReminder.TimeZone != "" {
+		result["timezone"] = *ociTaskReminder.TimeZone
+	}
+
+	result["channel"] = ""
+	if ociTaskReminder.Channel != nil {
+		result["channel"] = *ociTaskReminder.Channel
+	}
+
+	return result
+}
+
+/**
+ * @brief Compute next time reminder fires
+ * @param dueDate Due date of the Task in epoch milliseconds, used by offset reminders
+ * @param now Reminders firing at or before this time are skipped
+ * @return Next time reminder fires, zero if it won't fire again
+ * @return Instance of error if schedule or time zone is malformed
+ */
+func (ociTaskReminder *OciTaskReminder) Next(dueDate *int64, now time.Time) (time.Time, error) {
+	location := time.UTC
+	if ociTaskReminder.TimeZone != nil && *ociTaskReminder.TimeZone != "" {
+		loaded, err := time.LoadLocation(*ociTaskReminder.TimeZone)
+		if err != nil {
+			return time.Time{}, err
+		}
+		location = loaded
+	}
+
+	if ociTaskReminder.Cron != nil {
+		cron, err := ParseOciTaskCron(*ociTaskReminder.Cron)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		next, ok := cron.Next(now.In(location))
+		if !ok {
+			return time.Time{}, nil
+		}
+		return next, nil
+	}
+
+	if ociTaskReminder.OffsetSeconds != nil {
+		if dueDate == nil {
+			return time.Time{}, nil
+		}
+
+		next := time.UnixMilli(*dueDate).Add(-time.Duration(*ociTaskReminder.OffsetSeconds) * time.Second).In(location)
+		if !next.After(now) {
+			return time.Time{}, nil
+		}
+		return next, nil
+	}
+
+	return time.Time{}, errors.New("Reminder has neither cron schedule nor offset")
+}
+
+/**
+ * @brief Convert OciTaskReminder object into JSON String
+ * @return JSON String equivalent to OciTaskReminder object if succeeded
+ * @return Instance of error if failed
+ */
+func (ociTaskReminder *OciTaskReminder) Serialize() (string, error) {
+	result := ""
+	data, err := json.Marshal(ociTaskReminder)
+	if err == nil {
+		result = string(data)
+	}
+
+	return result, err
+}
+
+/**
+ * @brief Convert JSON String into OciTaskReminder object
+ * @param data JSON String equivalent to OciTaskReminder object
+ * @return Instance of error if failed
+ */
+func (ociTaskReminder *OciTaskReminder) Deserialize(data []byte) error {
+	return json.Unmarshal(data, ociTaskReminder)
+}
+
+/**
+ * @brief Sort reminders by Identifier, i.e. in order of creation
+ * @param reminders Reminders of Task, sorted in place
+ */
+func SortOciTaskReminders(reminders []OciTaskReminder) {
+	sort.SliceStable(reminders, func(i, j int) bool {
+		left, right := int64(0), int64(0)
+		if reminders[i].Id != nil {
+			left = *reminders[i].Id
+		}
+		if reminders[j].Id != nil {
+			right = *reminders[j].Id
+		}
+		return left < right
+	})
+}
@@ -0,0 +1,76 @@
+package ocitaskclient
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOciTaskReminderNextCron(test *testing.T) {
+	cron, timeZone := "0 9 * * *", "America/New_York"
+	reminder := OciTaskReminder{Cron: &cron, TimeZone: &timeZone}
+
+	next, err := reminder.Next(nil, time.Date(2023, 3, 15, 10, 0, 0, 0, time.UTC))
+
+	assert.NoError(test, err, "TestOciTaskReminderNextCron Failed: No error expected")
+	assert.Equal(test, time.Date(2023, 3, 15, 13, 0, 0, 0, time.UTC), next.UTC(), "TestOciTaskReminderNextCron Failed: Wrong next reminder time")
+}
+
+func TestOciTaskReminderNextOffset(test *testing.T) {
+	offset := int64(3600)
+	dueDate := time.Date(2023, 3, 20, 12, 0, 0, 0, time.UTC).UnixMilli()
+	reminder := OciTaskReminder{OffsetSeconds: &offset}
+
+	next, err := reminder.Next(&dueDate, time.Date(2023, 3, 15, 10, 0, 0, 0, time.UTC))
+	assert.NoError(test, err, "TestOciTaskReminderNextOffset Failed: No error expected")
+	assert.Equal(test, time.Date(2023, 3, 20, 11, 0, 0, 0, time.UTC), next, "TestOciTaskReminderNextOffset Failed: Wrong next reminder time")
+
+	next, err = reminder.Next(&dueDate, time.Date(2023, 3, 20, 11, 30, 0, 0, time.UTC))
+	assert.NoError(test, err, "TestOciTaskReminderNextOffset Failed: No error expected")
+	assert.True(test, next.IsZero(), "TestOciTaskReminderNextOffset Failed: No reminder expected after it fired")
+
+	next, err = reminder.Next(nil, time.Date(2023, 3, 15, 10, 0, 0, 0, time.UTC))
+	assert.NoError(test, err, "TestOciTaskReminderNextOffset Failed: No error expected")
+	assert.True(test, next.IsZero(), "TestOciTaskReminderNextOffset Failed: No reminder expected without due date")
+}
+
+func TestOciTaskReminderNextFailed(test *testing.T) {
+	cron, timeZone := "0 9 * * *", "Mars/Olympus_Mons"
+
+	_, err := (&OciTaskReminder{Cron: &cron, TimeZone: &timeZone}).Next(nil, time.Now())
+	assert.Error(test, err, "TestOciTaskReminderNextFailed Failed: Error expected for unknown time zone")
+
+	_, err = (&OciTaskReminder{}).Next(nil, time.Now())
+	assert.Error(test, err, "TestOciTaskReminderNextFailed Failed: Error expected without schedule")
+}
+
+func TestSortOciTaskReminders(test *testing.T) {
+	first, second := int64(1), int64(2)
+	reminders := []OciTaskReminder{{Id: &second}, {Id: &first}}
+
+	SortOciTaskReminders(reminders)
+
+	assert.Equal(test, first, *reminders[0].Id, "TestSortOciTaskReminders Failed: Reminders expected in Id order")
+}
+
+func TestOciTaskReminderDeserializeFailed(test *testing.T) {
+	reminder := OciTaskReminder{}
+
+	err := reminder.Deserialize([]byte("\"Test Error Message\""))
+
+	assert.Error(test, err, "TestOciTaskReminderDeserializeFailed Failed")
+}
+
+func TestOciTaskReminderFlatten(test *testing.T) {
+	id, offset, channel := int64(3), int64(5400), "slack"
+	reminder := OciTaskReminder{Id: &id, OffsetSeconds: &offset, Channel: &channel}
+
+	data := reminder.Flatten()
+
+	assert.Equal(test, 3, data["id"], "TestOciTaskReminderFlatten Failed: Wrong Reminder Id")
+	assert.Equal(test, "", data["cron"], "TestOciTaskReminderFlatten Failed: Empty Reminder Cron expected")
+	assert.Equal(test, "1h30m0s", data["offset"], "TestOciTaskReminderFlatten Failed: Wrong Reminder Offset")
+	assert.Equal(test, "UTC", data["timezone"], "TestOciTaskReminderFlatten Failed: UTC expected by default")
+	assert.Equal(test, "slack", data["channel"], "TestOciTaskReminderFlatten Failed: Wrong Reminder Channel")
+}
@@ -0,0 +1,52 @@
+package ocitaskclient
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+)
+
+/**
+ * @brief HTTP header carrying request identifier sent by client and echoed back by OCI Task Service
+ */
+const OciTaskRequestIdHeader string = "opc-request-id"
+
+/**
+ * @brief Key for request identifier stored in context
+ */
+type ociTaskRequestIdKey struct{}
+
+/**
+ * @brief Generate new request identifier
+ * @return Random request identifier
+ */
+func MakeOciTaskRequestId() string {
+	requestId, err := uuid.GenerateUUID()
+	if err != nil {
+		return fmt.Sprintf("ocitask-%d", time.Now().UnixNano())
+	}
+
+	return requestId
+}
+
+/**
+ * @brief Store request identifier in context. Client sends it instead of generating a new one.
+ * @param ctx Parent context
+ * @param requestId Request identifier
+ * @return Context carrying request identifier
+ */
+func ContextWithOciTaskRequestId(ctx context.Context, requestId string) context.Context {
+	return context.WithValue(ctx, ociTaskRequestIdKey{}, requestId)
+}
+
+/**
+ * @brief Read request identifier stored in context
+ * @param ctx Context
+ * @return Request identifier, empty if not stored
+ */
+func OciTaskRequestIdFromContext(ctx context.Context) string {
+	requestId, _ := ctx.Value(ociTaskRequestIdKey{}).(string)
+	return requestId
+}
@@ -0,0 +1,23 @@
+package ocitaskclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMakeOciTaskRequestIdUnique(test *testing.T) {
+	first := MakeOciTaskRequestId()
+	second := MakeOciTaskRequestId()
+
+	assert.NotEmpty(test, first, "TestMakeOciTaskRequestIdUnique Failed: Request id expected")
+	assert.NotEqual(test, first, second, "TestMakeOciTaskRequestIdUnique Failed: Unique request ids expected")
+}
+
+func TestOciTaskRequestIdContext(test *testing.T) {
+	ctx := ContextWithOciTaskRequestId(context.Background(), "client-1001")
+
+	assert.Equal(test, "client-1001", OciTaskRequestIdFromContext(ctx), "TestOciTaskRequestIdContext Failed: Request id doesn't match with expected value")
+	assert.Equal(test, "", OciTaskRequestIdFromContext(context.Background()), "TestOciTaskRequestIdContext Failed: Empty request id expected")
+}
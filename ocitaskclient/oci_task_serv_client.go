@@ -1,22 +1,83 @@
 package ocitaskclient
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
-	"log"
+	"mime/multipart"
 	"net/http"
+	"net/url"
+	"runtime"
 	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 /**
  * @brief Interface for OCI Task Service
  */
 type OciTaskServClientInterface interface {
-	CreateTask(ociTaskServRequest *OciTaskServRequest) (*OciTaskServResponse, error)
-	UpdateTask(taskId *int64, ociTaskServRequest *OciTaskServRequest) (*OciTaskServResponse, error)
-	GetTask(taskId *int64) (*OciTaskServResponse, error)
-	DeleteTask(taskId *int64) (*OciTaskServResponse, error)
+	CreateTask(ctx context.Context, ociTaskServRequest *OciTaskServRequest) (*OciTaskServResponse, error)
+	UpdateTask(ctx context.Context, taskId *int64, ociTaskServRequest *OciTaskServRequest) (*OciTaskServResponse, error)
+	GetTask(ctx context.Context, taskId *int64) (*OciTaskServResponse, error)
+	DeleteTask(ctx context.Context, taskId *int64) (*OciTaskServResponse, error)
+	ArchiveTask(ctx context.Context, taskId *int64) (*OciTaskServResponse, error)
+	RestoreTask(ctx context.Context, taskId *int64) (*OciTaskServResponse, error)
+	ListTasks(ctx context.Context, filter *OciTaskListFilter) (*OciTaskServResponse, error)
+	ListChildTasks(ctx context.Context, taskId *int64) (*OciTaskServResponse, error)
+	WatchTasks(ctx context.Context, cursor string) (*OciTaskWatch, error)
+	CreateTaskDependency(ctx context.Context, dependency *OciTaskDependency) (*OciTaskServResponse, error)
+	GetTaskDependency(ctx context.Context, dependencyId *int64) (*OciTaskServResponse, error)
+	DeleteTaskDependency(ctx context.Context, dependencyId *int64) (*OciTaskServResponse, error)
+	ListTaskDependencies(ctx context.Context, taskIds []int64) (*OciTaskServResponse, error)
+	CreateProject(ctx context.Context, project *OciTaskProject) (*OciTaskServResponse, error)
+	UpdateProject(ctx context.Context, projectId *int64, project *OciTaskProject) (*OciTaskServResponse, error)
+	GetProject(ctx context.Context, projectId *int64) (*OciTaskServResponse, error)
+	DeleteProject(ctx context.Context, projectId *int64, cascade bool) (*OciTaskServResponse, error)
+	GetUserByEmail(ctx context.Context, email string) (*OciTaskServResponse, error)
+	CreateTaskComment(ctx context.Context, taskId *int64, comment *OciTaskComment) (*OciTaskServResponse, error)
+	GetTaskComment(ctx context.Context, taskId *int64, commentId *int64) (*OciTaskServResponse, error)
+	UpdateTaskComment(ctx context.Context, taskId *int64, commentId *int64, comment *OciTaskComment) (*OciTaskServResponse, error)
+	DeleteTaskComment(ctx context.Context, taskId *int64, commentId *int64) (*OciTaskServResponse, error)
+	ListTaskComments(ctx context.Context, taskId *int64) (*OciTaskServResponse, error)
+	UploadTaskAttachment(ctx context.Context, taskId *int64, fileName string, content io.Reader) (*OciTaskServResponse, error)
+	GetTaskAttachment(ctx context.Context, taskId *int64, attachmentId *int64) (*OciTaskServResponse, error)
+	DeleteTaskAttachment(ctx context.Context, taskId *int64, attachmentId *int64) (*OciTaskServResponse, error)
+	DownloadTaskAttachment(ctx context.Context, taskId *int64, attachmentId *int64, content io.Writer) error
+	CreateTaskReminder(ctx context.Context, taskId *int64, reminder *OciTaskReminder) (*OciTaskServResponse, error)
+	GetTaskReminder(ctx context.Context, taskId *int64, reminderId *int64) (*OciTaskServResponse, error)
+	UpdateTaskReminder(ctx context.Context, taskId *int64, reminderId *int64, reminder *OciTaskReminder) (*OciTaskServResponse, error)
+	DeleteTaskReminder(ctx context.Context, taskId *int64, reminderId *int64) (*OciTaskServResponse, error)
+	ListTaskReminders(ctx context.Context, taskId *int64) (*OciTaskServResponse, error)
+	CreateTaskTimeEntry(ctx context.Context, taskId *int64, timeEntry *OciTaskTimeEntry) (*OciTaskServResponse, error)
+	GetTaskTimeEntry(ctx context.Context, taskId *int64, timeEntryId *int64) (*OciTaskServResponse, error)
+	UpdateTaskTimeEntry(ctx context.Context, taskId *int64, timeEntryId *int64, timeEntry *OciTaskTimeEntry) (*OciTaskServResponse, error)
+	DeleteTaskTimeEntry(ctx context.Context, taskId *int64, timeEntryId *int64) (*OciTaskServResponse, error)
+	ListTaskTimeEntries(ctx context.Context, taskId *int64) (*OciTaskServResponse, error)
+	GetTaskHistory(ctx context.Context, taskId *int64) (*OciTaskServResponse, error)
+	CreateWebhook(ctx context.Context, webhook *OciTaskWebhook) (*OciTaskServResponse, error)
+	GetWebhook(ctx context.Context, webhookId *int64) (*OciTaskServResponse, error)
+	UpdateWebhook(ctx context.Context, webhookId *int64, webhook *OciTaskWebhook) (*OciTaskServResponse, error)
+	DeleteWebhook(ctx context.Context, webhookId *int64) (*OciTaskServResponse, error)
+	GrantPermission(ctx context.Context, permission *OciTaskPermission) (*OciTaskServResponse, error)
+	GetPermission(ctx context.Context, targetType string, targetId *int64, permissionId *int64) (*OciTaskServResponse, error)
+	RevokePermission(ctx context.Context, targetType string, targetId *int64, permissionId *int64) (*OciTaskServResponse, error)
+	ListPermissions(ctx context.Context, targetType string, targetId *int64) (*OciTaskServResponse, error)
+	ListEffectivePermissions(ctx context.Context, targetType string, targetId *int64, principalType string, principal string) (*OciTaskServResponse, error)
+	CreateCustomField(ctx context.Context, projectId *int64, customField *OciTaskCustomField) (*OciTaskServResponse, error)
+	GetCustomField(ctx context.Context, projectId *int64, customFieldId *int64) (*OciTaskServResponse, error)
+	UpdateCustomField(ctx context.Context, projectId *int64, customFieldId *int64, customField *OciTaskCustomField) (*OciTaskServResponse, error)
+	DeleteCustomField(ctx context.Context, projectId *int64, customFieldId *int64) (*OciTaskServResponse, error)
+	ListCustomFields(ctx context.Context, projectId *int64) (*OciTaskServResponse, error)
+}
+
+/**
+ * @brief Body of request to OCI Task Service
+ */
+type ociTaskRequestBody interface {
+	Serialize() (string, error)
 }
 
 /**
@@ -25,6 +86,8 @@ type OciTaskServClientInterface interface {
 type OciTaskServClient struct {
 	httpClient OciTaskHttpInterface
 	hostUrl    *string
+	metrics    *OciTaskMetrics
+	userAgent  string
 }
 
 /**
@@ -37,6 +100,8 @@ func MakeOciTaskServClient(hostUrl *string) *OciTaskServClient {
 	return &OciTaskServClient{
 		httpClient: &client,
 		hostUrl:    hostUrl,
+		metrics:    MakeOciTaskMetrics(),
+		userAgent:  fmt.Sprintf("ocitaskclient (%s; %s/%s)", runtime.Version(), runtime.GOOS, runtime.GOARCH),
 	}
 }
 
@@ -48,195 +113,1577 @@ func (ociTaskServClient *OciTaskServClient) GetUrl() string {
 	return *ociTaskServClient.hostUrl
 }
 
+/**
+ * @brief Getter function for metrics collected by this client
+ * @return Instance of OciTaskMetrics, nil if metrics not collected
+ */
+func (ociTaskServClient *OciTaskServClient) GetMetrics() *OciTaskMetrics {
+	return ociTaskServClient.metrics
+}
+
+/**
+ * @brief Setter function for metrics collector. Lets several clients share one collector.
+ * @param metrics Instance of OciTaskMetrics, nil to stop collecting metrics
+ */
+func (ociTaskServClient *OciTaskServClient) SetMetrics(metrics *OciTaskMetrics) {
+	ociTaskServClient.metrics = metrics
+}
+
+/**
+ * @brief Getter function for User-Agent header sent to OCI Task Service
+ * @return User-Agent header value
+ */
+func (ociTaskServClient *OciTaskServClient) GetUserAgent() string {
+	return ociTaskServClient.userAgent
+}
+
+/**
+ * @brief Setter function for User-Agent header sent to OCI Task Service
+ * @param userAgent User-Agent header value, empty to use Go HTTP client default
+ */
+func (ociTaskServClient *OciTaskServClient) SetUserAgent(userAgent string) {
+	ociTaskServClient.userAgent = userAgent
+}
+
 /**
  * @brief Public method to cretae Task using OCI Task Service.
  *			Returns Task Idetifier if succeeded.
  *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
  * @param ociTaskServRequest Request to OCI Task Service
  * @return Instance of OciTaskServResponse
  * @return Instance of error if failed
  */
-func (ociTaskServClient *OciTaskServClient) CreateTask(ociTaskServRequest *OciTaskServRequest) (*OciTaskServResponse, error) {
+func (ociTaskServClient *OciTaskServClient) CreateTask(ctx context.Context, ociTaskServRequest *OciTaskServRequest) (*OciTaskServResponse, error) {
 	if ociTaskServRequest == nil {
 		return nil, errors.New("Invalid Argument - please check Api Request")
 	}
 
-	apiRequest, err := ociTaskServClient.buildRequest("POST", fmt.Sprintf("%s/tasks", *ociTaskServClient.hostUrl), ociTaskServRequest)
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	apiRequest, err := ociTaskServClient.buildRequest(ctx, "POST", fmt.Sprintf("%s/tasks", *ociTaskServClient.hostUrl), ociTaskServRequest)
 	if err != nil {
 		return nil, err
 	}
 
-	apiResp, body, err := ociTaskServClient.sendRequest(apiRequest)
+	apiResp, body, err := ociTaskServClient.sendRequest(ctx, "CreateTask", apiRequest, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	if apiResp.StatusCode != http.StatusCreated {
-		errMsg := fmt.Sprintf("Create Task failed - status: %d, body: %s", apiResp.StatusCode, string(body))
-		log.Println(errMsg)
-		return nil, errors.New(errMsg)
+	err = ociTaskServClient.checkStatus(ctx, "CreateTask", apiRequest, apiResp, body, http.StatusCreated)
+	if err != nil {
+		return nil, err
 	}
 
-	ociTaskServResponse := OciTaskServResponse{}
-	errResp := ociTaskServResponse.Deserialize(body)
-	return &ociTaskServResponse, errResp
+	return ociTaskServClient.parseResponse(ctx, apiRequest, apiResp, body)
 }
 
 /**
  * @brief Public method to update Task using OCI Task Service.
  *			Returns Task Idetifier if succeeded.
  *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
  * @param taskId Identifier of the Task
  * @param ociTaskServRequest Request to OCI Task Service
  * @return Instance of OciTaskServResponse
  * @return Instance of error if failed
  */
-func (ociTaskServClient *OciTaskServClient) UpdateTask(taskId *int64, ociTaskServRequest *OciTaskServRequest) (*OciTaskServResponse, error) {
+func (ociTaskServClient *OciTaskServClient) UpdateTask(ctx context.Context, taskId *int64, ociTaskServRequest *OciTaskServRequest) (*OciTaskServResponse, error) {
 	if taskId == nil || ociTaskServRequest == nil {
 		return nil, errors.New("Invalid Argument - please check Id or Api Request")
 	}
 
-	apiRequest, err := ociTaskServClient.buildRequest("PUT", fmt.Sprintf("%s/tasks/%d", *ociTaskServClient.hostUrl, *taskId), ociTaskServRequest)
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	apiRequest, err := ociTaskServClient.buildRequest(ctx, "PUT", fmt.Sprintf("%s/tasks/%d", *ociTaskServClient.hostUrl, *taskId), ociTaskServRequest)
 	if err != nil {
 		return nil, err
 	}
 
-	apiResp, body, err := ociTaskServClient.sendRequest(apiRequest)
+	apiResp, body, err := ociTaskServClient.sendRequest(ctx, "UpdateTask", apiRequest, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	if apiResp.StatusCode != http.StatusOK {
-		errMsg := fmt.Sprintf("Update Task failed - status: %d, body: %s", apiResp.StatusCode, string(body))
-		log.Println(errMsg)
-		return nil, errors.New(errMsg)
+	err = ociTaskServClient.checkStatus(ctx, "UpdateTask", apiRequest, apiResp, body, http.StatusOK)
+	if err != nil {
+		return nil, err
 	}
 
-	ociTaskServResponse := OciTaskServResponse{}
-	errResp := ociTaskServResponse.Deserialize(body)
-	return &ociTaskServResponse, errResp
+	return ociTaskServClient.parseResponse(ctx, apiRequest, apiResp, body)
 }
 
 /**
  * @brief Public method to read Task using OCI Task Service.
  *			Returns OciTask instance if succeeded.
  *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
  * @param taskId Identifier of the Task
  * @return Instance of OciTaskServResponse
  * @return Instance of error if failed
  */
-func (ociTaskServClient *OciTaskServClient) GetTask(taskId *int64) (*OciTaskServResponse, error) {
+func (ociTaskServClient *OciTaskServClient) GetTask(ctx context.Context, taskId *int64) (*OciTaskServResponse, error) {
 	if taskId == nil {
 		return nil, errors.New("Invalid Argument - please check Id")
 	}
 
-	apiRequest, err := ociTaskServClient.buildRequest("GET", fmt.Sprintf("%s/tasks/%d", *ociTaskServClient.hostUrl, *taskId), nil)
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	apiRequest, err := ociTaskServClient.buildRequest(ctx, "GET", fmt.Sprintf("%s/tasks/%d", *ociTaskServClient.hostUrl, *taskId), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	apiResp, body, err := ociTaskServClient.sendRequest(apiRequest)
+	apiResp, body, err := ociTaskServClient.sendRequest(ctx, "GetTask", apiRequest, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	if apiResp.StatusCode != http.StatusOK {
-		errMsg := fmt.Sprintf("Get Task failed - status: %d, body: %s", apiResp.StatusCode, string(body))
-		log.Println(errMsg)
-		return nil, errors.New(errMsg)
+	err = ociTaskServClient.checkStatus(ctx, "GetTask", apiRequest, apiResp, body, http.StatusOK)
+	if err != nil {
+		return nil, err
 	}
 
-	ociTaskServResponse := OciTaskServResponse{}
-	errResp := ociTaskServResponse.Deserialize(body)
-	return &ociTaskServResponse, errResp
+	return ociTaskServClient.parseResponse(ctx, apiRequest, apiResp, body)
 }
 
 /**
  * @brief Public method to delete Task using OCI Task Service.
  *			Returns nothing if succeeded.
  *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
  * @param taskId Identifier of the Task
  * @return Instance of OciTaskServResponse
  * @return Instance of error if failed
  */
-func (ociTaskServClient *OciTaskServClient) DeleteTask(taskId *int64) (*OciTaskServResponse, error) {
+func (ociTaskServClient *OciTaskServClient) DeleteTask(ctx context.Context, taskId *int64) (*OciTaskServResponse, error) {
 	if taskId == nil {
 		return nil, errors.New("Invalid Argument - please check Id")
 	}
 
-	apiRequest, err := ociTaskServClient.buildRequest("DELETE", fmt.Sprintf("%s/tasks/%d", *ociTaskServClient.hostUrl, *taskId), nil)
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	apiRequest, err := ociTaskServClient.buildRequest(ctx, "DELETE", fmt.Sprintf("%s/tasks/%d", *ociTaskServClient.hostUrl, *taskId), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	apiResp, body, err := ociTaskServClient.sendRequest(apiRequest)
+	apiResp, body, err := ociTaskServClient.sendRequest(ctx, "DeleteTask", apiRequest, nil)
 	if err != nil {
 		return nil, err
 	}
 
-	if apiResp.StatusCode != http.StatusOK {
-		errMsg := fmt.Sprintf("Delete Task failed - status: %d, body: %s", apiResp.StatusCode, string(body))
-		log.Println(errMsg)
-		return nil, errors.New(errMsg)
+	err = ociTaskServClient.checkStatus(ctx, "DeleteTask", apiRequest, apiResp, body, http.StatusOK)
+	if err != nil {
+		return nil, err
 	}
 
-	ociTaskServResponse := OciTaskServResponse{}
-	errResp := ociTaskServResponse.Deserialize(body)
-	return &ociTaskServResponse, errResp
+	return ociTaskServClient.parseResponse(ctx, apiRequest, apiResp, body)
 }
 
 /**
- * @brief Private method to build OCI Task Service HTTP request.
- * @param method HTTP Method (GET, POST, PUT or DELETE)
- * @param url HTTP URL to OCI Task Service
- * @param ociRequest Instance of OciTaskServRequest. This is optional.
- * @return Instance of http.Request if succeeded
+ * @brief Public method to archive Task using OCI Task Service.
+ *			Archived Task is hidden from lists but keeps its history, so it can be restored.
+ *			Returns nothing if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param taskId Identifier of the Task
+ * @return Instance of OciTaskServResponse
  * @return Instance of error if failed
  */
-func (ociTaskServClient *OciTaskServClient) buildRequest(method string, url string, ociRequest *OciTaskServRequest) (*http.Request, error) {
-	var body io.Reader = nil
-	if ociRequest != nil {
-		strReq, err := ociRequest.Serialize()
-		if err != nil {
-			return nil, err
-		}
+func (ociTaskServClient *OciTaskServClient) ArchiveTask(ctx context.Context, taskId *int64) (*OciTaskServResponse, error) {
+	if taskId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
 
-		body = strings.NewReader(strReq)
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "ArchiveTask", "POST", fmt.Sprintf("%s/tasks/%d/archive", *ociTaskServClient.hostUrl, *taskId), nil, http.StatusOK)
+}
+
+/**
+ * @brief Public method to restore archived Task using OCI Task Service.
+ *			Returns nothing if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param taskId Identifier of the Task
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) RestoreTask(ctx context.Context, taskId *int64) (*OciTaskServResponse, error) {
+	if taskId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "RestoreTask", "POST", fmt.Sprintf("%s/tasks/%d/restore", *ociTaskServClient.hostUrl, *taskId), nil, http.StatusOK)
+}
+
+/**
+ * @brief Public method to list Tasks using OCI Task Service.
+ *			Returns OciTask instances matching filter if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param filter Instance of OciTaskListFilter, nil to list all Tasks
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) ListTasks(ctx context.Context, filter *OciTaskListFilter) (*OciTaskServResponse, error) {
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	url := fmt.Sprintf("%s/tasks", *ociTaskServClient.hostUrl)
+	query := filter.QueryString()
+	if query != "" {
+		url += "?" + query
 	}
 
-	apiRequest, err := http.NewRequest(method, url, body)
+	apiRequest, err := ociTaskServClient.buildRequest(ctx, "GET", url, nil)
 	if err != nil {
-		log.Println(fmt.Sprintf("Failed to build request to OCI Task Management Service - error=%s", err))
 		return nil, err
 	}
 
-	return apiRequest, nil
+	apiResp, body, err := ociTaskServClient.sendRequest(ctx, "ListTasks", apiRequest, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ociTaskServClient.checkStatus(ctx, "ListTasks", apiRequest, apiResp, body, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	ociTaskServResponse, err := ociTaskServClient.parseResponse(ctx, apiRequest, apiResp, body)
+	if err != nil {
+		return ociTaskServResponse, err
+	}
+
+	// Service may ignore filters it doesn't support, so apply them to results as well
+	tasks := make([]OciTask, 0, len(ociTaskServResponse.Tasks))
+	for i := range ociTaskServResponse.Tasks {
+		if filter.Match(&ociTaskServResponse.Tasks[i]) {
+			tasks = append(tasks, ociTaskServResponse.Tasks[i])
+		}
+	}
+	ociTaskServResponse.Tasks = tasks
+
+	return ociTaskServResponse, nil
 }
 
 /**
- * @brief Private method to send HTTP request OCI Task Service.
- * @param apiRequest Instance of http.Request
- * @return Instance of http.Response if succeeded
- * @return Instance of http.Response Body if succeeded
+ * @brief Public method to list direct children of Task using OCI Task Service.
+ *			Returns child Tasks if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param taskId Identifier of parent Task
+ * @return Instance of OciTaskServResponse
  * @return Instance of error if failed
  */
-func (ociTaskServClient *OciTaskServClient) sendRequest(apiRequest *http.Request) (*http.Response, []byte, error) {
-	apiRequest.Header.Set("Content-Type", "application/json")
-	apiRequest.Header.Set("Accept", "application/json")
+func (ociTaskServClient *OciTaskServClient) ListChildTasks(ctx context.Context, taskId *int64) (*OciTaskServResponse, error) {
+	if taskId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
 
-	apiResp, err := ociTaskServClient.httpClient.SendRequest(apiRequest)
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	apiRequest, err := ociTaskServClient.buildRequest(ctx, "GET", fmt.Sprintf("%s/tasks/%d/children", *ociTaskServClient.hostUrl, *taskId), nil)
 	if err != nil {
-		log.Println(fmt.Sprintf("Failed to send request to OCI Task Management Service - error=%s", err))
-		return nil, nil, err
+		return nil, err
 	}
 
-	defer apiResp.Body.Close()
+	apiResp, body, err := ociTaskServClient.sendRequest(ctx, "ListChildTasks", apiRequest, nil)
+	if err != nil {
+		return nil, err
+	}
 
-	body, err := ociTaskServClient.httpClient.IoRead(apiResp.Body)
+	err = ociTaskServClient.checkStatus(ctx, "ListChildTasks", apiRequest, apiResp, body, http.StatusOK)
 	if err != nil {
-		log.Println(fmt.Sprintf("Failed to read response from OCI Task Management Service - error=%s", err))
-		return apiResp, nil, err
+		return nil, err
 	}
 
-	return apiResp, body, nil
+	return ociTaskServClient.parseResponse(ctx, apiRequest, apiResp, body)
+}
+
+/**
+ * @brief Public method to create dependency between Tasks using OCI Task Service.
+ *			Returns created dependency if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param dependency Instance of OciTaskDependency
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) CreateTaskDependency(ctx context.Context, dependency *OciTaskDependency) (*OciTaskServResponse, error) {
+	if dependency == nil || dependency.BlockerId == nil || dependency.BlockedId == nil {
+		return nil, errors.New("Invalid Argument - please check Dependency")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "CreateTaskDependency", "POST", fmt.Sprintf("%s/dependencies", *ociTaskServClient.hostUrl), dependency, http.StatusCreated)
+}
+
+/**
+ * @brief Public method to read dependency between Tasks using OCI Task Service.
+ *			Returns OciTaskDependency instance if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param dependencyId Identifier of the dependency
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) GetTaskDependency(ctx context.Context, dependencyId *int64) (*OciTaskServResponse, error) {
+	if dependencyId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "GetTaskDependency", "GET", fmt.Sprintf("%s/dependencies/%d", *ociTaskServClient.hostUrl, *dependencyId), nil, http.StatusOK)
+}
+
+/**
+ * @brief Public method to delete dependency between Tasks using OCI Task Service.
+ *			Returns nothing if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param dependencyId Identifier of the dependency
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) DeleteTaskDependency(ctx context.Context, dependencyId *int64) (*OciTaskServResponse, error) {
+	if dependencyId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "DeleteTaskDependency", "DELETE", fmt.Sprintf("%s/dependencies/%d", *ociTaskServClient.hostUrl, *dependencyId), nil, http.StatusOK)
+}
+
+/**
+ * @brief Public method to list dependencies of Tasks using OCI Task Service.
+ *			Returns dependencies in which any of the Tasks is blocker or blocked Task if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param taskIds Identifiers of Tasks, nil to list all dependencies
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) ListTaskDependencies(ctx context.Context, taskIds []int64) (*OciTaskServResponse, error) {
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	url := fmt.Sprintf("%s/dependencies", *ociTaskServClient.hostUrl)
+	if len(taskIds) > 0 {
+		params := make([]string, 0, len(taskIds))
+		for _, taskId := range taskIds {
+			params = append(params, fmt.Sprintf("taskId=%d", taskId))
+		}
+		url += "?" + strings.Join(params, "&")
+	}
+
+	return ociTaskServClient.call(ctx, "ListTaskDependencies", "GET", url, nil, http.StatusOK)
+}
+
+/**
+ * @brief Public method to create Project using OCI Task Service.
+ *			Returns created Project if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param project Instance of OciTaskProject
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) CreateProject(ctx context.Context, project *OciTaskProject) (*OciTaskServResponse, error) {
+	if project == nil || project.Name == nil {
+		return nil, errors.New("Invalid Argument - please check Project")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "CreateProject", "POST", fmt.Sprintf("%s/projects", *ociTaskServClient.hostUrl), project, http.StatusCreated)
+}
+
+/**
+ * @brief Public method to update Project using OCI Task Service.
+ *			Returns updated Project if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param projectId Identifier of the Project
+ * @param project Instance of OciTaskProject
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) UpdateProject(ctx context.Context, projectId *int64, project *OciTaskProject) (*OciTaskServResponse, error) {
+	if projectId == nil || project == nil {
+		return nil, errors.New("Invalid Argument - please check Id or Project")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "UpdateProject", "PUT", fmt.Sprintf("%s/projects/%d", *ociTaskServClient.hostUrl, *projectId), project, http.StatusOK)
+}
+
+/**
+ * @brief Public method to read Project using OCI Task Service.
+ *			Returns OciTaskProject instance if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param projectId Identifier of the Project
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) GetProject(ctx context.Context, projectId *int64) (*OciTaskServResponse, error) {
+	if projectId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "GetProject", "GET", fmt.Sprintf("%s/projects/%d", *ociTaskServClient.hostUrl, *projectId), nil, http.StatusOK)
+}
+
+/**
+ * @brief Public method to delete Project using OCI Task Service.
+ *			Returns nothing if succeeded.
+ *			Returns instance of OciError if failed, e.g. if Project still has Tasks and cascade is not requested.
+ * @param ctx Context for logging and cancellation
+ * @param projectId Identifier of the Project
+ * @param cascade True to delete Tasks of the Project as well
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) DeleteProject(ctx context.Context, projectId *int64, cascade bool) (*OciTaskServResponse, error) {
+	if projectId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	url := fmt.Sprintf("%s/projects/%d", *ociTaskServClient.hostUrl, *projectId)
+	if cascade {
+		url += "?cascade=true"
+	}
+
+	return ociTaskServClient.call(ctx, "DeleteProject", "DELETE", url, nil, http.StatusOK)
+}
+
+/**
+ * @brief Public method to look up user by e-mail address using OCI Task Service.
+ *			Returns matching user in User if succeeded, User is nil if no user has the address.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param email E-mail address of user, case-insensitive
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) GetUserByEmail(ctx context.Context, email string) (*OciTaskServResponse, error) {
+	email = NormalizeOciTaskUserEmail(email)
+	if email == "" {
+		return nil, errors.New("Invalid Argument - please check Email")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	ociTaskServResponse, err := ociTaskServClient.call(ctx, "GetUserByEmail", "GET", fmt.Sprintf("%s/users?email=%s", *ociTaskServClient.hostUrl, url.QueryEscape(email)), nil, http.StatusOK)
+	if err != nil {
+		return ociTaskServResponse, err
+	}
+
+	// Service may do prefix or fuzzy search, only exact address identifies user
+	if ociTaskServResponse.User == nil {
+		for i := range ociTaskServResponse.Users {
+			user := ociTaskServResponse.Users[i]
+			if user.Email != nil && NormalizeOciTaskUserEmail(*user.Email) == email {
+				ociTaskServResponse.User = &user
+				break
+			}
+		}
+	}
+
+	return ociTaskServResponse, nil
+}
+
+/**
+ * @brief Public method to add comment to Task using OCI Task Service.
+ *			Returns created comment if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param taskId Identifier of the Task
+ * @param comment Instance of OciTaskComment
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) CreateTaskComment(ctx context.Context, taskId *int64, comment *OciTaskComment) (*OciTaskServResponse, error) {
+	if taskId == nil || comment == nil || comment.Body == nil {
+		return nil, errors.New("Invalid Argument - please check Id or Comment")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "CreateTaskComment", "POST", fmt.Sprintf("%s/tasks/%d/comments", *ociTaskServClient.hostUrl, *taskId), comment, http.StatusCreated)
+}
+
+/**
+ * @brief Public method to read comment on Task using OCI Task Service.
+ *			Returns OciTaskComment instance if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param taskId Identifier of the Task
+ * @param commentId Identifier of the comment
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) GetTaskComment(ctx context.Context, taskId *int64, commentId *int64) (*OciTaskServResponse, error) {
+	if taskId == nil || commentId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "GetTaskComment", "GET", fmt.Sprintf("%s/tasks/%d/comments/%d", *ociTaskServClient.hostUrl, *taskId, *commentId), nil, http.StatusOK)
+}
+
+/**
+ * @brief Public method to edit comment on Task using OCI Task Service.
+ *			Returns updated comment if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param taskId Identifier of the Task
+ * @param commentId Identifier of the comment
+ * @param comment Instance of OciTaskComment
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) UpdateTaskComment(ctx context.Context, taskId *int64, commentId *int64, comment *OciTaskComment) (*OciTaskServResponse, error) {
+	if taskId == nil || commentId == nil || comment == nil {
+		return nil, errors.New("Invalid Argument - please check Id or Comment")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "UpdateTaskComment", "PUT", fmt.Sprintf("%s/tasks/%d/comments/%d", *ociTaskServClient.hostUrl, *taskId, *commentId), comment, http.StatusOK)
+}
+
+/**
+ * @brief Public method to delete comment on Task using OCI Task Service.
+ *			Returns nothing if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param taskId Identifier of the Task
+ * @param commentId Identifier of the comment
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) DeleteTaskComment(ctx context.Context, taskId *int64, commentId *int64) (*OciTaskServResponse, error) {
+	if taskId == nil || commentId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "DeleteTaskComment", "DELETE", fmt.Sprintf("%s/tasks/%d/comments/%d", *ociTaskServClient.hostUrl, *taskId, *commentId), nil, http.StatusOK)
+}
+
+/**
+ * @brief Public method to list comments on Task using OCI Task Service.
+ *			Returns comments oldest first if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param taskId Identifier of the Task
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) ListTaskComments(ctx context.Context, taskId *int64) (*OciTaskServResponse, error) {
+	if taskId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	ociTaskServResponse, err := ociTaskServClient.call(ctx, "ListTaskComments", "GET", fmt.Sprintf("%s/tasks/%d/comments", *ociTaskServClient.hostUrl, *taskId), nil, http.StatusOK)
+	if err != nil {
+		return ociTaskServResponse, err
+	}
+
+	SortOciTaskComments(ociTaskServResponse.Comments)
+
+	return ociTaskServResponse, nil
+}
+
+/**
+ * @brief Upload file to Task as multipart/form-data.
+ *			Content is streamed to OCI Task Service while it is read, so it is never held in memory as a whole.
+ * @param ctx Context for logging and cancellation
+ * @param taskId Identifier of the Task
+ * @param fileName Name of the file as stored by OCI Task Service
+ * @param content Content of the file
+ * @return Instance of OciTaskServResponse with Attachment metadata
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) UploadTaskAttachment(ctx context.Context, taskId *int64, fileName string, content io.Reader) (*OciTaskServResponse, error) {
+	if taskId == nil || fileName == "" || content == nil {
+		return nil, errors.New("Invalid Argument - please check Id, File Name and Content")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	bodyReader, bodyWriter := io.Pipe()
+	// Closing the reader unblocks the writer below when the request is abandoned before the body is consumed.
+	defer bodyReader.Close()
+
+	multipartWriter := multipart.NewWriter(bodyWriter)
+	go func() {
+		part, err := multipartWriter.CreateFormFile("file", fileName)
+		if err == nil {
+			_, err = io.Copy(part, content)
+		}
+		if err == nil {
+			err = multipartWriter.Close()
+		}
+		bodyWriter.CloseWithError(err)
+	}()
+
+	operation := "UploadTaskAttachment"
+	apiRequest, err := ociTaskServClient.newRequest(ctx, "POST", fmt.Sprintf("%s/tasks/%d/attachments", *ociTaskServClient.hostUrl, *taskId), bodyReader)
+	if err != nil {
+		return nil, err
+	}
+	apiRequest.Header.Set("Content-Type", multipartWriter.FormDataContentType())
+
+	apiResp, body, err := ociTaskServClient.sendRequest(ctx, operation, apiRequest, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ociTaskServClient.checkStatus(ctx, operation, apiRequest, apiResp, body, http.StatusCreated)
+	if err != nil {
+		return nil, err
+	}
+
+	return ociTaskServClient.parseResponse(ctx, apiRequest, apiResp, body)
+}
+
+/**
+ * @brief Get metadata of file attached to Task
+ * @param ctx Context for logging and cancellation
+ * @param taskId Identifier of the Task
+ * @param attachmentId Identifier of the Attachment
+ * @return Instance of OciTaskServResponse with Attachment metadata
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) GetTaskAttachment(ctx context.Context, taskId *int64, attachmentId *int64) (*OciTaskServResponse, error) {
+	if taskId == nil || attachmentId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "GetTaskAttachment", "GET", fmt.Sprintf("%s/tasks/%d/attachments/%d", *ociTaskServClient.hostUrl, *taskId, *attachmentId), nil, http.StatusOK)
+}
+
+/**
+ * @brief Delete file attached to Task
+ * @param ctx Context for logging and cancellation
+ * @param taskId Identifier of the Task
+ * @param attachmentId Identifier of the Attachment
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) DeleteTaskAttachment(ctx context.Context, taskId *int64, attachmentId *int64) (*OciTaskServResponse, error) {
+	if taskId == nil || attachmentId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "DeleteTaskAttachment", "DELETE", fmt.Sprintf("%s/tasks/%d/attachments/%d", *ociTaskServClient.hostUrl, *taskId, *attachmentId), nil, http.StatusOK)
+}
+
+/**
+ * @brief Download content of file attached to Task.
+ *			Content is copied to the writer as it arrives instead of being read into memory first.
+ * @param ctx Context for logging and cancellation
+ * @param taskId Identifier of the Task
+ * @param attachmentId Identifier of the Attachment
+ * @param content Destination of the file content
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) DownloadTaskAttachment(ctx context.Context, taskId *int64, attachmentId *int64, content io.Writer) error {
+	if taskId == nil || attachmentId == nil || content == nil {
+		return errors.New("Invalid Argument - please check Id and Content")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	operation := "DownloadTaskAttachment"
+	apiRequest, err := ociTaskServClient.newRequest(ctx, "GET", fmt.Sprintf("%s/tasks/%d/attachments/%d/content", *ociTaskServClient.hostUrl, *taskId, *attachmentId), nil)
+	if err != nil {
+		return err
+	}
+	apiRequest.Header.Set("Accept", "application/octet-stream")
+
+	apiResp, body, err := ociTaskServClient.sendRequest(ctx, operation, apiRequest, content)
+	if err != nil {
+		return err
+	}
+
+	return ociTaskServClient.checkStatus(ctx, operation, apiRequest, apiResp, body, http.StatusOK)
+}
+
+/**
+ * @brief Public method to add reminder to Task using OCI Task Service.
+ *			Returns created reminder if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param taskId Identifier of the Task
+ * @param reminder Instance of OciTaskReminder with either Cron or OffsetSeconds
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) CreateTaskReminder(ctx context.Context, taskId *int64, reminder *OciTaskReminder) (*OciTaskServResponse, error) {
+	if taskId == nil || reminder == nil || (reminder.Cron == nil) == (reminder.OffsetSeconds == nil) {
+		return nil, errors.New("Invalid Argument - please check Id or Reminder")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "CreateTaskReminder", "POST", fmt.Sprintf("%s/tasks/%d/reminders", *ociTaskServClient.hostUrl, *taskId), reminder, http.StatusCreated)
+}
+
+/**
+ * @brief Public method to read reminder on Task using OCI Task Service.
+ *			Returns OciTaskReminder instance if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param taskId Identifier of the Task
+ * @param reminderId Identifier of the reminder
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) GetTaskReminder(ctx context.Context, taskId *int64, reminderId *int64) (*OciTaskServResponse, error) {
+	if taskId == nil || reminderId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "GetTaskReminder", "GET", fmt.Sprintf("%s/tasks/%d/reminders/%d", *ociTaskServClient.hostUrl, *taskId, *reminderId), nil, http.StatusOK)
+}
+
+/**
+ * @brief Public method to change schedule or channel of reminder on Task using OCI Task Service.
+ *			Returns updated reminder if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param taskId Identifier of the Task
+ * @param reminderId Identifier of the reminder
+ * @param reminder Instance of OciTaskReminder with either Cron or OffsetSeconds
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) UpdateTaskReminder(ctx context.Context, taskId *int64, reminderId *int64, reminder *OciTaskReminder) (*OciTaskServResponse, error) {
+	if taskId == nil || reminderId == nil || reminder == nil || (reminder.Cron == nil) == (reminder.OffsetSeconds == nil) {
+		return nil, errors.New("Invalid Argument - please check Id or Reminder")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "UpdateTaskReminder", "PUT", fmt.Sprintf("%s/tasks/%d/reminders/%d", *ociTaskServClient.hostUrl, *taskId, *reminderId), reminder, http.StatusOK)
+}
+
+/**
+ * @brief Public method to delete reminder on Task using OCI Task Service.
+ *			Returns nothing if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param taskId Identifier of the Task
+ * @param reminderId Identifier of the reminder
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) DeleteTaskReminder(ctx context.Context, taskId *int64, reminderId *int64) (*OciTaskServResponse, error) {
+	if taskId == nil || reminderId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "DeleteTaskReminder", "DELETE", fmt.Sprintf("%s/tasks/%d/reminders/%d", *ociTaskServClient.hostUrl, *taskId, *reminderId), nil, http.StatusOK)
+}
+
+/**
+ * @brief Public method to list reminders on Task using OCI Task Service.
+ *			Returns reminders in order of creation if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param taskId Identifier of the Task
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) ListTaskReminders(ctx context.Context, taskId *int64) (*OciTaskServResponse, error) {
+	if taskId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	ociTaskServResponse, err := ociTaskServClient.call(ctx, "ListTaskReminders", "GET", fmt.Sprintf("%s/tasks/%d/reminders", *ociTaskServClient.hostUrl, *taskId), nil, http.StatusOK)
+	if err != nil {
+		return ociTaskServResponse, err
+	}
+
+	SortOciTaskReminders(ociTaskServResponse.Reminders)
+
+	return ociTaskServResponse, nil
+}
+
+/**
+ * @brief Public method to log time on Task using OCI Task Service.
+ *			Returns created time entry if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param taskId Identifier of the Task
+ * @param timeEntry Instance of OciTaskTimeEntry
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) CreateTaskTimeEntry(ctx context.Context, taskId *int64, timeEntry *OciTaskTimeEntry) (*OciTaskServResponse, error) {
+	if taskId == nil || timeEntry == nil || timeEntry.Minutes == nil || timeEntry.Date == nil {
+		return nil, errors.New("Invalid Argument - please check Id or Time Entry")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "CreateTaskTimeEntry", "POST", fmt.Sprintf("%s/tasks/%d/time-entries", *ociTaskServClient.hostUrl, *taskId), timeEntry, http.StatusCreated)
+}
+
+/**
+ * @brief Public method to read time entry of Task using OCI Task Service.
+ *			Returns OciTaskTimeEntry instance if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param taskId Identifier of the Task
+ * @param timeEntryId Identifier of the time entry
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) GetTaskTimeEntry(ctx context.Context, taskId *int64, timeEntryId *int64) (*OciTaskServResponse, error) {
+	if taskId == nil || timeEntryId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "GetTaskTimeEntry", "GET", fmt.Sprintf("%s/tasks/%d/time-entries/%d", *ociTaskServClient.hostUrl, *taskId, *timeEntryId), nil, http.StatusOK)
+}
+
+/**
+ * @brief Public method to correct time entry of Task using OCI Task Service.
+ *			Returns updated time entry if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param taskId Identifier of the Task
+ * @param timeEntryId Identifier of the time entry
+ * @param timeEntry Instance of OciTaskTimeEntry
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) UpdateTaskTimeEntry(ctx context.Context, taskId *int64, timeEntryId *int64, timeEntry *OciTaskTimeEntry) (*OciTaskServResponse, error) {
+	if taskId == nil || timeEntryId == nil || timeEntry == nil {
+		return nil, errors.New("Invalid Argument - please check Id or Time Entry")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "UpdateTaskTimeEntry", "PUT", fmt.Sprintf("%s/tasks/%d/time-entries/%d", *ociTaskServClient.hostUrl, *taskId, *timeEntryId), timeEntry, http.StatusOK)
+}
+
+/**
+ * @brief Public method to delete time entry of Task using OCI Task Service.
+ *			Returns nothing if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param taskId Identifier of the Task
+ * @param timeEntryId Identifier of the time entry
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) DeleteTaskTimeEntry(ctx context.Context, taskId *int64, timeEntryId *int64) (*OciTaskServResponse, error) {
+	if taskId == nil || timeEntryId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "DeleteTaskTimeEntry", "DELETE", fmt.Sprintf("%s/tasks/%d/time-entries/%d", *ociTaskServClient.hostUrl, *taskId, *timeEntryId), nil, http.StatusOK)
+}
+
+/**
+ * @brief Public method to list time entries of Task using OCI Task Service.
+ *			Returns time entries in order of date if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param taskId Identifier of the Task
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) ListTaskTimeEntries(ctx context.Context, taskId *int64) (*OciTaskServResponse, error) {
+	if taskId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	ociTaskServResponse, err := ociTaskServClient.call(ctx, "ListTaskTimeEntries", "GET", fmt.Sprintf("%s/tasks/%d/time-entries", *ociTaskServClient.hostUrl, *taskId), nil, http.StatusOK)
+	if err != nil {
+		return ociTaskServResponse, err
+	}
+
+	SortOciTaskTimeEntries(ociTaskServResponse.TimeEntries)
+
+	return ociTaskServResponse, nil
+}
+
+/**
+ * @brief Public method to read change history of Task using OCI Task Service.
+ *			Returns revisions of Task, oldest first, if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param taskId Identifier of the Task
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) GetTaskHistory(ctx context.Context, taskId *int64) (*OciTaskServResponse, error) {
+	if taskId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	ociTaskServResponse, err := ociTaskServClient.call(ctx, "GetTaskHistory", "GET", fmt.Sprintf("%s/tasks/%d/history", *ociTaskServClient.hostUrl, *taskId), nil, http.StatusOK)
+	if err != nil {
+		return ociTaskServResponse, err
+	}
+
+	SortOciTaskRevisions(ociTaskServResponse.Revisions)
+
+	return ociTaskServResponse, nil
+}
+
+/**
+ * @brief Public method to define custom field on Project using OCI Task Service.
+ *			Returns created custom field if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param projectId Identifier of the Project
+ * @param customField Instance of OciTaskCustomField
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) CreateCustomField(ctx context.Context, projectId *int64, customField *OciTaskCustomField) (*OciTaskServResponse, error) {
+	if projectId == nil || customField == nil || customField.Name == nil || customField.Type == nil {
+		return nil, errors.New("Invalid Argument - please check Id or Custom Field")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "CreateCustomField", "POST", fmt.Sprintf("%s/projects/%d/custom-fields", *ociTaskServClient.hostUrl, *projectId), customField, http.StatusCreated)
+}
+
+/**
+ * @brief Public method to read custom field of Project using OCI Task Service.
+ *			Returns OciTaskCustomField instance if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param projectId Identifier of the Project
+ * @param customFieldId Identifier of the custom field
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) GetCustomField(ctx context.Context, projectId *int64, customFieldId *int64) (*OciTaskServResponse, error) {
+	if projectId == nil || customFieldId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "GetCustomField", "GET", fmt.Sprintf("%s/projects/%d/custom-fields/%d", *ociTaskServClient.hostUrl, *projectId, *customFieldId), nil, http.StatusOK)
+}
+
+/**
+ * @brief Public method to update custom field of Project using OCI Task Service.
+ *			Returns updated custom field if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param projectId Identifier of the Project
+ * @param customFieldId Identifier of the custom field
+ * @param customField Instance of OciTaskCustomField
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) UpdateCustomField(ctx context.Context, projectId *int64, customFieldId *int64, customField *OciTaskCustomField) (*OciTaskServResponse, error) {
+	if projectId == nil || customFieldId == nil || customField == nil {
+		return nil, errors.New("Invalid Argument - please check Id or Custom Field")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "UpdateCustomField", "PUT", fmt.Sprintf("%s/projects/%d/custom-fields/%d", *ociTaskServClient.hostUrl, *projectId, *customFieldId), customField, http.StatusOK)
+}
+
+/**
+ * @brief Public method to delete custom field of Project using OCI Task Service.
+ *			Returns nothing if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param projectId Identifier of the Project
+ * @param customFieldId Identifier of the custom field
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) DeleteCustomField(ctx context.Context, projectId *int64, customFieldId *int64) (*OciTaskServResponse, error) {
+	if projectId == nil || customFieldId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "DeleteCustomField", "DELETE", fmt.Sprintf("%s/projects/%d/custom-fields/%d", *ociTaskServClient.hostUrl, *projectId, *customFieldId), nil, http.StatusOK)
+}
+
+/**
+ * @brief Public method to list custom fields of Project using OCI Task Service.
+ *			Returns custom fields in order of name if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param projectId Identifier of the Project
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) ListCustomFields(ctx context.Context, projectId *int64) (*OciTaskServResponse, error) {
+	if projectId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	ociTaskServResponse, err := ociTaskServClient.call(ctx, "ListCustomFields", "GET", fmt.Sprintf("%s/projects/%d/custom-fields", *ociTaskServClient.hostUrl, *projectId), nil, http.StatusOK)
+	if err != nil {
+		return ociTaskServResponse, err
+	}
+
+	SortOciTaskCustomFields(ociTaskServResponse.CustomFields)
+
+	return ociTaskServResponse, nil
+}
+
+/**
+ * @brief Public method to subscribe webhook to events using OCI Task Service.
+ *			Returns created webhook subscription if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param webhook Instance of OciTaskWebhook
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) CreateWebhook(ctx context.Context, webhook *OciTaskWebhook) (*OciTaskServResponse, error) {
+	if webhook == nil || webhook.Url == nil {
+		return nil, errors.New("Invalid Argument - please check Webhook")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "CreateWebhook", "POST", fmt.Sprintf("%s/webhooks", *ociTaskServClient.hostUrl), webhook, http.StatusCreated)
+}
+
+/**
+ * @brief Public method to read webhook subscription using OCI Task Service.
+ *			Returns OciTaskWebhook instance without secret if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param webhookId Identifier of the webhook subscription
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) GetWebhook(ctx context.Context, webhookId *int64) (*OciTaskServResponse, error) {
+	if webhookId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "GetWebhook", "GET", fmt.Sprintf("%s/webhooks/%d", *ociTaskServClient.hostUrl, *webhookId), nil, http.StatusOK)
+}
+
+/**
+ * @brief Public method to update webhook subscription using OCI Task Service.
+ *			Returns updated webhook subscription if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param webhookId Identifier of the webhook subscription
+ * @param webhook Instance of OciTaskWebhook. Secret is rotated if set, kept otherwise.
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) UpdateWebhook(ctx context.Context, webhookId *int64, webhook *OciTaskWebhook) (*OciTaskServResponse, error) {
+	if webhookId == nil || webhook == nil {
+		return nil, errors.New("Invalid Argument - please check Id or Webhook")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "UpdateWebhook", "PUT", fmt.Sprintf("%s/webhooks/%d", *ociTaskServClient.hostUrl, *webhookId), webhook, http.StatusOK)
+}
+
+/**
+ * @brief Public method to unsubscribe webhook using OCI Task Service.
+ *			Returns nothing if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param webhookId Identifier of the webhook subscription
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) DeleteWebhook(ctx context.Context, webhookId *int64) (*OciTaskServResponse, error) {
+	if webhookId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "DeleteWebhook", "DELETE", fmt.Sprintf("%s/webhooks/%d", *ociTaskServClient.hostUrl, *webhookId), nil, http.StatusOK)
+}
+
+/**
+ * @brief Public method to grant role to user or group on Task or Project using OCI Task Service.
+ *			Returns created permission if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param permission Instance of OciTaskPermission naming target, principal and role
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) GrantPermission(ctx context.Context, permission *OciTaskPermission) (*OciTaskServResponse, error) {
+	if permission == nil {
+		return nil, errors.New("Invalid Argument - please check Permission")
+	}
+
+	err := permission.Validate()
+	if err != nil {
+		return nil, fmt.Errorf("Invalid Argument - %s", err.Error())
+	}
+
+	path, _ := ociTaskPermissionsPath(*permission.TargetType, *permission.TargetId)
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "GrantPermission", "POST", *ociTaskServClient.hostUrl+path, permission, http.StatusCreated)
+}
+
+/**
+ * @brief Public method to read permission on Task or Project using OCI Task Service.
+ *			Returns OciTaskPermission instance if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param targetType Kind of target, task or project
+ * @param targetId Identifier of the Task or Project
+ * @param permissionId Identifier of the permission
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) GetPermission(ctx context.Context, targetType string, targetId *int64, permissionId *int64) (*OciTaskServResponse, error) {
+	if targetId == nil || permissionId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	path, err := ociTaskPermissionsPath(targetType, *targetId)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid Argument - %s", err.Error())
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "GetPermission", "GET", fmt.Sprintf("%s%s/%d", *ociTaskServClient.hostUrl, path, *permissionId), nil, http.StatusOK)
+}
+
+/**
+ * @brief Public method to revoke permission on Task or Project using OCI Task Service.
+ *			Returns nothing if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param targetType Kind of target, task or project
+ * @param targetId Identifier of the Task or Project
+ * @param permissionId Identifier of the permission
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) RevokePermission(ctx context.Context, targetType string, targetId *int64, permissionId *int64) (*OciTaskServResponse, error) {
+	if targetId == nil || permissionId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	path, err := ociTaskPermissionsPath(targetType, *targetId)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid Argument - %s", err.Error())
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	return ociTaskServClient.call(ctx, "RevokePermission", "DELETE", fmt.Sprintf("%s%s/%d", *ociTaskServClient.hostUrl, path, *permissionId), nil, http.StatusOK)
+}
+
+/**
+ * @brief Public method to list permissions granted directly on Task or Project using OCI Task Service.
+ *			Returns permissions, most privileged role first, if succeeded.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param targetType Kind of target, task or project
+ * @param targetId Identifier of the Task or Project
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) ListPermissions(ctx context.Context, targetType string, targetId *int64) (*OciTaskServResponse, error) {
+	if targetId == nil {
+		return nil, errors.New("Invalid Argument - please check Id")
+	}
+
+	path, err := ociTaskPermissionsPath(targetType, *targetId)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid Argument - %s", err.Error())
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	ociTaskServResponse, err := ociTaskServClient.call(ctx, "ListPermissions", "GET", *ociTaskServClient.hostUrl+path, nil, http.StatusOK)
+	if err != nil {
+		return ociTaskServResponse, err
+	}
+
+	SortOciTaskPermissions(ociTaskServResponse.Permissions)
+
+	return ociTaskServResponse, nil
+}
+
+/**
+ * @brief Public method to list all permissions which apply to user or group on Task or Project using OCI Task Service.
+ *			Includes grants to groups the user belongs to and, for Tasks, grants inherited from Project.
+ *			Returns permissions, most privileged role first, if succeeded. See ResolveOciTaskEffectivePermission.
+ *			Returns instance of OciError if failed.
+ * @param ctx Context for logging and cancellation
+ * @param targetType Kind of target, task or project
+ * @param targetId Identifier of the Task or Project
+ * @param principalType Kind of principal, user or group
+ * @param principal E-mail address of user or name of group
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) ListEffectivePermissions(ctx context.Context, targetType string, targetId *int64, principalType string, principal string) (*OciTaskServResponse, error) {
+	if targetId == nil || principal == "" {
+		return nil, errors.New("Invalid Argument - please check Id and Principal")
+	}
+
+	path, err := ociTaskPermissionsPath(targetType, *targetId)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid Argument - %s", err.Error())
+	}
+
+	if principalType == OciTaskPrincipalUser {
+		principal = NormalizeOciTaskUserEmail(principal)
+	}
+
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	query := url.Values{}
+	query.Set("principalType", principalType)
+	query.Set("principal", principal)
+
+	ociTaskServResponse, err := ociTaskServClient.call(ctx, "ListEffectivePermissions", "GET", fmt.Sprintf("%s%s/effective?%s", *ociTaskServClient.hostUrl, path, query.Encode()), nil, http.StatusOK)
+	if err != nil {
+		return ociTaskServResponse, err
+	}
+
+	SortOciTaskPermissions(ociTaskServResponse.Permissions)
+
+	return ociTaskServResponse, nil
+}
+
+/**
+ * @brief Private method to call OCI Task Service: builds and sends request, checks status and parses response.
+ * @param ctx Context prepared by requestContext
+ * @param operation Name of the operation, e.g. CreateTask
+ * @param method HTTP Method (GET, POST, PUT or DELETE)
+ * @param url HTTP URL to OCI Task Service
+ * @param ociRequest Request body. This is optional.
+ * @param expectedStatus HTTP status code expected on success
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) call(ctx context.Context, operation string, method string, url string, ociRequest ociTaskRequestBody, expectedStatus int) (*OciTaskServResponse, error) {
+	apiRequest, err := ociTaskServClient.buildRequest(ctx, method, url, ociRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	apiResp, body, err := ociTaskServClient.sendRequest(ctx, operation, apiRequest, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ociTaskServClient.checkStatus(ctx, operation, apiRequest, apiResp, body, expectedStatus)
+	if err != nil {
+		return nil, err
+	}
+
+	return ociTaskServClient.parseResponse(ctx, apiRequest, apiResp, body)
+}
+
+/**
+ * @brief Private method to build OCI Task Service HTTP request.
+ * @param ctx Context for logging and cancellation
+ * @param method HTTP Method (GET, POST, PUT or DELETE)
+ * @param url HTTP URL to OCI Task Service
+ * @param ociRequest Request body, e.g. instance of OciTaskServRequest. This is optional.
+ * @return Instance of http.Request if succeeded
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) buildRequest(ctx context.Context, method string, url string, ociRequest ociTaskRequestBody) (*http.Request, error) {
+	var body io.Reader = nil
+	if ociRequest != nil {
+		strReq, err := ociRequest.Serialize()
+		if err != nil {
+			tflog.SubsystemError(ctx, OciTaskLogSubsystem, "Failed to serialize request to OCI Task Management Service", map[string]interface{}{
+				"error": err.Error(),
+			})
+			return nil, err
+		}
+
+		tflog.SubsystemTrace(ctx, OciTaskLogSubsystem, "Request body", map[string]interface{}{
+			"method": method,
+			"url":    url,
+			"body":   RedactOciTaskBody([]byte(strReq)),
+		})
+
+		body = strings.NewReader(strReq)
+	}
+
+	return ociTaskServClient.newRequest(ctx, method, url, body)
+}
+
+/**
+ * @brief Private method to create OCI Task Service HTTP request with given body and request identifier header.
+ * @param ctx Context for logging and cancellation
+ * @param method HTTP Method (GET, POST, PUT or DELETE)
+ * @param url HTTP URL to OCI Task Service
+ * @param body Request body. This is optional.
+ * @return Instance of http.Request if succeeded
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) newRequest(ctx context.Context, method string, url string, body io.Reader) (*http.Request, error) {
+	apiRequest, err := http.NewRequestWithContext(ctx, method, url, body)
+	if err != nil {
+		tflog.SubsystemError(ctx, OciTaskLogSubsystem, "Failed to build request to OCI Task Management Service", map[string]interface{}{
+			"error": err.Error(),
+		})
+		return nil, err
+	}
+
+	requestId := OciTaskRequestIdFromContext(ctx)
+	if requestId != "" {
+		apiRequest.Header.Set(OciTaskRequestIdHeader, requestId)
+	}
+
+	return apiRequest, nil
+}
+
+/**
+ * @brief Private method to send HTTP request OCI Task Service.
+ *			Each call is recorded as a client span and W3C trace context is propagated.
+ * @param ctx Context for logging, tracing and cancellation
+ * @param operation Name of the operation, used for metrics
+ * @param apiRequest Instance of http.Request. Content-Type and Accept default to JSON unless already set.
+ * @param content Destination for successful response body, which is then streamed instead of read into memory. This is optional.
+ * @return Instance of http.Response if succeeded
+ * @return Instance of http.Response Body if succeeded, nil if streamed to content
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) sendRequest(ctx context.Context, operation string, apiRequest *http.Request, content io.Writer) (*http.Response, []byte, error) {
+	if apiRequest.Header.Get("Content-Type") == "" {
+		apiRequest.Header.Set("Content-Type", "application/json")
+	}
+	if apiRequest.Header.Get("Accept") == "" {
+		apiRequest.Header.Set("Accept", "application/json")
+	}
+	if ociTaskServClient.userAgent != "" {
+		apiRequest.Header.Set("User-Agent", ociTaskServClient.userAgent)
+	}
+
+	ctx, span := StartOciTaskHttpSpan(ctx, apiRequest)
+
+	tflog.SubsystemDebug(ctx, OciTaskLogSubsystem, "Sending request to OCI Task Management Service", map[string]interface{}{
+		"method": apiRequest.Method,
+		"url":    apiRequest.URL.String(),
+	})
+	tflog.SubsystemTrace(ctx, OciTaskLogSubsystem, "Request headers", map[string]interface{}{
+		"headers": RedactOciTaskHeaders(apiRequest.Header),
+	})
+
+	startTime := time.Now()
+	apiResp, err := ociTaskServClient.httpClient.SendRequest(apiRequest)
+	latency := time.Since(startTime)
+	if err != nil {
+		tflog.SubsystemError(ctx, OciTaskLogSubsystem, "Failed to send request to OCI Task Management Service", map[string]interface{}{
+			"method":     apiRequest.Method,
+			"url":        apiRequest.URL.String(),
+			"latency_ms": latency.Milliseconds(),
+			"error":      err.Error(),
+		})
+		EndOciTaskHttpSpan(span, nil, err)
+		ociTaskServClient.metrics.Record(operation, 0, latency, err)
+		return nil, nil, &OciTaskServError{
+			Operation:       operation,
+			ClientRequestId: apiRequest.Header.Get(OciTaskRequestIdHeader),
+			Err:             err,
+		}
+	}
+
+	defer apiResp.Body.Close()
+
+	ctx = tflog.SubsystemSetField(ctx, OciTaskLogSubsystem, "server_request_id", apiResp.Header.Get(OciTaskRequestIdHeader))
+
+	tflog.SubsystemDebug(ctx, OciTaskLogSubsystem, "Received response from OCI Task Management Service", map[string]interface{}{
+		"method":     apiRequest.Method,
+		"url":        apiRequest.URL.String(),
+		"status":     apiResp.StatusCode,
+		"latency_ms": latency.Milliseconds(),
+	})
+	tflog.SubsystemTrace(ctx, OciTaskLogSubsystem, "Response headers", map[string]interface{}{
+		"headers": RedactOciTaskHeaders(apiResp.Header),
+	})
+
+	if content != nil && apiResp.StatusCode >= 200 && apiResp.StatusCode < 300 {
+		size, err := io.Copy(content, apiResp.Body)
+		latency = time.Since(startTime)
+		if err != nil {
+			tflog.SubsystemError(ctx, OciTaskLogSubsystem, "Failed to stream response from OCI Task Management Service", map[string]interface{}{
+				"error": err.Error(),
+			})
+			EndOciTaskHttpSpan(span, nil, err)
+			ociTaskServClient.metrics.Record(operation, 0, latency, err)
+			return apiResp, nil, &OciTaskServError{
+				Operation:       operation,
+				StatusCode:      apiResp.StatusCode,
+				ClientRequestId: apiRequest.Header.Get(OciTaskRequestIdHeader),
+				ServerRequestId: apiResp.Header.Get(OciTaskRequestIdHeader),
+				Err:             err,
+			}
+		}
+
+		EndOciTaskHttpSpan(span, apiResp, nil)
+		ociTaskServClient.metrics.Record(operation, apiResp.StatusCode, latency, nil)
+
+		tflog.SubsystemTrace(ctx, OciTaskLogSubsystem, "Response body streamed", map[string]interface{}{
+			"bytes": size,
+		})
+
+		return apiResp, nil, nil
+	}
+
+	body, err := ociTaskServClient.httpClient.IoRead(apiResp.Body)
+	if err != nil {
+		tflog.SubsystemError(ctx, OciTaskLogSubsystem, "Failed to read response from OCI Task Management Service", map[string]interface{}{
+			"error": err.Error(),
+		})
+		EndOciTaskHttpSpan(span, nil, err)
+		ociTaskServClient.metrics.Record(operation, 0, latency, err)
+		return apiResp, nil, &OciTaskServError{
+			Operation:       operation,
+			StatusCode:      apiResp.StatusCode,
+			ClientRequestId: apiRequest.Header.Get(OciTaskRequestIdHeader),
+			ServerRequestId: apiResp.Header.Get(OciTaskRequestIdHeader),
+			Err:             err,
+		}
+	}
+
+	EndOciTaskHttpSpan(span, apiResp, nil)
+	ociTaskServClient.metrics.Record(operation, apiResp.StatusCode, latency, nil)
+
+	tflog.SubsystemTrace(ctx, OciTaskLogSubsystem, "Response body", map[string]interface{}{
+		"body": RedactOciTaskBody(body),
+	})
+
+	return apiResp, body, nil
+}
+
+/**
+ * @brief Private method to check status of OCI Task Service HTTP response.
+ * @param ctx Context for logging
+ * @param operation Name of the operation
+ * @param apiRequest Instance of http.Request
+ * @param apiResp Instance of http.Response
+ * @param body HTTP response body
+ * @param expectedStatus HTTP status returned by OCI Task Service on success
+ * @return Instance of OciTaskServError if status doesn't match, nil otherwise
+ */
+func (ociTaskServClient *OciTaskServClient) checkStatus(ctx context.Context, operation string, apiRequest *http.Request, apiResp *http.Response, body []byte, expectedStatus int) error {
+	if apiResp.StatusCode == expectedStatus {
+		return nil
+	}
+
+	tflog.SubsystemError(ctx, OciTaskLogSubsystem, operation+" failed", map[string]interface{}{
+		"status":            apiResp.StatusCode,
+		"body":              RedactOciTaskBody(body),
+		"server_request_id": apiResp.Header.Get(OciTaskRequestIdHeader),
+	})
+
+	return &OciTaskServError{
+		Operation:       operation,
+		StatusCode:      apiResp.StatusCode,
+		Body:            string(body),
+		ClientRequestId: apiRequest.Header.Get(OciTaskRequestIdHeader),
+		ServerRequestId: apiResp.Header.Get(OciTaskRequestIdHeader),
+	}
+}
+
+/**
+ * @brief Private method to parse OCI Task Service HTTP response body.
+ * @param ctx Context for logging
+ * @param apiRequest Instance of http.Request
+ * @param apiResp Instance of http.Response
+ * @param body HTTP response body
+ * @return Instance of OciTaskServResponse
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) parseResponse(ctx context.Context, apiRequest *http.Request, apiResp *http.Response, body []byte) (*OciTaskServResponse, error) {
+	ociTaskServResponse := OciTaskServResponse{}
+	errResp := ociTaskServResponse.Deserialize(body)
+	if errResp != nil {
+		tflog.SubsystemError(ctx, OciTaskLogSubsystem, "Failed to parse response from OCI Task Management Service", map[string]interface{}{
+			"error": errResp.Error(),
+		})
+	}
+
+	ociTaskServResponse.ClientRequestId = apiRequest.Header.Get(OciTaskRequestIdHeader)
+	ociTaskServResponse.ServerRequestId = apiResp.Header.Get(OciTaskRequestIdHeader)
+
+	return &ociTaskServResponse, errResp
+}
+
+/**
+ * @brief Private method to prepare context for call to OCI Task Service.
+ *			Sets up logging and assigns request identifier unless caller already stored one.
+ * @param ctx Context passed in by caller
+ * @return Context for call to OCI Task Service
+ */
+func (ociTaskServClient *OciTaskServClient) requestContext(ctx context.Context) context.Context {
+	ctx = MakeOciTaskLogContext(ctx)
+
+	requestId := OciTaskRequestIdFromContext(ctx)
+	if requestId == "" {
+		requestId = MakeOciTaskRequestId()
+		ctx = ContextWithOciTaskRequestId(ctx, requestId)
+	}
+
+	return tflog.SubsystemSetField(ctx, OciTaskLogSubsystem, "client_request_id", requestId)
 }
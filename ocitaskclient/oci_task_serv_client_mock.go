@@ -1,6 +1,9 @@
 package ocitaskclient
 
 import (
+	"context"
+	"io"
+
 	"github.com/stretchr/testify/mock"
 )
 
@@ -8,8 +11,436 @@ type OciTaskServClientMock struct {
 	mock.Mock
 }
 
-func (ociTaskServClientMock *OciTaskServClientMock) CreateTask(ociTaskServRequest *OciTaskServRequest) (*OciTaskServResponse, error) {
-	args := ociTaskServClientMock.Called(ociTaskServRequest)
+func (ociTaskServClientMock *OciTaskServClientMock) CreateTask(ctx context.Context, ociTaskServRequest *OciTaskServRequest) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, ociTaskServRequest)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) UpdateTask(ctx context.Context, taskId *int64, ociTaskServRequest *OciTaskServRequest) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId, ociTaskServRequest)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) GetTask(ctx context.Context, taskId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) DeleteTask(ctx context.Context, taskId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) ListTasks(ctx context.Context, filter *OciTaskListFilter) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, filter)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) ListChildTasks(ctx context.Context, taskId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) CreateTaskDependency(ctx context.Context, dependency *OciTaskDependency) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, dependency)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) GetTaskDependency(ctx context.Context, dependencyId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, dependencyId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) DeleteTaskDependency(ctx context.Context, dependencyId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, dependencyId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) ListTaskDependencies(ctx context.Context, taskIds []int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskIds)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) CreateProject(ctx context.Context, project *OciTaskProject) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, project)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) UpdateProject(ctx context.Context, projectId *int64, project *OciTaskProject) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, projectId, project)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) GetProject(ctx context.Context, projectId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, projectId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) DeleteProject(ctx context.Context, projectId *int64, cascade bool) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, projectId, cascade)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) GetUserByEmail(ctx context.Context, email string) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, email)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) CreateTaskComment(ctx context.Context, taskId *int64, comment *OciTaskComment) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId, comment)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) GetTaskComment(ctx context.Context, taskId *int64, commentId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId, commentId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) UpdateTaskComment(ctx context.Context, taskId *int64, commentId *int64, comment *OciTaskComment) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId, commentId, comment)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) DeleteTaskComment(ctx context.Context, taskId *int64, commentId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId, commentId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) ListTaskComments(ctx context.Context, taskId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) UploadTaskAttachment(ctx context.Context, taskId *int64, fileName string, content io.Reader) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId, fileName, content)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) GetTaskAttachment(ctx context.Context, taskId *int64, attachmentId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId, attachmentId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) DeleteTaskAttachment(ctx context.Context, taskId *int64, attachmentId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId, attachmentId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) DownloadTaskAttachment(ctx context.Context, taskId *int64, attachmentId *int64, content io.Writer) error {
+	args := ociTaskServClientMock.Called(ctx, taskId, attachmentId, content)
+	return args.Error(0)
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) CreateTaskReminder(ctx context.Context, taskId *int64, reminder *OciTaskReminder) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId, reminder)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) GetTaskReminder(ctx context.Context, taskId *int64, reminderId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId, reminderId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) UpdateTaskReminder(ctx context.Context, taskId *int64, reminderId *int64, reminder *OciTaskReminder) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId, reminderId, reminder)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) DeleteTaskReminder(ctx context.Context, taskId *int64, reminderId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId, reminderId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) ListTaskReminders(ctx context.Context, taskId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) CreateTaskTimeEntry(ctx context.Context, taskId *int64, timeEntry *OciTaskTimeEntry) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId, timeEntry)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) GetTaskTimeEntry(ctx context.Context, taskId *int64, timeEntryId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId, timeEntryId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) UpdateTaskTimeEntry(ctx context.Context, taskId *int64, timeEntryId *int64, timeEntry *OciTaskTimeEntry) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId, timeEntryId, timeEntry)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) DeleteTaskTimeEntry(ctx context.Context, taskId *int64, timeEntryId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId, timeEntryId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) ListTaskTimeEntries(ctx context.Context, taskId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) CreateCustomField(ctx context.Context, projectId *int64, customField *OciTaskCustomField) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, projectId, customField)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) GetCustomField(ctx context.Context, projectId *int64, customFieldId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, projectId, customFieldId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) UpdateCustomField(ctx context.Context, projectId *int64, customFieldId *int64, customField *OciTaskCustomField) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, projectId, customFieldId, customField)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) DeleteCustomField(ctx context.Context, projectId *int64, customFieldId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, projectId, customFieldId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) ListCustomFields(ctx context.Context, projectId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, projectId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) ArchiveTask(ctx context.Context, taskId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) RestoreTask(ctx context.Context, taskId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) GetTaskHistory(ctx context.Context, taskId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, taskId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) CreateWebhook(ctx context.Context, webhook *OciTaskWebhook) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, webhook)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) GetWebhook(ctx context.Context, webhookId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, webhookId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) UpdateWebhook(ctx context.Context, webhookId *int64, webhook *OciTaskWebhook) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, webhookId, webhook)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) DeleteWebhook(ctx context.Context, webhookId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, webhookId)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) WatchTasks(ctx context.Context, cursor string) (*OciTaskWatch, error) {
+	args := ociTaskServClientMock.Called(ctx, cursor)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskWatch), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) GrantPermission(ctx context.Context, permission *OciTaskPermission) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, permission)
+	if args.Get(0) != nil {
+		return args.Get(0).(*OciTaskServResponse), args.Error(1)
+	} else {
+		return nil, args.Error(1)
+	}
+}
+
+func (ociTaskServClientMock *OciTaskServClientMock) GetPermission(ctx context.Context, targetType string, targetId *int64, permissionId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, targetType, targetId, permissionId)
 	if args.Get(0) != nil {
 		return args.Get(0).(*OciTaskServResponse), args.Error(1)
 	} else {
@@ -17,8 +448,8 @@ func (ociTaskServClientMock *OciTaskServClientMock) CreateTask(ociTaskServReques
 	}
 }
 
-func (ociTaskServClientMock *OciTaskServClientMock) UpdateTask(taskId *int64, ociTaskServRequest *OciTaskServRequest) (*OciTaskServResponse, error) {
-	args := ociTaskServClientMock.Called(taskId, ociTaskServRequest)
+func (ociTaskServClientMock *OciTaskServClientMock) RevokePermission(ctx context.Context, targetType string, targetId *int64, permissionId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, targetType, targetId, permissionId)
 	if args.Get(0) != nil {
 		return args.Get(0).(*OciTaskServResponse), args.Error(1)
 	} else {
@@ -26,8 +457,8 @@ func (ociTaskServClientMock *OciTaskServClientMock) UpdateTask(taskId *int64, oc
 	}
 }
 
-func (ociTaskServClientMock *OciTaskServClientMock) GetTask(taskId *int64) (*OciTaskServResponse, error) {
-	args := ociTaskServClientMock.Called(taskId)
+func (ociTaskServClientMock *OciTaskServClientMock) ListPermissions(ctx context.Context, targetType string, targetId *int64) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, targetType, targetId)
 	if args.Get(0) != nil {
 		return args.Get(0).(*OciTaskServResponse), args.Error(1)
 	} else {
@@ -35,8 +466,8 @@ func (ociTaskServClientMock *OciTaskServClientMock) GetTask(taskId *int64) (*Oci
 	}
 }
 
-func (ociTaskServClientMock *OciTaskServClientMock) DeleteTask(taskId *int64) (*OciTaskServResponse, error) {
-	args := ociTaskServClientMock.Called(taskId)
+func (ociTaskServClientMock *OciTaskServClientMock) ListEffectivePermissions(ctx context.Context, targetType string, targetId *int64, principalType string, principal string) (*OciTaskServResponse, error) {
+	args := ociTaskServClientMock.Called(ctx, targetType, targetId, principalType, principal)
 	if args.Get(0) != nil {
 		return args.Get(0).(*OciTaskServResponse), args.Error(1)
 	} else {
@@ -1,8 +1,12 @@
 package ocitaskclient
 
 import (
+	"bytes"
+	"context"
 	"errors"
 	"io/ioutil"
+	"mime"
+	"mime/multipart"
 	"net/http"
 	"strings"
 	"testing"
@@ -16,7 +20,7 @@ const HostUrl string = "http://localhost"
 func TestCreateTaskSuccess(test *testing.T) {
 	httpClientMock := OciTaskHttpMock{}
 	url := HostUrl
-	ociTaskServClient := OciTaskServClient{&httpClientMock, &url}
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url, metrics: MakeOciTaskMetrics()}
 
 	taskId := int64(1001)
 	ociTaskServResp := OciTaskServResponse{TaskId: &taskId}
@@ -32,21 +36,22 @@ func TestCreateTaskSuccess(test *testing.T) {
 	httpClientMock.On("SendRequest", mock.Anything).Return(&httpResp, nil).Once()
 	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
 
-	apiResp, err := ociTaskServClient.CreateTask(&ociTaskServReq)
+	apiResp, err := ociTaskServClient.CreateTask(context.Background(), &ociTaskServReq)
 
 	httpClientMock.AssertExpectations(test)
 
 	assert.NoError(test, err, "TestCreateTaskSuccess Failed: No error expected")
 	assert.NotNil(test, apiResp, "TestCreateTaskSuccess Failed: Valid api response expected")
 	assert.Equal(test, int64(1001), *apiResp.TaskId, "TestCreateTaskSuccess Failed: Task Id doesn't match with expected value")
+	assert.Equal(test, int64(1), ociTaskServClient.GetMetrics().Snapshot()["CreateTask"].Count, "TestCreateTaskSuccess Failed: CreateTask call should be counted")
 }
 
 func TestCreateTaskFailedBadTask(test *testing.T) {
 	httpClientMock := OciTaskHttpMock{}
 	url := HostUrl
-	ociTaskServClient := OciTaskServClient{&httpClientMock, &url}
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url, metrics: MakeOciTaskMetrics()}
 
-	apiResp, err := ociTaskServClient.CreateTask(nil)
+	apiResp, err := ociTaskServClient.CreateTask(context.Background(), nil)
 
 	assert.Error(test, err, "TestCreateTaskFailedBadTask Failed: Error expected")
 	assert.Nil(test, apiResp, "TestCreateTaskFailedBadTask Failed: Invalid api response expected")
@@ -55,7 +60,7 @@ func TestCreateTaskFailedBadTask(test *testing.T) {
 func TestCreateTaskFailedBadStatus(test *testing.T) {
 	httpClientMock := OciTaskHttpMock{}
 	url := HostUrl
-	ociTaskServClient := OciTaskServClient{&httpClientMock, &url}
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url, metrics: MakeOciTaskMetrics()}
 
 	taskId := int64(1001)
 	ociTaskServResp := OciTaskServResponse{TaskId: &taskId}
@@ -71,7 +76,7 @@ func TestCreateTaskFailedBadStatus(test *testing.T) {
 	httpClientMock.On("SendRequest", mock.Anything).Return(&httpResp, nil).Once()
 	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
 
-	apiResp, err := ociTaskServClient.CreateTask(&ociTaskServReq)
+	apiResp, err := ociTaskServClient.CreateTask(context.Background(), &ociTaskServReq)
 
 	httpClientMock.AssertExpectations(test)
 
@@ -82,13 +87,13 @@ func TestCreateTaskFailedBadStatus(test *testing.T) {
 func TestCreateTaskFailedSendRequest(test *testing.T) {
 	httpClientMock := OciTaskHttpMock{}
 	url := HostUrl
-	ociTaskServClient := OciTaskServClient{&httpClientMock, &url}
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url, metrics: MakeOciTaskMetrics()}
 
 	ociTaskServReq := OciTaskServRequest{}
 
 	httpClientMock.On("SendRequest", mock.Anything).Return(nil, errors.New("SendRequest Failed")).Once()
 
-	apiResp, err := ociTaskServClient.CreateTask(&ociTaskServReq)
+	apiResp, err := ociTaskServClient.CreateTask(context.Background(), &ociTaskServReq)
 
 	httpClientMock.AssertExpectations(test)
 
@@ -99,7 +104,7 @@ func TestCreateTaskFailedSendRequest(test *testing.T) {
 func TestCreateTaskFailedIoRead(test *testing.T) {
 	httpClientMock := OciTaskHttpMock{}
 	url := HostUrl
-	ociTaskServClient := OciTaskServClient{&httpClientMock, &url}
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url, metrics: MakeOciTaskMetrics()}
 
 	taskId := int64(1001)
 	ociTaskServResp := OciTaskServResponse{TaskId: &taskId}
@@ -115,7 +120,7 @@ func TestCreateTaskFailedIoRead(test *testing.T) {
 	httpClientMock.On("SendRequest", mock.Anything).Return(&httpResp, nil).Once()
 	httpClientMock.On("IoRead", mock.Anything).Return(nil, errors.New("IoRead Failed")).Once()
 
-	apiResp, err := ociTaskServClient.CreateTask(&ociTaskServReq)
+	apiResp, err := ociTaskServClient.CreateTask(context.Background(), &ociTaskServReq)
 
 	httpClientMock.AssertExpectations(test)
 
@@ -126,7 +131,7 @@ func TestCreateTaskFailedIoRead(test *testing.T) {
 func TestUpdateTaskSuccess(test *testing.T) {
 	httpClientMock := OciTaskHttpMock{}
 	url := HostUrl
-	ociTaskServClient := OciTaskServClient{&httpClientMock, &url}
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url, metrics: MakeOciTaskMetrics()}
 
 	taskId := int64(1001)
 	ociTaskServResp := OciTaskServResponse{TaskId: &taskId}
@@ -142,7 +147,7 @@ func TestUpdateTaskSuccess(test *testing.T) {
 	httpClientMock.On("SendRequest", mock.Anything).Return(&httpResp, nil).Once()
 	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
 
-	apiResp, err := ociTaskServClient.UpdateTask(&taskId, &ociTaskServReq)
+	apiResp, err := ociTaskServClient.UpdateTask(context.Background(), &taskId, &ociTaskServReq)
 
 	httpClientMock.AssertExpectations(test)
 
@@ -154,11 +159,11 @@ func TestUpdateTaskSuccess(test *testing.T) {
 func TestUpdateTaskFailedBadTask(test *testing.T) {
 	httpClientMock := OciTaskHttpMock{}
 	url := HostUrl
-	ociTaskServClient := OciTaskServClient{&httpClientMock, &url}
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url, metrics: MakeOciTaskMetrics()}
 
 	taskId := int64(1001)
 
-	apiResp, err := ociTaskServClient.UpdateTask(&taskId, nil)
+	apiResp, err := ociTaskServClient.UpdateTask(context.Background(), &taskId, nil)
 
 	httpClientMock.AssertExpectations(test)
 
@@ -169,9 +174,9 @@ func TestUpdateTaskFailedBadTask(test *testing.T) {
 func TestUpdateTaskFailedBadId(test *testing.T) {
 	httpClientMock := OciTaskHttpMock{}
 	url := HostUrl
-	ociTaskServClient := OciTaskServClient{&httpClientMock, &url}
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url, metrics: MakeOciTaskMetrics()}
 
-	apiResp, err := ociTaskServClient.UpdateTask(nil, nil)
+	apiResp, err := ociTaskServClient.UpdateTask(context.Background(), nil, nil)
 
 	httpClientMock.AssertExpectations(test)
 
@@ -182,7 +187,7 @@ func TestUpdateTaskFailedBadId(test *testing.T) {
 func TestUpdateTaskFailedBadStatus(test *testing.T) {
 	httpClientMock := OciTaskHttpMock{}
 	url := HostUrl
-	ociTaskServClient := OciTaskServClient{&httpClientMock, &url}
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url, metrics: MakeOciTaskMetrics()}
 
 	taskId := int64(1001)
 	ociTaskServResp := OciTaskServResponse{TaskId: &taskId}
@@ -198,7 +203,7 @@ func TestUpdateTaskFailedBadStatus(test *testing.T) {
 	httpClientMock.On("SendRequest", mock.Anything).Return(&httpResp, nil).Once()
 	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
 
-	apiResp, err := ociTaskServClient.UpdateTask(&taskId, &ociTaskServReq)
+	apiResp, err := ociTaskServClient.UpdateTask(context.Background(), &taskId, &ociTaskServReq)
 
 	httpClientMock.AssertExpectations(test)
 
@@ -209,14 +214,14 @@ func TestUpdateTaskFailedBadStatus(test *testing.T) {
 func TestUpdateTaskFailedSendRequest(test *testing.T) {
 	httpClientMock := OciTaskHttpMock{}
 	url := HostUrl
-	ociTaskServClient := OciTaskServClient{&httpClientMock, &url}
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url, metrics: MakeOciTaskMetrics()}
 
 	taskId := int64(1001)
 	ociTaskServReq := OciTaskServRequest{}
 
 	httpClientMock.On("SendRequest", mock.Anything).Return(nil, errors.New("SendRequest Failed")).Once()
 
-	apiResp, err := ociTaskServClient.UpdateTask(&taskId, &ociTaskServReq)
+	apiResp, err := ociTaskServClient.UpdateTask(context.Background(), &taskId, &ociTaskServReq)
 
 	httpClientMock.AssertExpectations(test)
 
@@ -227,7 +232,7 @@ func TestUpdateTaskFailedSendRequest(test *testing.T) {
 func TestUpdateTaskFailedIoRead(test *testing.T) {
 	httpClientMock := OciTaskHttpMock{}
 	url := HostUrl
-	ociTaskServClient := OciTaskServClient{&httpClientMock, &url}
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url, metrics: MakeOciTaskMetrics()}
 
 	taskId := int64(1001)
 	ociTaskServResp := OciTaskServResponse{TaskId: &taskId}
@@ -243,7 +248,7 @@ func TestUpdateTaskFailedIoRead(test *testing.T) {
 	httpClientMock.On("SendRequest", mock.Anything).Return(&httpResp, nil).Once()
 	httpClientMock.On("IoRead", mock.Anything).Return(nil, errors.New("IoRead Failed")).Once()
 
-	apiResp, err := ociTaskServClient.UpdateTask(&taskId, &ociTaskServReq)
+	apiResp, err := ociTaskServClient.UpdateTask(context.Background(), &taskId, &ociTaskServReq)
 
 	httpClientMock.AssertExpectations(test)
 
@@ -254,7 +259,7 @@ func TestUpdateTaskFailedIoRead(test *testing.T) {
 func TestGetTaskSuccess(test *testing.T) {
 	httpClientMock := OciTaskHttpMock{}
 	url := HostUrl
-	ociTaskServClient := OciTaskServClient{&httpClientMock, &url}
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url, metrics: MakeOciTaskMetrics()}
 
 	taskId := int64(1001)
 	task := OciTask{Id: &taskId}
@@ -269,7 +274,7 @@ func TestGetTaskSuccess(test *testing.T) {
 	httpClientMock.On("SendRequest", mock.Anything).Return(&httpResp, nil).Once()
 	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
 
-	apiResp, err := ociTaskServClient.GetTask(&taskId)
+	apiResp, err := ociTaskServClient.GetTask(context.Background(), &taskId)
 
 	httpClientMock.AssertExpectations(test)
 
@@ -281,9 +286,9 @@ func TestGetTaskSuccess(test *testing.T) {
 func TestGetTaskFailedBadId(test *testing.T) {
 	httpClientMock := OciTaskHttpMock{}
 	url := HostUrl
-	ociTaskServClient := OciTaskServClient{&httpClientMock, &url}
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url, metrics: MakeOciTaskMetrics()}
 
-	apiResp, err := ociTaskServClient.GetTask(nil)
+	apiResp, err := ociTaskServClient.GetTask(context.Background(), nil)
 
 	httpClientMock.AssertExpectations(test)
 
@@ -294,7 +299,7 @@ func TestGetTaskFailedBadId(test *testing.T) {
 func TestGetTaskFailedBadStatus(test *testing.T) {
 	httpClientMock := OciTaskHttpMock{}
 	url := HostUrl
-	ociTaskServClient := OciTaskServClient{&httpClientMock, &url}
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url, metrics: MakeOciTaskMetrics()}
 
 	taskId := int64(1001)
 	ociTaskServResp := OciTaskServResponse{TaskId: &taskId}
@@ -308,24 +313,25 @@ func TestGetTaskFailedBadStatus(test *testing.T) {
 	httpClientMock.On("SendRequest", mock.Anything).Return(&httpResp, nil).Once()
 	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
 
-	apiResp, err := ociTaskServClient.GetTask(&taskId)
+	apiResp, err := ociTaskServClient.GetTask(context.Background(), &taskId)
 
 	httpClientMock.AssertExpectations(test)
 
 	assert.Error(test, err, "TestGetTaskFailedBadStatus Failed: Error expected")
 	assert.Nil(test, apiResp, "TestGetTaskFailedBadStatus Failed: Invalid api response expected")
+	assert.Equal(test, int64(1), ociTaskServClient.GetMetrics().Snapshot()["GetTask"].Errors["5xx"], "TestGetTaskFailedBadStatus Failed: GetTask 5xx error should be counted")
 }
 
 func TestGetTaskFailedSendRequest(test *testing.T) {
 	httpClientMock := OciTaskHttpMock{}
 	url := HostUrl
-	ociTaskServClient := OciTaskServClient{&httpClientMock, &url}
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url, metrics: MakeOciTaskMetrics()}
 
 	taskId := int64(1001)
 
 	httpClientMock.On("SendRequest", mock.Anything).Return(nil, errors.New("SendRequest Failed")).Once()
 
-	apiResp, err := ociTaskServClient.GetTask(&taskId)
+	apiResp, err := ociTaskServClient.GetTask(context.Background(), &taskId)
 
 	httpClientMock.AssertExpectations(test)
 
@@ -336,7 +342,7 @@ func TestGetTaskFailedSendRequest(test *testing.T) {
 func TestGetTaskFailedIoRead(test *testing.T) {
 	httpClientMock := OciTaskHttpMock{}
 	url := HostUrl
-	ociTaskServClient := OciTaskServClient{&httpClientMock, &url}
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url, metrics: MakeOciTaskMetrics()}
 
 	taskId := int64(1001)
 	ociTaskServResp := OciTaskServResponse{TaskId: &taskId}
@@ -350,7 +356,7 @@ func TestGetTaskFailedIoRead(test *testing.T) {
 	httpClientMock.On("SendRequest", mock.Anything).Return(&httpResp, nil).Once()
 	httpClientMock.On("IoRead", mock.Anything).Return(nil, errors.New("IoRead Failed")).Once()
 
-	apiResp, err := ociTaskServClient.GetTask(&taskId)
+	apiResp, err := ociTaskServClient.GetTask(context.Background(), &taskId)
 
 	httpClientMock.AssertExpectations(test)
 
@@ -361,7 +367,7 @@ func TestGetTaskFailedIoRead(test *testing.T) {
 func TestDeleteTaskSuccess(test *testing.T) {
 	httpClientMock := OciTaskHttpMock{}
 	url := HostUrl
-	ociTaskServClient := OciTaskServClient{&httpClientMock, &url}
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url, metrics: MakeOciTaskMetrics()}
 
 	taskId := int64(1001)
 	task := OciTask{Id: &taskId}
@@ -376,7 +382,7 @@ func TestDeleteTaskSuccess(test *testing.T) {
 	httpClientMock.On("SendRequest", mock.Anything).Return(&httpResp, nil).Once()
 	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
 
-	apiResp, err := ociTaskServClient.DeleteTask(&taskId)
+	apiResp, err := ociTaskServClient.DeleteTask(context.Background(), &taskId)
 
 	httpClientMock.AssertExpectations(test)
 
@@ -388,9 +394,9 @@ func TestDeleteTaskSuccess(test *testing.T) {
 func TestDeleteTaskFailedBadId(test *testing.T) {
 	httpClientMock := OciTaskHttpMock{}
 	url := HostUrl
-	ociTaskServClient := OciTaskServClient{&httpClientMock, &url}
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url, metrics: MakeOciTaskMetrics()}
 
-	apiResp, err := ociTaskServClient.DeleteTask(nil)
+	apiResp, err := ociTaskServClient.DeleteTask(context.Background(), nil)
 
 	httpClientMock.AssertExpectations(test)
 
@@ -401,7 +407,7 @@ func TestDeleteTaskFailedBadId(test *testing.T) {
 func TestDeleteTaskFailedBadStatus(test *testing.T) {
 	httpClientMock := OciTaskHttpMock{}
 	url := HostUrl
-	ociTaskServClient := OciTaskServClient{&httpClientMock, &url}
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url, metrics: MakeOciTaskMetrics()}
 
 	taskId := int64(1001)
 	ociTaskServResp := OciTaskServResponse{TaskId: &taskId}
@@ -415,7 +421,7 @@ func TestDeleteTaskFailedBadStatus(test *testing.T) {
 	httpClientMock.On("SendRequest", mock.Anything).Return(&httpResp, nil).Once()
 	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
 
-	apiResp, err := ociTaskServClient.DeleteTask(&taskId)
+	apiResp, err := ociTaskServClient.DeleteTask(context.Background(), &taskId)
 
 	httpClientMock.AssertExpectations(test)
 
@@ -426,13 +432,13 @@ func TestDeleteTaskFailedBadStatus(test *testing.T) {
 func TestDeleteTaskFailedSendRequest(test *testing.T) {
 	httpClientMock := OciTaskHttpMock{}
 	url := HostUrl
-	ociTaskServClient := OciTaskServClient{&httpClientMock, &url}
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url, metrics: MakeOciTaskMetrics()}
 
 	taskId := int64(1001)
 
 	httpClientMock.On("SendRequest", mock.Anything).Return(nil, errors.New("SendRequest Failed")).Once()
 
-	apiResp, err := ociTaskServClient.DeleteTask(&taskId)
+	apiResp, err := ociTaskServClient.DeleteTask(context.Background(), &taskId)
 
 	httpClientMock.AssertExpectations(test)
 
@@ -443,7 +449,7 @@ func TestDeleteTaskFailedSendRequest(test *testing.T) {
 func TestDeleteTaskFailedIoRead(test *testing.T) {
 	httpClientMock := OciTaskHttpMock{}
 	url := HostUrl
-	ociTaskServClient := OciTaskServClient{&httpClientMock, &url}
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url, metrics: MakeOciTaskMetrics()}
 
 	taskId := int64(1001)
 	ociTaskServResp := OciTaskServResponse{TaskId: &taskId}
@@ -457,10 +463,1307 @@ func TestDeleteTaskFailedIoRead(test *testing.T) {
 	httpClientMock.On("SendRequest", mock.Anything).Return(&httpResp, nil).Once()
 	httpClientMock.On("IoRead", mock.Anything).Return(nil, errors.New("IoRead Failed")).Once()
 
-	apiResp, err := ociTaskServClient.DeleteTask(&taskId)
+	apiResp, err := ociTaskServClient.DeleteTask(context.Background(), &taskId)
 
 	httpClientMock.AssertExpectations(test)
 
 	assert.Error(test, err, "TestDeleteTaskFailedIoRead Failed: Error expected")
 	assert.Nil(test, apiResp, "TestDeleteTaskFailedIoRead Failed: Invalid api response expected")
 }
+
+func TestGetTaskSendsRequestId(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId := int64(1001)
+	task := OciTask{Id: &taskId}
+	ociTaskServResp := OciTaskServResponse{Task: &task}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 200,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+	httpResp.Header.Set(OciTaskRequestIdHeader, "server-1001")
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Header.Get(OciTaskRequestIdHeader) == "client-1001"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	ctx := ContextWithOciTaskRequestId(context.Background(), "client-1001")
+	apiResp, err := ociTaskServClient.GetTask(ctx, &taskId)
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestGetTaskSendsRequestId Failed: No error expected")
+	assert.Equal(test, "client-1001", apiResp.ClientRequestId, "TestGetTaskSendsRequestId Failed: Client request id doesn't match with expected value")
+	assert.Equal(test, "server-1001", apiResp.ServerRequestId, "TestGetTaskSendsRequestId Failed: Server request id doesn't match with expected value")
+}
+
+func TestGetTaskFailedBadStatusWithRequestId(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId := int64(1001)
+
+	httpResp := http.Response{
+		StatusCode: 500,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(strings.NewReader("Internal Error")),
+	}
+	httpResp.Header.Set(OciTaskRequestIdHeader, "server-1001")
+
+	httpClientMock.On("SendRequest", mock.Anything).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte("Internal Error"), nil).Once()
+
+	apiResp, err := ociTaskServClient.GetTask(context.Background(), &taskId)
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.Nil(test, apiResp, "TestGetTaskFailedBadStatusWithRequestId Failed: Invalid api response expected")
+
+	servErr, ok := err.(*OciTaskServError)
+
+	assert.True(test, ok, "TestGetTaskFailedBadStatusWithRequestId Failed: OciTaskServError expected")
+	assert.Equal(test, 500, servErr.StatusCode, "TestGetTaskFailedBadStatusWithRequestId Failed: Status doesn't match with expected value")
+	assert.NotEmpty(test, servErr.ClientRequestId, "TestGetTaskFailedBadStatusWithRequestId Failed: Generated client request id expected")
+	assert.Equal(test, "server-1001", servErr.ServerRequestId, "TestGetTaskFailedBadStatusWithRequestId Failed: Server request id doesn't match with expected value")
+	assert.Contains(test, err.Error(), "server request id: server-1001", "TestGetTaskFailedBadStatusWithRequestId Failed: Server request id expected in error message")
+}
+
+func TestGetTaskSendsUserAgent(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+	ociTaskServClient.SetUserAgent("terraform-provider-ocitask/1.0.0 ci-pipeline")
+
+	taskId := int64(1001)
+	task := OciTask{Id: &taskId}
+	ociTaskServResp := OciTaskServResponse{Task: &task}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Header.Get("User-Agent") == "terraform-provider-ocitask/1.0.0 ci-pipeline"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	_, err := ociTaskServClient.GetTask(context.Background(), &taskId)
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestGetTaskSendsUserAgent Failed: No error expected")
+}
+
+func TestMakeOciTaskServClientDefaultUserAgent(test *testing.T) {
+	url := HostUrl
+	ociTaskServClient := MakeOciTaskServClient(&url)
+
+	assert.True(test, strings.HasPrefix(ociTaskServClient.GetUserAgent(), "ocitaskclient (go"), "TestMakeOciTaskServClientDefaultUserAgent Failed: Default User-Agent doesn't match with expected value")
+}
+
+func TestListTasksSuccess(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	firstId := int64(1001)
+	secondId := int64(1002)
+	ociTaskServResp := OciTaskServResponse{Tasks: []OciTask{
+		{Id: &firstId, Tags: map[string]string{"team": "platform"}},
+		{Id: &secondId, Tags: map[string]string{"team": "storage"}},
+	}}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	filter := MakeOciTaskListFilter()
+	filter.Tags = map[string]string{"Team": "platform"}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "GET" && apiRequest.URL.String() == HostUrl+"/tasks?tag=team%3Aplatform"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.ListTasks(context.Background(), filter)
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestListTasksSuccess Failed: No error expected")
+	assert.Equal(test, 1, len(apiResp.Tasks), "TestListTasksSuccess Failed: Only matching Tasks expected")
+	assert.Equal(test, int64(1001), *apiResp.Tasks[0].Id, "TestListTasksSuccess Failed: Task Id doesn't match with expected value")
+}
+
+func TestListTasksFailedBadStatus(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	httpResp := http.Response{
+		StatusCode: 500,
+		Body:       ioutil.NopCloser(strings.NewReader("Internal Error")),
+	}
+
+	httpClientMock.On("SendRequest", mock.Anything).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte("Internal Error"), nil).Once()
+
+	apiResp, err := ociTaskServClient.ListTasks(context.Background(), nil)
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.Error(test, err, "TestListTasksFailedBadStatus Failed: Error expected")
+	assert.Nil(test, apiResp, "TestListTasksFailedBadStatus Failed: Invalid api response expected")
+}
+
+func TestListTasksFailedSendRequest(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	httpClientMock.On("SendRequest", mock.Anything).Return(nil, errors.New("SendRequest Failed")).Once()
+
+	apiResp, err := ociTaskServClient.ListTasks(context.Background(), nil)
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.Error(test, err, "TestListTasksFailedSendRequest Failed: Error expected")
+	assert.Nil(test, apiResp, "TestListTasksFailedSendRequest Failed: No api response expected")
+}
+
+func TestListChildTasksSuccess(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId := int64(1001)
+	childId := int64(1002)
+	ociTaskServResp := OciTaskServResponse{Tasks: []OciTask{{Id: &childId, ParentId: &taskId}}}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "GET" && apiRequest.URL.String() == HostUrl+"/tasks/1001/children"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.ListChildTasks(context.Background(), &taskId)
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestListChildTasksSuccess Failed: No error expected")
+	assert.Equal(test, 1, len(apiResp.Tasks), "TestListChildTasksSuccess Failed: One child Task expected")
+	assert.Equal(test, taskId, *apiResp.Tasks[0].ParentId, "TestListChildTasksSuccess Failed: Parent Id doesn't match with expected value")
+}
+
+func TestListChildTasksFailedInvalidArgument(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	apiResp, err := ociTaskServClient.ListChildTasks(context.Background(), nil)
+
+	assert.Error(test, err, "TestListChildTasksFailedInvalidArgument Failed: Error expected")
+	assert.Nil(test, apiResp, "TestListChildTasksFailedInvalidArgument Failed: Invalid api response expected")
+}
+
+func TestListChildTasksFailedBadStatus(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId := int64(1001)
+
+	httpResp := http.Response{
+		StatusCode: 404,
+		Body:       ioutil.NopCloser(strings.NewReader("Not Found")),
+	}
+
+	httpClientMock.On("SendRequest", mock.Anything).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte("Not Found"), nil).Once()
+
+	apiResp, err := ociTaskServClient.ListChildTasks(context.Background(), &taskId)
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.Error(test, err, "TestListChildTasksFailedBadStatus Failed: Error expected")
+	assert.Nil(test, apiResp, "TestListChildTasksFailedBadStatus Failed: Invalid api response expected")
+}
+
+func TestCreateTaskDependencySuccess(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	dependencyId := int64(1)
+	blockerId := int64(1001)
+	blockedId := int64(1002)
+	dependency := OciTaskDependency{BlockerId: &blockerId, BlockedId: &blockedId}
+	ociTaskServResp := OciTaskServResponse{Dependency: &OciTaskDependency{Id: &dependencyId, BlockerId: &blockerId, BlockedId: &blockedId}}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 201,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "POST" && apiRequest.URL.String() == HostUrl+"/dependencies"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.CreateTaskDependency(context.Background(), &dependency)
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestCreateTaskDependencySuccess Failed: No error expected")
+	assert.Equal(test, dependencyId, *apiResp.Dependency.Id, "TestCreateTaskDependencySuccess Failed: Dependency Id doesn't match with expected value")
+}
+
+func TestCreateTaskDependencyFailedInvalidArgument(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	apiResp, err := ociTaskServClient.CreateTaskDependency(context.Background(), &OciTaskDependency{})
+
+	assert.Error(test, err, "TestCreateTaskDependencyFailedInvalidArgument Failed: Error expected")
+	assert.Nil(test, apiResp, "TestCreateTaskDependencyFailedInvalidArgument Failed: Invalid api response expected")
+}
+
+func TestGetTaskDependencySuccess(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	dependencyId := int64(1)
+	ociTaskServResp := OciTaskServResponse{Dependency: &OciTaskDependency{Id: &dependencyId}}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "GET" && apiRequest.URL.String() == HostUrl+"/dependencies/1"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.GetTaskDependency(context.Background(), &dependencyId)
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestGetTaskDependencySuccess Failed: No error expected")
+	assert.Equal(test, dependencyId, *apiResp.Dependency.Id, "TestGetTaskDependencySuccess Failed: Dependency Id doesn't match with expected value")
+}
+
+func TestDeleteTaskDependencyFailedBadStatus(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	dependencyId := int64(1)
+
+	httpResp := http.Response{
+		StatusCode: 404,
+		Body:       ioutil.NopCloser(strings.NewReader("Not Found")),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "DELETE" && apiRequest.URL.String() == HostUrl+"/dependencies/1"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte("Not Found"), nil).Once()
+
+	apiResp, err := ociTaskServClient.DeleteTaskDependency(context.Background(), &dependencyId)
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.Error(test, err, "TestDeleteTaskDependencyFailedBadStatus Failed: Error expected")
+	assert.Nil(test, apiResp, "TestDeleteTaskDependencyFailedBadStatus Failed: Invalid api response expected")
+}
+
+func TestListTaskDependenciesSuccess(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	blockerId := int64(1001)
+	blockedId := int64(1002)
+	ociTaskServResp := OciTaskServResponse{Dependencies: []OciTaskDependency{{BlockerId: &blockerId, BlockedId: &blockedId}}}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "GET" && apiRequest.URL.String() == HostUrl+"/dependencies?taskId=1001&taskId=1002"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.ListTaskDependencies(context.Background(), []int64{1001, 1002})
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestListTaskDependenciesSuccess Failed: No error expected")
+	assert.Equal(test, 1, len(apiResp.Dependencies), "TestListTaskDependenciesSuccess Failed: One dependency expected")
+}
+
+func TestCreateProjectSuccess(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	projectId := int64(42)
+	name := "Platform"
+	ociTaskServResp := OciTaskServResponse{Project: &OciTaskProject{Id: &projectId, Name: &name}}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 201,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "POST" && apiRequest.URL.String() == HostUrl+"/projects"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.CreateProject(context.Background(), &OciTaskProject{Name: &name})
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestCreateProjectSuccess Failed: No error expected")
+	assert.Equal(test, projectId, *apiResp.Project.Id, "TestCreateProjectSuccess Failed: Project Id doesn't match with expected value")
+}
+
+func TestCreateProjectFailedInvalidArgument(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	apiResp, err := ociTaskServClient.CreateProject(context.Background(), &OciTaskProject{})
+
+	assert.Error(test, err, "TestCreateProjectFailedInvalidArgument Failed: Error expected")
+	assert.Nil(test, apiResp, "TestCreateProjectFailedInvalidArgument Failed: Invalid api response expected")
+}
+
+func TestUpdateProjectSuccess(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	projectId := int64(42)
+	archived := true
+	ociTaskServResp := OciTaskServResponse{Project: &OciTaskProject{Id: &projectId, Archived: &archived}}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "PUT" && apiRequest.URL.String() == HostUrl+"/projects/42"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.UpdateProject(context.Background(), &projectId, &OciTaskProject{Archived: &archived})
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestUpdateProjectSuccess Failed: No error expected")
+	assert.True(test, *apiResp.Project.Archived, "TestUpdateProjectSuccess Failed: Project should be archived")
+}
+
+func TestGetProjectFailedInvalidArgument(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	apiResp, err := ociTaskServClient.GetProject(context.Background(), nil)
+
+	assert.Error(test, err, "TestGetProjectFailedInvalidArgument Failed: Error expected")
+	assert.Nil(test, apiResp, "TestGetProjectFailedInvalidArgument Failed: Invalid api response expected")
+}
+
+func TestDeleteProjectCascade(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	projectId := int64(42)
+
+	httpResp := http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader("{}")),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "DELETE" && apiRequest.URL.String() == HostUrl+"/projects/42?cascade=true"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte("{}"), nil).Once()
+
+	apiResp, err := ociTaskServClient.DeleteProject(context.Background(), &projectId, true)
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestDeleteProjectCascade Failed: No error expected")
+	assert.NotNil(test, apiResp, "TestDeleteProjectCascade Failed: Valid api response expected")
+}
+
+func TestGetUserByEmailSuccess(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	userId := int64(7)
+	prefixEmail := "jdoe@example.com.au"
+	email := "JDoe@example.com"
+	ociTaskServResp := OciTaskServResponse{Users: []OciTaskUser{{Email: &prefixEmail}, {Id: &userId, Email: &email}}}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "GET" && apiRequest.URL.String() == HostUrl+"/users?email=jdoe%40example.com"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.GetUserByEmail(context.Background(), " jdoe@Example.com")
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestGetUserByEmailSuccess Failed: No error expected")
+	assert.Equal(test, userId, *apiResp.User.Id, "TestGetUserByEmailSuccess Failed: Exact match expected")
+}
+
+func TestGetUserByEmailFailedInvalidArgument(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	apiResp, err := ociTaskServClient.GetUserByEmail(context.Background(), " ")
+
+	assert.Error(test, err, "TestGetUserByEmailFailedInvalidArgument Failed: Error expected")
+	assert.Nil(test, apiResp, "TestGetUserByEmailFailedInvalidArgument Failed: Invalid api response expected")
+}
+
+func TestCreateTaskCommentSuccess(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId := int64(1001)
+	commentId := int64(5)
+	body := "Test Comment"
+	ociTaskServResp := OciTaskServResponse{Comment: &OciTaskComment{Id: &commentId, TaskId: &taskId, Body: &body}}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 201,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "POST" && apiRequest.URL.String() == HostUrl+"/tasks/1001/comments"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.CreateTaskComment(context.Background(), &taskId, &OciTaskComment{Body: &body})
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestCreateTaskCommentSuccess Failed: No error expected")
+	assert.Equal(test, commentId, *apiResp.Comment.Id, "TestCreateTaskCommentSuccess Failed: Comment Id doesn't match with expected value")
+}
+
+func TestCreateTaskCommentFailedInvalidArgument(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId := int64(1001)
+
+	apiResp, err := ociTaskServClient.CreateTaskComment(context.Background(), &taskId, &OciTaskComment{})
+
+	assert.Error(test, err, "TestCreateTaskCommentFailedInvalidArgument Failed: Error expected")
+	assert.Nil(test, apiResp, "TestCreateTaskCommentFailedInvalidArgument Failed: Invalid api response expected")
+}
+
+func TestUpdateTaskCommentSuccess(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId := int64(1001)
+	commentId := int64(5)
+	body := "Edited Comment"
+	ociTaskServResp := OciTaskServResponse{Comment: &OciTaskComment{Id: &commentId, Body: &body}}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "PUT" && apiRequest.URL.String() == HostUrl+"/tasks/1001/comments/5"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.UpdateTaskComment(context.Background(), &taskId, &commentId, &OciTaskComment{Body: &body})
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestUpdateTaskCommentSuccess Failed: No error expected")
+	assert.Equal(test, body, *apiResp.Comment.Body, "TestUpdateTaskCommentSuccess Failed: Comment Body doesn't match with expected value")
+}
+
+func TestDeleteTaskCommentFailedInvalidArgument(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId := int64(1001)
+
+	apiResp, err := ociTaskServClient.DeleteTaskComment(context.Background(), &taskId, nil)
+
+	assert.Error(test, err, "TestDeleteTaskCommentFailedInvalidArgument Failed: Error expected")
+	assert.Nil(test, apiResp, "TestDeleteTaskCommentFailedInvalidArgument Failed: Invalid api response expected")
+}
+
+func TestListTaskCommentsSortsThread(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId := int64(1001)
+	firstId, secondId := int64(1), int64(2)
+	firstTime, secondTime := int64(1000), int64(2000)
+	ociTaskServResp := OciTaskServResponse{Comments: []OciTaskComment{{Id: &secondId, TimeCreated: &secondTime}, {Id: &firstId, TimeCreated: &firstTime}}}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "GET" && apiRequest.URL.String() == HostUrl+"/tasks/1001/comments"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.ListTaskComments(context.Background(), &taskId)
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestListTaskCommentsSortsThread Failed: No error expected")
+	assert.Equal(test, firstId, *apiResp.Comments[0].Id, "TestListTaskCommentsSortsThread Failed: Oldest comment expected first")
+}
+
+func TestUploadTaskAttachmentSuccess(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId := int64(1001)
+	attachmentId := int64(7)
+	ociTaskServResp := OciTaskServResponse{Attachment: &OciTaskAttachment{Id: &attachmentId, TaskId: &taskId}}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 201,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	fileName, fileContent := "", ""
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "POST" && apiRequest.URL.String() == HostUrl+"/tasks/1001/attachments"
+	})).Run(func(args mock.Arguments) {
+		apiRequest := args.Get(0).(*http.Request)
+		_, params, _ := mime.ParseMediaType(apiRequest.Header.Get("Content-Type"))
+		part, err := multipart.NewReader(apiRequest.Body, params["boundary"]).NextPart()
+		if err == nil {
+			data, _ := ioutil.ReadAll(part)
+			fileName, fileContent = part.FileName(), string(data)
+		}
+	}).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.UploadTaskAttachment(context.Background(), &taskId, "notes.txt", strings.NewReader("Test Content"))
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestUploadTaskAttachmentSuccess Failed: No error expected")
+	assert.Equal(test, attachmentId, *apiResp.Attachment.Id, "TestUploadTaskAttachmentSuccess Failed: Attachment Id doesn't match with expected value")
+	assert.Equal(test, "notes.txt", fileName, "TestUploadTaskAttachmentSuccess Failed: File name should be sent in multipart form")
+	assert.Equal(test, "Test Content", fileContent, "TestUploadTaskAttachmentSuccess Failed: File content should be sent in multipart form")
+}
+
+func TestUploadTaskAttachmentFailedInvalidArgument(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId := int64(1001)
+
+	apiResp, err := ociTaskServClient.UploadTaskAttachment(context.Background(), &taskId, "", strings.NewReader("Test Content"))
+
+	assert.Error(test, err, "TestUploadTaskAttachmentFailedInvalidArgument Failed: Error expected")
+	assert.Nil(test, apiResp, "TestUploadTaskAttachmentFailedInvalidArgument Failed: Invalid api response expected")
+}
+
+func TestUploadTaskAttachmentFailedSendRequest(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId := int64(1001)
+
+	httpClientMock.On("SendRequest", mock.Anything).Return(nil, errors.New("SendRequest Failed")).Once()
+
+	apiResp, err := ociTaskServClient.UploadTaskAttachment(context.Background(), &taskId, "notes.txt", strings.NewReader(strings.Repeat("x", 1<<20)))
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.Error(test, err, "TestUploadTaskAttachmentFailedSendRequest Failed: Error expected")
+	assert.Nil(test, apiResp, "TestUploadTaskAttachmentFailedSendRequest Failed: Invalid api response expected")
+}
+
+func TestGetTaskAttachmentSuccess(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId := int64(1001)
+	attachmentId := int64(7)
+	fileName := "notes.txt"
+	ociTaskServResp := OciTaskServResponse{Attachment: &OciTaskAttachment{Id: &attachmentId, FileName: &fileName}}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "GET" && apiRequest.URL.String() == HostUrl+"/tasks/1001/attachments/7"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.GetTaskAttachment(context.Background(), &taskId, &attachmentId)
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestGetTaskAttachmentSuccess Failed: No error expected")
+	assert.Equal(test, "notes.txt", *apiResp.Attachment.FileName, "TestGetTaskAttachmentSuccess Failed: File name doesn't match with expected value")
+}
+
+func TestDeleteTaskAttachmentSuccess(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId := int64(1001)
+	attachmentId := int64(7)
+
+	httpResp := http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader("{}")),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "DELETE" && apiRequest.URL.String() == HostUrl+"/tasks/1001/attachments/7"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte("{}"), nil).Once()
+
+	_, err := ociTaskServClient.DeleteTaskAttachment(context.Background(), &taskId, &attachmentId)
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestDeleteTaskAttachmentSuccess Failed: No error expected")
+}
+
+func TestDownloadTaskAttachmentSuccess(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url, metrics: MakeOciTaskMetrics()}
+
+	taskId := int64(1001)
+	attachmentId := int64(7)
+
+	httpResp := http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader("Test Content")),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "GET" && apiRequest.URL.String() == HostUrl+"/tasks/1001/attachments/7/content" &&
+			apiRequest.Header.Get("Accept") == "application/octet-stream"
+	})).Return(&httpResp, nil).Once()
+
+	var content bytes.Buffer
+	err := ociTaskServClient.DownloadTaskAttachment(context.Background(), &taskId, &attachmentId, &content)
+
+	httpClientMock.AssertExpectations(test)
+	httpClientMock.AssertNotCalled(test, "IoRead", mock.Anything)
+
+	assert.NoError(test, err, "TestDownloadTaskAttachmentSuccess Failed: No error expected")
+	assert.Equal(test, "Test Content", content.String(), "TestDownloadTaskAttachmentSuccess Failed: Content should be streamed to writer")
+	assert.Equal(test, int64(1), ociTaskServClient.GetMetrics().Snapshot()["DownloadTaskAttachment"].Count, "TestDownloadTaskAttachmentSuccess Failed: DownloadTaskAttachment call should be counted")
+}
+
+func TestDownloadTaskAttachmentFailedBadStatus(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId := int64(1001)
+	attachmentId := int64(7)
+	strErr := "{\"error\":{\"code\":\"NotFound\"}}"
+
+	httpResp := http.Response{
+		StatusCode: 404,
+		Body:       ioutil.NopCloser(strings.NewReader(strErr)),
+	}
+
+	httpClientMock.On("SendRequest", mock.Anything).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strErr), nil).Once()
+
+	var content bytes.Buffer
+	err := ociTaskServClient.DownloadTaskAttachment(context.Background(), &taskId, &attachmentId, &content)
+
+	httpClientMock.AssertExpectations(test)
+
+	var ociTaskServError *OciTaskServError
+	assert.ErrorAs(test, err, &ociTaskServError, "TestDownloadTaskAttachmentFailedBadStatus Failed: OciTaskServError expected")
+	assert.Equal(test, 404, ociTaskServError.StatusCode, "TestDownloadTaskAttachmentFailedBadStatus Failed: Status code should be kept")
+	assert.Equal(test, 0, content.Len(), "TestDownloadTaskAttachmentFailedBadStatus Failed: Error body shouldn't be written to content")
+}
+
+func TestCreateTaskReminderSuccess(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId := int64(1001)
+	reminderId := int64(3)
+	cron, channel := "0 9 * * MON-FRI", "email"
+	ociTaskServResp := OciTaskServResponse{Reminder: &OciTaskReminder{Id: &reminderId, TaskId: &taskId, Cron: &cron, Channel: &channel}}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 201,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "POST" && apiRequest.URL.String() == HostUrl+"/tasks/1001/reminders"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.CreateTaskReminder(context.Background(), &taskId, &OciTaskReminder{Cron: &cron, Channel: &channel})
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestCreateTaskReminderSuccess Failed: No error expected")
+	assert.Equal(test, reminderId, *apiResp.Reminder.Id, "TestCreateTaskReminderSuccess Failed: Reminder Id doesn't match with expected value")
+}
+
+func TestCreateTaskReminderFailedInvalidArgument(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId := int64(1001)
+	cron, offset := "@daily", int64(3600)
+
+	apiResp, err := ociTaskServClient.CreateTaskReminder(context.Background(), &taskId, &OciTaskReminder{})
+	assert.Error(test, err, "TestCreateTaskReminderFailedInvalidArgument Failed: Error expected without schedule")
+	assert.Nil(test, apiResp, "TestCreateTaskReminderFailedInvalidArgument Failed: Invalid api response expected")
+
+	apiResp, err = ociTaskServClient.CreateTaskReminder(context.Background(), &taskId, &OciTaskReminder{Cron: &cron, OffsetSeconds: &offset})
+	assert.Error(test, err, "TestCreateTaskReminderFailedInvalidArgument Failed: Error expected with both cron and offset")
+	assert.Nil(test, apiResp, "TestCreateTaskReminderFailedInvalidArgument Failed: Invalid api response expected")
+}
+
+func TestUpdateTaskReminderSuccess(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId, reminderId := int64(1001), int64(3)
+	offset := int64(86400)
+	ociTaskServResp := OciTaskServResponse{Reminder: &OciTaskReminder{Id: &reminderId, TaskId: &taskId, OffsetSeconds: &offset}}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "PUT" && apiRequest.URL.String() == HostUrl+"/tasks/1001/reminders/3"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.UpdateTaskReminder(context.Background(), &taskId, &reminderId, &OciTaskReminder{OffsetSeconds: &offset})
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestUpdateTaskReminderSuccess Failed: No error expected")
+	assert.Equal(test, offset, *apiResp.Reminder.OffsetSeconds, "TestUpdateTaskReminderSuccess Failed: Reminder Offset doesn't match with expected value")
+}
+
+func TestDeleteTaskReminderFailedInvalidArgument(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId := int64(1001)
+
+	apiResp, err := ociTaskServClient.DeleteTaskReminder(context.Background(), &taskId, nil)
+
+	assert.Error(test, err, "TestDeleteTaskReminderFailedInvalidArgument Failed: Error expected")
+	assert.Nil(test, apiResp, "TestDeleteTaskReminderFailedInvalidArgument Failed: Invalid api response expected")
+}
+
+func TestListTaskRemindersSorted(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId := int64(1001)
+	firstId, secondId := int64(1), int64(2)
+	ociTaskServResp := OciTaskServResponse{Reminders: []OciTaskReminder{{Id: &secondId}, {Id: &firstId}}}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "GET" && apiRequest.URL.String() == HostUrl+"/tasks/1001/reminders"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.ListTaskReminders(context.Background(), &taskId)
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestListTaskRemindersSorted Failed: No error expected")
+	assert.Equal(test, firstId, *apiResp.Reminders[0].Id, "TestListTaskRemindersSorted Failed: Reminders expected in Id order")
+}
+
+func TestCreateTaskTimeEntrySuccess(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId, timeEntryId := int64(1001), int64(4)
+	minutes, date := int64(45), "2023-03-15"
+	ociTaskServResp := OciTaskServResponse{TimeEntry: &OciTaskTimeEntry{Id: &timeEntryId, TaskId: &taskId, Minutes: &minutes, Date: &date}}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 201,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "POST" && apiRequest.URL.String() == HostUrl+"/tasks/1001/time-entries"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.CreateTaskTimeEntry(context.Background(), &taskId, &OciTaskTimeEntry{Minutes: &minutes, Date: &date})
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestCreateTaskTimeEntrySuccess Failed: No error expected")
+	assert.Equal(test, timeEntryId, *apiResp.TimeEntry.Id, "TestCreateTaskTimeEntrySuccess Failed: Time Entry Id doesn't match with expected value")
+}
+
+func TestCreateTaskTimeEntryFailedInvalidArgument(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId, minutes := int64(1001), int64(45)
+
+	apiResp, err := ociTaskServClient.CreateTaskTimeEntry(context.Background(), &taskId, &OciTaskTimeEntry{Minutes: &minutes})
+
+	assert.Error(test, err, "TestCreateTaskTimeEntryFailedInvalidArgument Failed: Error expected without date")
+	assert.Nil(test, apiResp, "TestCreateTaskTimeEntryFailedInvalidArgument Failed: Invalid api response expected")
+}
+
+func TestDeleteTaskTimeEntryFailedInvalidArgument(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	apiResp, err := ociTaskServClient.DeleteTaskTimeEntry(context.Background(), nil, nil)
+
+	assert.Error(test, err, "TestDeleteTaskTimeEntryFailedInvalidArgument Failed: Error expected")
+	assert.Nil(test, apiResp, "TestDeleteTaskTimeEntryFailedInvalidArgument Failed: Invalid api response expected")
+}
+
+func TestListTaskTimeEntriesSorted(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId := int64(1001)
+	firstId, secondId := int64(1), int64(2)
+	firstDate, secondDate := "2023-03-14", "2023-03-15"
+	ociTaskServResp := OciTaskServResponse{TimeEntries: []OciTaskTimeEntry{{Id: &firstId, Date: &secondDate}, {Id: &secondId, Date: &firstDate}}}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "GET" && apiRequest.URL.String() == HostUrl+"/tasks/1001/time-entries"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.ListTaskTimeEntries(context.Background(), &taskId)
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestListTaskTimeEntriesSorted Failed: No error expected")
+	assert.Equal(test, secondId, *apiResp.TimeEntries[0].Id, "TestListTaskTimeEntriesSorted Failed: Earliest date expected first")
+}
+
+func TestCreateCustomFieldSuccess(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	projectId, customFieldId := int64(42), int64(7)
+	name, fieldType := "environment", OciTaskCustomFieldEnum
+	ociTaskServResp := OciTaskServResponse{CustomField: &OciTaskCustomField{Id: &customFieldId, ProjectId: &projectId, Name: &name, Type: &fieldType}}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 201,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "POST" && apiRequest.URL.String() == HostUrl+"/projects/42/custom-fields"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.CreateCustomField(context.Background(), &projectId, &OciTaskCustomField{Name: &name, Type: &fieldType, Options: []string{"dev", "prod"}})
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestCreateCustomFieldSuccess Failed: No error expected")
+	assert.Equal(test, customFieldId, *apiResp.CustomField.Id, "TestCreateCustomFieldSuccess Failed: Custom Field Id doesn't match with expected value")
+}
+
+func TestCreateCustomFieldFailedInvalidArgument(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	projectId, name := int64(42), "environment"
+
+	apiResp, err := ociTaskServClient.CreateCustomField(context.Background(), &projectId, &OciTaskCustomField{Name: &name})
+
+	assert.Error(test, err, "TestCreateCustomFieldFailedInvalidArgument Failed: Error expected without type")
+	assert.Nil(test, apiResp, "TestCreateCustomFieldFailedInvalidArgument Failed: Invalid api response expected")
+}
+
+func TestListCustomFieldsSorted(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	projectId := int64(42)
+	first, second := "cost_center", "environment"
+	ociTaskServResp := OciTaskServResponse{CustomFields: []OciTaskCustomField{{Name: &second}, {Name: &first}}}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "GET" && apiRequest.URL.String() == HostUrl+"/projects/42/custom-fields"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.ListCustomFields(context.Background(), &projectId)
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestListCustomFieldsSorted Failed: No error expected")
+	assert.Equal(test, first, *apiResp.CustomFields[0].Name, "TestListCustomFieldsSorted Failed: Custom fields expected in order of name")
+}
+
+func TestArchiveTaskSuccess(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId := int64(1001)
+	strOciTaskServResp := "{}"
+
+	httpResp := http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "POST" && apiRequest.URL.String() == HostUrl+"/tasks/1001/archive"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.ArchiveTask(context.Background(), &taskId)
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestArchiveTaskSuccess Failed: No error expected")
+	assert.NotNil(test, apiResp, "TestArchiveTaskSuccess Failed: Valid api response expected")
+}
+
+func TestRestoreTaskSuccess(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId := int64(1001)
+	strOciTaskServResp := "{}"
+
+	httpResp := http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "POST" && apiRequest.URL.String() == HostUrl+"/tasks/1001/restore"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.RestoreTask(context.Background(), &taskId)
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestRestoreTaskSuccess Failed: No error expected")
+	assert.NotNil(test, apiResp, "TestRestoreTaskSuccess Failed: Valid api response expected")
+}
+
+func TestArchiveTaskFailedBadId(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	apiResp, err := ociTaskServClient.ArchiveTask(context.Background(), nil)
+
+	assert.Error(test, err, "TestArchiveTaskFailedBadId Failed: Error expected")
+	assert.Nil(test, apiResp, "TestArchiveTaskFailedBadId Failed: Invalid api response expected")
+}
+
+func TestGetTaskHistorySorted(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId := int64(1001)
+	ociTaskServResp := OciTaskServResponse{Revisions: []OciTaskRevision{makeTestOciTaskRevision(2, "Write user docs", "todo"), makeTestOciTaskRevision(1, "Write docs", "todo")}}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "GET" && apiRequest.URL.String() == HostUrl+"/tasks/1001/history"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.GetTaskHistory(context.Background(), &taskId)
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestGetTaskHistorySorted Failed: No error expected")
+	assert.Equal(test, int64(1), *apiResp.Revisions[0].Revision, "TestGetTaskHistorySorted Failed: Oldest revision expected first")
+}
+
+func TestGetTaskHistoryInvalidId(test *testing.T) {
+	ociTaskServClient := OciTaskServClient{}
+
+	_, err := ociTaskServClient.GetTaskHistory(context.Background(), nil)
+
+	assert.Error(test, err, "TestGetTaskHistoryInvalidId Failed: Error expected")
+}
+
+func TestCreateWebhookSuccess(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	webhookId := int64(5)
+	hookUrl, secret := "https://hooks.example.com/tasks", "s3cr3t"
+	ociTaskServResp := OciTaskServResponse{Webhook: &OciTaskWebhook{Id: &webhookId, Url: &hookUrl, Events: []string{OciTaskWebhookEventTaskCreated}}}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 201,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "POST" && apiRequest.URL.String() == HostUrl+"/webhooks"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.CreateWebhook(context.Background(), &OciTaskWebhook{Url: &hookUrl, Events: []string{OciTaskWebhookEventTaskCreated}, Secret: &secret})
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestCreateWebhookSuccess Failed: No error expected")
+	assert.Equal(test, webhookId, *apiResp.Webhook.Id, "TestCreateWebhookSuccess Failed: Webhook Id doesn't match with expected value")
+}
+
+func TestCreateWebhookFailedInvalidArgument(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	apiResp, err := ociTaskServClient.CreateWebhook(context.Background(), &OciTaskWebhook{Events: []string{OciTaskWebhookEventTaskCreated}})
+
+	assert.Error(test, err, "TestCreateWebhookFailedInvalidArgument Failed: Error expected without URL")
+	assert.Nil(test, apiResp, "TestCreateWebhookFailedInvalidArgument Failed: Invalid api response expected")
+}
+
+func TestDeleteWebhookSuccess(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	webhookId := int64(5)
+	strOciTaskServResp := "{}"
+
+	httpResp := http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "DELETE" && apiRequest.URL.String() == HostUrl+"/webhooks/5"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.DeleteWebhook(context.Background(), &webhookId)
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestDeleteWebhookSuccess Failed: No error expected")
+	assert.Nil(test, apiResp.Err, "TestDeleteWebhookSuccess Failed: No OciError expected")
+}
+
+func TestGrantPermissionOnProjectSuccess(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	permission := makeTestOciTaskPermission(9, OciTaskPermissionTargetProject, 42, OciTaskPrincipalGroup, "platform", OciTaskRoleEditor)
+	ociTaskServResp := OciTaskServResponse{Permission: &permission}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 201,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "POST" && apiRequest.URL.String() == HostUrl+"/projects/42/permissions"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.GrantPermission(context.Background(), &permission)
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestGrantPermissionOnProjectSuccess Failed: No error expected")
+	assert.Equal(test, int64(9), *apiResp.Permission.Id, "TestGrantPermissionOnProjectSuccess Failed: Permission Id doesn't match with expected value")
+}
+
+func TestGrantPermissionFailedInvalidRole(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	permission := makeTestOciTaskPermission(9, OciTaskPermissionTargetTask, 1001, OciTaskPrincipalUser, "jdoe@example.com", "admin")
+
+	apiResp, err := ociTaskServClient.GrantPermission(context.Background(), &permission)
+
+	assert.Error(test, err, "TestGrantPermissionFailedInvalidRole Failed: Error expected")
+	assert.Nil(test, apiResp, "TestGrantPermissionFailedInvalidRole Failed: Invalid api response expected")
+}
+
+func TestListEffectivePermissionsSuccess(test *testing.T) {
+	httpClientMock := OciTaskHttpMock{}
+	url := HostUrl
+	ociTaskServClient := OciTaskServClient{httpClient: &httpClientMock, hostUrl: &url}
+
+	taskId := int64(1001)
+	ociTaskServResp := OciTaskServResponse{Permissions: []OciTaskPermission{
+		makeTestOciTaskPermission(1, OciTaskPermissionTargetTask, 1001, OciTaskPrincipalUser, "jdoe@example.com", OciTaskRoleViewer),
+		makeTestOciTaskPermission(2, OciTaskPermissionTargetProject, 42, OciTaskPrincipalGroup, "platform", OciTaskRoleOwner),
+	}}
+	strOciTaskServResp, _ := ociTaskServResp.Serialize()
+
+	httpResp := http.Response{
+		StatusCode: 200,
+		Body:       ioutil.NopCloser(strings.NewReader(strOciTaskServResp)),
+	}
+
+	httpClientMock.On("SendRequest", mock.MatchedBy(func(apiRequest *http.Request) bool {
+		return apiRequest.Method == "GET" && apiRequest.URL.String() == HostUrl+"/tasks/1001/permissions/effective?principal=jdoe%40example.com&principalType=user"
+	})).Return(&httpResp, nil).Once()
+	httpClientMock.On("IoRead", mock.Anything).Return([]byte(strOciTaskServResp), nil).Once()
+
+	apiResp, err := ociTaskServClient.ListEffectivePermissions(context.Background(), OciTaskPermissionTargetTask, &taskId, OciTaskPrincipalUser, "JDoe@Example.com")
+
+	httpClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestListEffectivePermissionsSuccess Failed: No error expected")
+	assert.Equal(test, OciTaskRoleOwner, *apiResp.Permissions[0].Role, "TestListEffectivePermissionsSuccess Failed: Most privileged permission expected first")
+}
+
+func TestRevokePermissionFailedInvalidTarget(test *testing.T) {
+	ociTaskServClient := OciTaskServClient{}
+
+	targetId, permissionId := int64(1001), int64(9)
+	_, err := ociTaskServClient.RevokePermission(context.Background(), "board", &targetId, &permissionId)
+
+	assert.Error(test, err, "TestRevokePermissionFailedInvalidTarget Failed: Error expected")
+}
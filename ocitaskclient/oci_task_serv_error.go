@@ -0,0 +1,72 @@
+package ocitaskclient
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+/**
+ * @brief Error returned by OCI Task Service Client when call to OCI Task Service fails.
+ *			Carries request identifiers so failures can be traced in service logs.
+ */
+type OciTaskServError struct {
+	Operation       string
+	StatusCode      int
+	Body            string
+	ClientRequestId string
+	ServerRequestId string
+	Err             error
+}
+
+/**
+ * @brief Build error message
+ * @return Error message with status, body with sensitive values masked and request identifiers
+ */
+func (ociTaskServError *OciTaskServError) Error() string {
+	errMsg := ""
+	if ociTaskServError.Err != nil {
+		errMsg = fmt.Sprintf("%s failed - error: %s", ociTaskServError.Operation, ociTaskServError.Err)
+	} else {
+		errMsg = fmt.Sprintf("%s failed - status: %d, body: %s", ociTaskServError.Operation, ociTaskServError.StatusCode, RedactOciTaskBody([]byte(ociTaskServError.Body)))
+	}
+
+	return errMsg + FormatOciTaskRequestIds(ociTaskServError.ClientRequestId, ociTaskServError.ServerRequestId)
+}
+
+/**
+ * @brief Access underlying error
+ * @return Underlying error, nil if OCI Task Service returned bad status
+ */
+func (ociTaskServError *OciTaskServError) Unwrap() error {
+	return ociTaskServError.Err
+}
+
+/**
+ * @brief Check if call failed because object doesn't exist in OCI Task Service, e.g. it was deleted outside of Terraform
+ * @param err Instance of error returned by OCI Task Service Client
+ * @return True if OCI Task Service answered with status 404
+ */
+func IsOciTaskNotFound(err error) bool {
+	var ociTaskServError *OciTaskServError
+	return errors.As(err, &ociTaskServError) && ociTaskServError.Err == nil && ociTaskServError.StatusCode == http.StatusNotFound
+}
+
+/**
+ * @brief Format request identifiers for error messages and diagnostics
+ * @param clientRequestId Request identifier sent by client
+ * @param serverRequestId Request identifier returned by OCI Task Service
+ * @return Formatted request identifiers, empty if none known
+ */
+func FormatOciTaskRequestIds(clientRequestId string, serverRequestId string) string {
+	result := ""
+	if clientRequestId != "" {
+		result += fmt.Sprintf(", client request id: %s", clientRequestId)
+	}
+
+	if serverRequestId != "" {
+		result += fmt.Sprintf(", server request id: %s", serverRequestId)
+	}
+
+	return result
+}
@@ -0,0 +1,61 @@
+package ocitaskclient
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOciTaskServErrorBadStatus(test *testing.T) {
+	servErr := OciTaskServError{
+		Operation:       "GetTask",
+		StatusCode:      404,
+		Body:            "Not Found",
+		ClientRequestId: "client-1001",
+		ServerRequestId: "server-1001",
+	}
+
+	assert.Equal(test, "GetTask failed - status: 404, body: Not Found, client request id: client-1001, server request id: server-1001", servErr.Error(), "TestOciTaskServErrorBadStatus Failed: Wrong error message")
+	assert.Nil(test, servErr.Unwrap(), "TestOciTaskServErrorBadStatus Failed: No underlying error expected")
+}
+
+func TestOciTaskServErrorRedactedBody(test *testing.T) {
+	servErr := OciTaskServError{
+		Operation:  "CreateWebhook",
+		StatusCode: 400,
+		Body:       `{"message":"invalid url","secret":"s3cr3t"}`,
+	}
+
+	assert.NotContains(test, servErr.Error(), "s3cr3t", "TestOciTaskServErrorRedactedBody Failed: Sensitive value not expected in error message")
+	assert.Contains(test, servErr.Error(), "invalid url", "TestOciTaskServErrorRedactedBody Failed: Non sensitive body expected in error message")
+}
+
+func TestOciTaskServErrorWrapped(test *testing.T) {
+	cause := errors.New("connection refused")
+	servErr := &OciTaskServError{
+		Operation:       "CreateTask",
+		ClientRequestId: "client-1001",
+		Err:             cause,
+	}
+
+	assert.Equal(test, "CreateTask failed - error: connection refused, client request id: client-1001", servErr.Error(), "TestOciTaskServErrorWrapped Failed: Wrong error message")
+	assert.True(test, errors.Is(servErr, cause), "TestOciTaskServErrorWrapped Failed: Underlying error expected")
+}
+
+func TestIsOciTaskNotFound(test *testing.T) {
+	notFound := &OciTaskServError{Operation: "GetTask", StatusCode: 404, Body: "Not Found"}
+	badRequest := &OciTaskServError{Operation: "GetTask", StatusCode: 400}
+	wrapped := &OciTaskServError{Operation: "GetTask", Err: errors.New("connection refused")}
+
+	assert.True(test, IsOciTaskNotFound(notFound), "TestIsOciTaskNotFound Failed: Status 404 expected to be not found")
+	assert.True(test, IsOciTaskNotFound(fmt.Errorf("read failed: %w", notFound)), "TestIsOciTaskNotFound Failed: Wrapped status 404 expected to be not found")
+	assert.False(test, IsOciTaskNotFound(badRequest), "TestIsOciTaskNotFound Failed: Status 400 is not not found")
+	assert.False(test, IsOciTaskNotFound(wrapped), "TestIsOciTaskNotFound Failed: Transport error is not not found")
+	assert.False(test, IsOciTaskNotFound(nil), "TestIsOciTaskNotFound Failed: nil is not not found")
+}
+
+func TestFormatOciTaskRequestIdsEmpty(test *testing.T) {
+	assert.Equal(test, "", FormatOciTaskRequestIds("", ""), "TestFormatOciTaskRequestIdsEmpty Failed: Empty result expected")
+}
@@ -4,19 +4,28 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
+	"strings"
 )
 
 /**
  * @brief Request container for OCI Task Service
  */
 type OciTaskServRequest struct {
-	Title       *string `json:"title,omitempty"`
-	Description *string `json:"description,omitempty"`
-	Priority    *int    `json:"priority,omitempty"`
-	Completed   *bool   `json:"completed,omitempty"`
-	StartDate   *string `json:"startDate,omitempty"`
-	DueDate     *string `json:"dueDate,omitempty"`
+	Title           *string                 `json:"title,omitempty"`
+	Description     *string                 `json:"description,omitempty"`
+	Priority        *int                    `json:"priority,omitempty"`
+	Completed       *bool                   `json:"completed,omitempty"`
+	Status          *string                 `json:"status,omitempty"`
+	StartDate       *string                 `json:"startDate,omitempty"`
+	DueDate         *string                 `json:"dueDate,omitempty"`
+	Tags            map[string]string       `json:"tags,omitempty"`
+	ParentId        *int64                  `json:"parentId,omitempty"`
+	ProjectId       *int64                  `json:"projectId,omitempty"`
+	Assignee        *string                 `json:"assignee,omitempty"`
+	Watchers        *[]string               `json:"watchers,omitempty"`
+	EstimateMinutes *int64                  `json:"estimateMinutes,omitempty"`
+	Checklist       *[]OciTaskChecklistItem `json:"checklist,omitempty"`
+	CustomFields    *map[string]string      `json:"customFields,omitempty"`
 }
 
 /**
@@ -27,27 +36,103 @@ type OciTaskServRequest struct {
  */
 func MakeOciTaskServRequest(srcOciTask *interface{}) (*OciTaskServRequest, error) {
 	if srcOciTask == nil {
-		errMsg := "Invalid Argment: Invalid OCI Task passed"
-		log.Println(errMsg)
-		return nil, errors.New(errMsg)
+		return nil, errors.New("Invalid Argment: Invalid OCI Task passed")
 	}
 
 	ociTask := (*srcOciTask).(map[string]interface{})
 	title := ociTask["title"].(string)
 	description := ociTask["description"].(string)
-	priority := ociTask["priority"].(int)
+	priority, hasPriority := ociTask["priority"].(int)
 	completed := ociTask["completed"].(bool)
+	status, _ := ociTask["status"].(string)
 	startDate := ociTask["start_date"].(string)
 	dueDate := ociTask["due_date"].(string)
 
-	return &OciTaskServRequest{
+	// Status wins over legacy completed flag, completed flag is kept in sync for older consumers
+	if status == "" {
+		status = OciTaskStatusFromCompleted(completed)
+	} else if !IsValidOciTaskStatus(status) {
+		return nil, fmt.Errorf("Invalid status %q - expected one of %s", status, strings.Join(OciTaskStatuses, ", "))
+	}
+	completed = OciTaskCompletedFromStatus(status)
+
+	tags, err := NormalizeOciTaskTags(ExpandOciTaskStringMap(ociTask["tags"]))
+	if err != nil {
+		return nil, err
+	}
+
+	ociTaskServRequest := &OciTaskServRequest{
 		Title:       &title,
 		Description: &description,
-		Priority:    &priority,
 		Completed:   &completed,
+		Status:      &status,
 		StartDate:   &startDate,
 		DueDate:     &dueDate,
-	}, nil
+		Tags:        tags,
+	}
+
+	if hasPriority {
+		ociTaskServRequest.Priority = &priority
+	}
+
+	// Parent 0 moves Task to top level
+	if parentId, ok := ociTask["parent_id"].(int); ok {
+		parentId64 := int64(parentId)
+		ociTaskServRequest.ParentId = &parentId64
+	}
+
+	// Project 0 moves Task out of its project
+	if projectId, ok := ociTask["project_id"].(int); ok {
+		projectId64 := int64(projectId)
+		ociTaskServRequest.ProjectId = &projectId64
+	}
+
+	// Empty assignee unassigns Task
+	if assignee, ok := ociTask["assignee"].(string); ok {
+		assignee = NormalizeOciTaskUserEmail(assignee)
+		if assignee != "" {
+			err := ValidateOciTaskUserEmail(assignee)
+			if err != nil {
+				return nil, err
+			}
+		}
+		ociTaskServRequest.Assignee = &assignee
+	}
+
+	// Estimate 0 removes estimate
+	if estimateMinutes, ok := ociTask["estimate_minutes"].(int); ok {
+		if estimateMinutes < 0 {
+			return nil, fmt.Errorf("Invalid estimate %d - must not be negative", estimateMinutes)
+		}
+		estimateMinutes64 := int64(estimateMinutes)
+		ociTaskServRequest.EstimateMinutes = &estimateMinutes64
+	}
+
+	// Empty list removes all watchers
+	if src, ok := ociTask["watchers"]; ok {
+		watchers := NormalizeOciTaskUserEmails(ExpandOciTaskStringList(src))
+		for _, watcher := range watchers {
+			err := ValidateOciTaskUserEmail(watcher)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if watchers == nil {
+			watchers = []string{}
+		}
+		ociTaskServRequest.Watchers = &watchers
+	}
+
+	// Empty map removes all custom field values
+	if src, ok := ociTask["custom_fields"]; ok {
+		customFields := ExpandOciTaskStringMap(src)
+		if customFields == nil {
+			customFields = map[string]string{}
+		}
+		ociTaskServRequest.CustomFields = &customFields
+	}
+
+	return ociTaskServRequest, nil
 }
 
 /**
@@ -58,9 +143,7 @@ func MakeOciTaskServRequest(srcOciTask *interface{}) (*OciTaskServRequest, error
 func (ociTaskServRequest *OciTaskServRequest) Serialize() (string, error) {
 	result := ""
 	data, err := json.Marshal(ociTaskServRequest)
-	if err != nil {
-		log.Println(fmt.Sprintf("Failed to serialize OciTaskServRequest - error=%s", err))
-	} else {
+	if err == nil {
 		result = string(data)
 	}
 
@@ -73,10 +156,5 @@ func (ociTaskServRequest *OciTaskServRequest) Serialize() (string, error) {
  * @return Instance of error if failed
  */
 func (ociTaskServRequest *OciTaskServRequest) Deserialize(data []byte) error {
-	err := json.Unmarshal(data, ociTaskServRequest)
-	if err != nil {
-		log.Println(fmt.Sprintf("Failed to deserialize OciTaskServRequest - error=%s", err))
-	}
-
-	return err
+	return json.Unmarshal(data, ociTaskServRequest)
 }
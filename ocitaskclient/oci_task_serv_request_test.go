@@ -102,3 +102,244 @@ func TestMakeOciTaskServRequestFailedIvalidArgument(test *testing.T) {
 	assert.Error(test, err, "TestMakeOciTaskServRequestFailedIvalidArgument Failed: Error expected")
 	assert.Nil(test, ociTaskServRequest, "TestMakeOciTaskServRequestFailedIvalidArgument Failed: No response expected")
 }
+
+func TestMakeOciTaskServRequestWithTags(test *testing.T) {
+	data := make(map[string]interface{})
+	data["title"] = "Test Task"
+	data["description"] = "Test Task Desc"
+	data["priority"] = 2
+	data["completed"] = true
+	data["start_date"] = "2023-02-11"
+	data["due_date"] = "2023-02-12"
+	data["tags"] = map[string]interface{}{"Team": "Platform"}
+
+	var iData interface{} = data
+
+	ociTaskServRequest, err := MakeOciTaskServRequest(&iData)
+
+	assert.NoError(test, err, "TestMakeOciTaskServRequestWithTags Failed: Failed to create OciTaskServRequest")
+	assert.Equal(test, map[string]string{"team": "Platform"}, ociTaskServRequest.Tags, "TestMakeOciTaskServRequestWithTags Failed: Wrong Task Tags")
+}
+
+func TestMakeOciTaskServRequestFailedDuplicateTags(test *testing.T) {
+	data := make(map[string]interface{})
+	data["title"] = "Test Task"
+	data["description"] = "Test Task Desc"
+	data["priority"] = 2
+	data["completed"] = true
+	data["start_date"] = "2023-02-11"
+	data["due_date"] = "2023-02-12"
+	data["tags"] = map[string]interface{}{"Team": "Platform", "team": "Storage"}
+
+	var iData interface{} = data
+
+	ociTaskServRequest, err := MakeOciTaskServRequest(&iData)
+
+	assert.Error(test, err, "TestMakeOciTaskServRequestFailedDuplicateTags Failed: Error expected")
+	assert.Nil(test, ociTaskServRequest, "TestMakeOciTaskServRequestFailedDuplicateTags Failed: No request expected")
+}
+
+func TestMakeOciTaskServRequestStatusFromCompleted(test *testing.T) {
+	data := make(map[string]interface{})
+	data["title"] = "Test Task"
+	data["description"] = "Test Task Desc"
+	data["priority"] = 2
+	data["completed"] = true
+	data["start_date"] = "2023-02-11"
+	data["due_date"] = "2023-02-12"
+
+	var iData interface{} = data
+
+	ociTaskServRequest, err := MakeOciTaskServRequest(&iData)
+
+	assert.NoError(test, err, "TestMakeOciTaskServRequestStatusFromCompleted Failed: Failed to create OciTaskServRequest")
+	assert.Equal(test, OciTaskStatusDone, *ociTaskServRequest.Status, "TestMakeOciTaskServRequestStatusFromCompleted Failed: Status expected to follow completed flag")
+}
+
+func TestMakeOciTaskServRequestCompletedFromStatus(test *testing.T) {
+	data := make(map[string]interface{})
+	data["title"] = "Test Task"
+	data["description"] = "Test Task Desc"
+	data["priority"] = 2
+	data["completed"] = true
+	data["status"] = OciTaskStatusInProgress
+	data["start_date"] = "2023-02-11"
+	data["due_date"] = "2023-02-12"
+
+	var iData interface{} = data
+
+	ociTaskServRequest, err := MakeOciTaskServRequest(&iData)
+
+	assert.NoError(test, err, "TestMakeOciTaskServRequestCompletedFromStatus Failed: Failed to create OciTaskServRequest")
+	assert.Equal(test, OciTaskStatusInProgress, *ociTaskServRequest.Status, "TestMakeOciTaskServRequestCompletedFromStatus Failed: Wrong Task Status")
+	assert.Equal(test, false, *ociTaskServRequest.Completed, "TestMakeOciTaskServRequestCompletedFromStatus Failed: Completed expected to follow status")
+}
+
+func TestMakeOciTaskServRequestFailedInvalidStatus(test *testing.T) {
+	data := make(map[string]interface{})
+	data["title"] = "Test Task"
+	data["description"] = "Test Task Desc"
+	data["priority"] = 2
+	data["completed"] = false
+	data["status"] = "paused"
+	data["start_date"] = "2023-02-11"
+	data["due_date"] = "2023-02-12"
+
+	var iData interface{} = data
+
+	ociTaskServRequest, err := MakeOciTaskServRequest(&iData)
+
+	assert.Error(test, err, "TestMakeOciTaskServRequestFailedInvalidStatus Failed: Error expected")
+	assert.Nil(test, ociTaskServRequest, "TestMakeOciTaskServRequestFailedInvalidStatus Failed: No request expected")
+}
+
+func TestMakeOciTaskServRequestWithoutPriority(test *testing.T) {
+	data := make(map[string]interface{})
+	data["title"] = "Test Task"
+	data["description"] = "Test Task Desc"
+	data["completed"] = false
+	data["start_date"] = "2023-02-11"
+	data["due_date"] = "2023-02-12"
+
+	var iData interface{} = data
+
+	ociTaskServRequest, err := MakeOciTaskServRequest(&iData)
+
+	assert.NoError(test, err, "TestMakeOciTaskServRequestWithoutPriority Failed: Failed to create OciTaskServRequest")
+	assert.Nil(test, ociTaskServRequest.Priority, "TestMakeOciTaskServRequestWithoutPriority Failed: No priority expected")
+}
+
+func TestMakeOciTaskServRequestWithParent(test *testing.T) {
+	data := make(map[string]interface{})
+	data["title"] = "Test Task"
+	data["description"] = "Test Task Desc"
+	data["completed"] = false
+	data["start_date"] = "2023-02-11"
+	data["due_date"] = "2023-02-12"
+	data["parent_id"] = 1001
+
+	var iData interface{} = data
+
+	ociTaskServRequest, err := MakeOciTaskServRequest(&iData)
+
+	assert.NoError(test, err, "TestMakeOciTaskServRequestWithParent Failed: Failed to create OciTaskServRequest")
+	assert.Equal(test, int64(1001), *ociTaskServRequest.ParentId, "TestMakeOciTaskServRequestWithParent Failed: Wrong Parent Id")
+}
+
+func TestMakeOciTaskServRequestWithProject(test *testing.T) {
+	data := make(map[string]interface{})
+	data["title"] = "Test Task"
+	data["description"] = "Test Task Desc"
+	data["completed"] = false
+	data["start_date"] = "2023-02-11"
+	data["due_date"] = "2023-02-12"
+	data["project_id"] = 42
+
+	var iData interface{} = data
+
+	ociTaskServRequest, err := MakeOciTaskServRequest(&iData)
+
+	assert.NoError(test, err, "TestMakeOciTaskServRequestWithProject Failed: Failed to create OciTaskServRequest")
+	assert.Equal(test, int64(42), *ociTaskServRequest.ProjectId, "TestMakeOciTaskServRequestWithProject Failed: Wrong Project Id")
+}
+
+func TestMakeOciTaskServRequestWithAssignee(test *testing.T) {
+	data := make(map[string]interface{})
+	data["title"] = "Test Task"
+	data["description"] = "Test Task Desc"
+	data["completed"] = false
+	data["start_date"] = "2023-02-11"
+	data["due_date"] = "2023-02-12"
+	data["assignee"] = " JDoe@Example.com"
+	data["watchers"] = []interface{}{"b@example.com", "A@example.com", "a@example.com"}
+
+	var iData interface{} = data
+
+	ociTaskServRequest, err := MakeOciTaskServRequest(&iData)
+
+	assert.NoError(test, err, "TestMakeOciTaskServRequestWithAssignee Failed: Failed to create OciTaskServRequest")
+	assert.Equal(test, "jdoe@example.com", *ociTaskServRequest.Assignee, "TestMakeOciTaskServRequestWithAssignee Failed: Wrong Assignee")
+	assert.Equal(test, []string{"a@example.com", "b@example.com"}, *ociTaskServRequest.Watchers, "TestMakeOciTaskServRequestWithAssignee Failed: Wrong Watchers")
+}
+
+func TestMakeOciTaskServRequestClearsWatchers(test *testing.T) {
+	data := make(map[string]interface{})
+	data["title"] = "Test Task"
+	data["description"] = "Test Task Desc"
+	data["completed"] = false
+	data["start_date"] = "2023-02-11"
+	data["due_date"] = "2023-02-12"
+	data["watchers"] = []interface{}{}
+
+	var iData interface{} = data
+
+	ociTaskServRequest, err := MakeOciTaskServRequest(&iData)
+	assert.NoError(test, err, "TestMakeOciTaskServRequestClearsWatchers Failed: Failed to create OciTaskServRequest")
+
+	dataJson, _ := ociTaskServRequest.Serialize()
+	assert.Contains(test, dataJson, `"watchers":[]`, "TestMakeOciTaskServRequestClearsWatchers Failed: Empty watchers expected in request")
+}
+
+func TestMakeOciTaskServRequestFailedInvalidAssignee(test *testing.T) {
+	data := make(map[string]interface{})
+	data["title"] = "Test Task"
+	data["description"] = "Test Task Desc"
+	data["completed"] = false
+	data["start_date"] = "2023-02-11"
+	data["due_date"] = "2023-02-12"
+	data["assignee"] = "jdoe"
+
+	var iData interface{} = data
+
+	ociTaskServRequest, err := MakeOciTaskServRequest(&iData)
+
+	assert.Error(test, err, "TestMakeOciTaskServRequestFailedInvalidAssignee Failed: Error expected")
+	assert.Nil(test, ociTaskServRequest, "TestMakeOciTaskServRequestFailedInvalidAssignee Failed: No request expected")
+}
+
+func TestMakeOciTaskServRequestWithEstimate(test *testing.T) {
+	data := make(map[string]interface{})
+	data["title"] = "Test Task"
+	data["description"] = "Test Task Desc"
+	data["completed"] = false
+	data["start_date"] = "2023-02-11"
+	data["due_date"] = "2023-02-12"
+	data["estimate_minutes"] = 90
+
+	var iData interface{} = data
+
+	ociTaskServRequest, err := MakeOciTaskServRequest(&iData)
+
+	assert.NoError(test, err, "TestMakeOciTaskServRequestWithEstimate Failed: Failed to create OciTaskServRequest")
+	assert.Equal(test, int64(90), *ociTaskServRequest.EstimateMinutes, "TestMakeOciTaskServRequestWithEstimate Failed: Wrong Task Estimate")
+
+	data["estimate_minutes"] = -1
+
+	_, err = MakeOciTaskServRequest(&iData)
+
+	assert.Error(test, err, "TestMakeOciTaskServRequestWithEstimate Failed: Error expected for negative estimate")
+}
+
+func TestMakeOciTaskServRequestWithCustomFields(test *testing.T) {
+	data := make(map[string]interface{})
+	data["title"] = "Test Task"
+	data["description"] = "Test Task Desc"
+	data["completed"] = false
+	data["start_date"] = "2023-02-11"
+	data["due_date"] = "2023-02-12"
+	data["custom_fields"] = map[string]interface{}{"cost_center": "CC-42"}
+
+	var iData interface{} = data
+
+	ociTaskServRequest, err := MakeOciTaskServRequest(&iData)
+
+	assert.NoError(test, err, "TestMakeOciTaskServRequestWithCustomFields Failed: Failed to create OciTaskServRequest")
+	assert.Equal(test, map[string]string{"cost_center": "CC-42"}, *ociTaskServRequest.CustomFields, "TestMakeOciTaskServRequestWithCustomFields Failed: Wrong Task Custom Fields")
+
+	data["custom_fields"] = map[string]interface{}{}
+
+	ociTaskServRequest, _ = MakeOciTaskServRequest(&iData)
+	dataJson, _ := ociTaskServRequest.Serialize()
+
+	assert.Contains(test, dataJson, `"customFields":{}`, "TestMakeOciTaskServRequestWithCustomFields Failed: Empty custom fields expected in request")
+}
@@ -2,17 +2,36 @@ package ocitaskclient
 
 import (
 	"encoding/json"
-	"fmt"
-	"log"
 )
 
 /**
  * @brief Container for OCI Task Service API Response
  */
 type OciTaskServResponse struct {
-	TaskId *int64    `json:"taskId,omitempty"`
-	Task   *OciTask  `json:"task,omitempty"`
-	Err    *OciError `json:"error,omitempty"`
+	TaskId          *int64               `json:"taskId,omitempty"`
+	Task            *OciTask             `json:"task,omitempty"`
+	Tasks           []OciTask            `json:"tasks,omitempty"`
+	Dependency      *OciTaskDependency   `json:"dependency,omitempty"`
+	Dependencies    []OciTaskDependency  `json:"dependencies,omitempty"`
+	Project         *OciTaskProject      `json:"project,omitempty"`
+	User            *OciTaskUser         `json:"user,omitempty"`
+	Users           []OciTaskUser        `json:"users,omitempty"`
+	Comment         *OciTaskComment      `json:"comment,omitempty"`
+	Comments        []OciTaskComment     `json:"comments,omitempty"`
+	Attachment      *OciTaskAttachment   `json:"attachment,omitempty"`
+	Reminder        *OciTaskReminder     `json:"reminder,omitempty"`
+	Reminders       []OciTaskReminder    `json:"reminders,omitempty"`
+	TimeEntry       *OciTaskTimeEntry    `json:"timeEntry,omitempty"`
+	TimeEntries     []OciTaskTimeEntry   `json:"timeEntries,omitempty"`
+	CustomField     *OciTaskCustomField  `json:"customField,omitempty"`
+	CustomFields    []OciTaskCustomField `json:"customFields,omitempty"`
+	Revisions       []OciTaskRevision    `json:"revisions,omitempty"`
+	Webhook         *OciTaskWebhook      `json:"webhook,omitempty"`
+	Permission      *OciTaskPermission   `json:"permission,omitempty"`
+	Permissions     []OciTaskPermission  `json:"permissions,omitempty"`
+	Err             *OciError            `json:"error,omitempty"`
+	ClientRequestId string               `json:"-"`
+	ServerRequestId string               `json:"-"`
 }
 
 /**
@@ -23,9 +42,7 @@ type OciTaskServResponse struct {
 func (ociTaskServResponse *OciTaskServResponse) Serialize() (string, error) {
 	result := ""
 	data, err := json.Marshal(ociTaskServResponse)
-	if err != nil {
-		log.Println(fmt.Sprintf("Failed to serialize OciTaskServResponse - error=%s", err))
-	} else {
+	if err == nil {
 		result = string(data)
 	}
 
@@ -38,10 +55,5 @@ func (ociTaskServResponse *OciTaskServResponse) Serialize() (string, error) {
  * @return Instance of error if failed
  */
 func (ociTaskServResponse *OciTaskServResponse) Deserialize(data []byte) error {
-	err := json.Unmarshal(data, ociTaskServResponse)
-	if err != nil {
-		log.Println(fmt.Sprintf("Failed to deserialize OciTaskServResponse - error=%s", err))
-	}
-
-	return err
+	return json.Unmarshal(data, ociTaskServResponse)
 }
@@ -0,0 +1,120 @@
+package ocitaskclient
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+/**
+ * @brief Status of Task in OCI Task System
+ */
+const (
+	OciTaskStatusTodo       string = "todo"
+	OciTaskStatusInProgress string = "in_progress"
+	OciTaskStatusBlocked    string = "blocked"
+	OciTaskStatusDone       string = "done"
+	OciTaskStatusCancelled  string = "cancelled"
+)
+
+/**
+ * @brief All valid statuses of Task
+ */
+var OciTaskStatuses = []string{
+	OciTaskStatusTodo,
+	OciTaskStatusInProgress,
+	OciTaskStatusBlocked,
+	OciTaskStatusDone,
+	OciTaskStatusCancelled,
+}
+
+/**
+ * @brief Allowed status transitions of Task. Maps status to statuses Task may move to.
+ *			Staying in the same status is always allowed.
+ */
+type OciTaskStatusTransitions map[string][]string
+
+/**
+ * @brief Constructor for OciTaskStatusTransitions with default workflow.
+ *			Open Tasks may move to any status, blocked Tasks must be unblocked before done,
+ *			done and cancelled Tasks may only be reopened.
+ * @return Instance of OciTaskStatusTransitions
+ */
+func MakeOciTaskStatusTransitions() OciTaskStatusTransitions {
+	return OciTaskStatusTransitions{
+		OciTaskStatusTodo:       {OciTaskStatusInProgress, OciTaskStatusBlocked, OciTaskStatusDone, OciTaskStatusCancelled},
+		OciTaskStatusInProgress: {OciTaskStatusTodo, OciTaskStatusBlocked, OciTaskStatusDone, OciTaskStatusCancelled},
+		OciTaskStatusBlocked:    {OciTaskStatusTodo, OciTaskStatusInProgress, OciTaskStatusCancelled},
+		OciTaskStatusDone:       {OciTaskStatusTodo},
+		OciTaskStatusCancelled:  {OciTaskStatusTodo},
+	}
+}
+
+/**
+ * @brief Check if status is valid
+ * @param status Status of Task
+ * @return True if status is one of OciTaskStatuses
+ */
+func IsValidOciTaskStatus(status string) bool {
+	for _, validStatus := range OciTaskStatuses {
+		if status == validStatus {
+			return true
+		}
+	}
+
+	return false
+}
+
+/**
+ * @brief Check if Task may move from one status to another
+ * @param from Current status of Task
+ * @param to Requested status of Task
+ * @return Instance of error if transition not allowed
+ */
+func (ociTaskStatusTransitions OciTaskStatusTransitions) Validate(from string, to string) error {
+	if !IsValidOciTaskStatus(to) {
+		return fmt.Errorf("Invalid status %q - expected one of %s", to, strings.Join(OciTaskStatuses, ", "))
+	}
+
+	if from == "" || from == to {
+		return nil
+	}
+
+	allowed := ociTaskStatusTransitions[from]
+	for _, status := range allowed {
+		if status == to {
+			return nil
+		}
+	}
+
+	if len(allowed) == 0 {
+		return fmt.Errorf("Status transition from %q to %q not allowed - no transitions allowed from %q", from, to, from)
+	}
+
+	sorted := append([]string(nil), allowed...)
+	sort.Strings(sorted)
+
+	return fmt.Errorf("Status transition from %q to %q not allowed - allowed: %s", from, to, strings.Join(sorted, ", "))
+}
+
+/**
+ * @brief Map legacy completed flag to status
+ * @param completed Completed flag of Task
+ * @return done if completed, todo otherwise
+ */
+func OciTaskStatusFromCompleted(completed bool) string {
+	if completed {
+		return OciTaskStatusDone
+	}
+
+	return OciTaskStatusTodo
+}
+
+/**
+ * @brief Map status to legacy completed flag
+ * @param status Status of Task
+ * @return True if status is done
+ */
+func OciTaskCompletedFromStatus(status string) bool {
+	return status == OciTaskStatusDone
+}
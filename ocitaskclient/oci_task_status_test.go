@@ -0,0 +1,57 @@
+package ocitaskclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOciTaskStatusTransitionsValidateSuccess(test *testing.T) {
+	transitions := MakeOciTaskStatusTransitions()
+
+	assert.NoError(test, transitions.Validate(OciTaskStatusTodo, OciTaskStatusInProgress), "TestOciTaskStatusTransitionsValidateSuccess Failed: todo to in_progress expected to be allowed")
+	assert.NoError(test, transitions.Validate(OciTaskStatusDone, OciTaskStatusDone), "TestOciTaskStatusTransitionsValidateSuccess Failed: Same status expected to be allowed")
+	assert.NoError(test, transitions.Validate("", OciTaskStatusBlocked), "TestOciTaskStatusTransitionsValidateSuccess Failed: Any initial status expected to be allowed")
+}
+
+func TestOciTaskStatusTransitionsValidateFailed(test *testing.T) {
+	transitions := MakeOciTaskStatusTransitions()
+
+	err := transitions.Validate(OciTaskStatusBlocked, OciTaskStatusDone)
+
+	assert.Error(test, err, "TestOciTaskStatusTransitionsValidateFailed Failed: blocked to done expected to be rejected")
+	assert.Contains(test, err.Error(), "allowed: cancelled, in_progress, todo", "TestOciTaskStatusTransitionsValidateFailed Failed: Allowed statuses expected in error")
+}
+
+func TestOciTaskStatusTransitionsValidateFailedInvalidStatus(test *testing.T) {
+	transitions := MakeOciTaskStatusTransitions()
+
+	assert.Error(test, transitions.Validate(OciTaskStatusTodo, "paused"), "TestOciTaskStatusTransitionsValidateFailedInvalidStatus Failed: Unknown status expected to be rejected")
+}
+
+func TestOciTaskStatusTransitionsValidateFailedNoTransitions(test *testing.T) {
+	transitions := OciTaskStatusTransitions{OciTaskStatusTodo: {OciTaskStatusDone}}
+
+	err := transitions.Validate(OciTaskStatusDone, OciTaskStatusTodo)
+
+	assert.Error(test, err, "TestOciTaskStatusTransitionsValidateFailedNoTransitions Failed: Error expected")
+	assert.Contains(test, err.Error(), "no transitions allowed", "TestOciTaskStatusTransitionsValidateFailedNoTransitions Failed: Wrong error")
+}
+
+func TestOciTaskStatusCompletedMapping(test *testing.T) {
+	assert.Equal(test, OciTaskStatusDone, OciTaskStatusFromCompleted(true), "TestOciTaskStatusCompletedMapping Failed: done expected for completed Task")
+	assert.Equal(test, OciTaskStatusTodo, OciTaskStatusFromCompleted(false), "TestOciTaskStatusCompletedMapping Failed: todo expected for open Task")
+	assert.True(test, OciTaskCompletedFromStatus(OciTaskStatusDone), "TestOciTaskStatusCompletedMapping Failed: done Task expected to be completed")
+	assert.False(test, OciTaskCompletedFromStatus(OciTaskStatusCancelled), "TestOciTaskStatusCompletedMapping Failed: cancelled Task expected not to be completed")
+}
+
+func TestOciTaskGetStatus(test *testing.T) {
+	completed := true
+	status := OciTaskStatusBlocked
+
+	ociTask := OciTask{Completed: &completed}
+	assert.Equal(test, OciTaskStatusDone, ociTask.GetStatus(), "TestOciTaskGetStatus Failed: Status expected from completed flag")
+
+	ociTask.Status = &status
+	assert.Equal(test, OciTaskStatusBlocked, ociTask.GetStatus(), "TestOciTaskGetStatus Failed: Status expected from Task")
+}
@@ -0,0 +1,231 @@
+package ocitaskclient
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+/**
+ * @brief Normalise tag key. Keys are compared case-insensitively and without surrounding whitespace.
+ * @param key Tag key as configured
+ * @return Lower case tag key without surrounding whitespace
+ */
+func NormalizeOciTaskTagKey(key string) string {
+	return strings.ToLower(strings.TrimSpace(key))
+}
+
+/**
+ * @brief Normalise tag keys of Task
+ * @param tags Tags as configured
+ * @return Tags with normalised keys if succeeded
+ * @return Instance of error if two keys normalise to the same key or a key is empty
+ */
+func NormalizeOciTaskTags(tags map[string]string) (map[string]string, error) {
+	if tags == nil {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := make(map[string]string)
+	origin := make(map[string]string)
+	for _, key := range keys {
+		normKey := NormalizeOciTaskTagKey(key)
+		if normKey == "" {
+			return nil, fmt.Errorf("Invalid tag key %q - tag key must not be empty", key)
+		}
+
+		if prevKey, ok := origin[normKey]; ok {
+			return nil, fmt.Errorf("Duplicate tag key %q - tag keys %q and %q differ only by case or whitespace", normKey, prevKey, key)
+		}
+
+		origin[normKey] = key
+		result[normKey] = tags[key]
+	}
+
+	return result, nil
+}
+
+/**
+ * @brief Restore casing of tag keys used in configuration for keys returned normalised by OCI Task Service.
+ *			Keeps tag diffs stable when configuration uses mixed case keys.
+ * @param tags Tags returned by OCI Task Service
+ * @param configured Tags as configured, optional
+ * @return Tags with configured key casing where keys match after normalisation
+ */
+func ReconcileOciTaskTags(tags map[string]string, configured map[string]string) map[string]string {
+	if tags == nil {
+		return nil
+	}
+
+	configuredKeys := make(map[string]string)
+	for key := range configured {
+		configuredKeys[NormalizeOciTaskTagKey(key)] = key
+	}
+
+	result := make(map[string]string)
+	for key, value := range tags {
+		if configuredKey, ok := configuredKeys[NormalizeOciTaskTagKey(key)]; ok {
+			result[configuredKey] = value
+		} else {
+			result[key] = value
+		}
+	}
+
+	return result
+}
+
+/**
+ * @brief Merge default tags with tags of Task. Tags of Task win over default tags with same normalised key.
+ * @param defaultTags Default tags configured on provider
+ * @param tags Tags configured on Task
+ * @return Effective tags, nil if both are empty
+ */
+func MergeOciTaskTags(defaultTags map[string]string, tags map[string]string) map[string]string {
+	if len(defaultTags) == 0 && len(tags) == 0 {
+		return nil
+	}
+
+	taskKeys := make(map[string]bool)
+	for key := range tags {
+		taskKeys[NormalizeOciTaskTagKey(key)] = true
+	}
+
+	result := make(map[string]string)
+	for key, value := range defaultTags {
+		if !taskKeys[NormalizeOciTaskTagKey(key)] {
+			result[key] = value
+		}
+	}
+
+	for key, value := range tags {
+		result[key] = value
+	}
+
+	return result
+}
+
+/**
+ * @brief Remove tags supplied by default tags from effective tags returned by OCI Task Service.
+ *			Tag is kept if configured on Task or if its value differs from default value.
+ * @param tags Effective tags returned by OCI Task Service
+ * @param defaultTags Default tags configured on provider
+ * @param configured Tags configured on Task
+ * @return Tags owned by Task
+ */
+func StripOciTaskDefaultTags(tags map[string]string, defaultTags map[string]string, configured map[string]string) map[string]string {
+	if tags == nil {
+		return nil
+	}
+
+	defaults := make(map[string]string)
+	for key, value := range defaultTags {
+		defaults[NormalizeOciTaskTagKey(key)] = value
+	}
+
+	configuredKeys := make(map[string]bool)
+	for key := range configured {
+		configuredKeys[NormalizeOciTaskTagKey(key)] = true
+	}
+
+	result := make(map[string]string)
+	for key, value := range tags {
+		normKey := NormalizeOciTaskTagKey(key)
+		if defaultValue, ok := defaults[normKey]; ok && defaultValue == value && !configuredKeys[normKey] {
+			continue
+		}
+		result[key] = value
+	}
+
+	return result
+}
+
+/**
+ * @brief Check if two sets of tags are equal after normalising keys
+ * @param tags First set of tags
+ * @param otherTags Second set of tags
+ * @return True if both carry same keys and values
+ */
+func EqualOciTaskTags(tags map[string]string, otherTags map[string]string) bool {
+	if len(tags) != len(otherTags) {
+		return false
+	}
+
+	others := make(map[string]string)
+	for key, value := range otherTags {
+		others[NormalizeOciTaskTagKey(key)] = value
+	}
+
+	for key, value := range tags {
+		otherValue, ok := others[NormalizeOciTaskTagKey(key)]
+		if !ok || otherValue != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+/**
+ * @brief Check if Task carries all given tags
+ * @param ociTask Instance of OciTask
+ * @param tags Tags to look for, keys compared after normalisation and values compared exactly
+ * @return True if Task carries all given tags
+ */
+func MatchOciTaskTags(ociTask *OciTask, tags map[string]string) bool {
+	if ociTask == nil {
+		return false
+	}
+
+	taskTags := make(map[string]string)
+	for key, value := range ociTask.Tags {
+		taskTags[NormalizeOciTaskTagKey(key)] = value
+	}
+
+	for key, value := range tags {
+		taskValue, ok := taskTags[NormalizeOciTaskTagKey(key)]
+		if !ok || taskValue != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+/**
+ * @brief Convert generic map from Terraform resource data into map of string
+ * @param src Generic map, values must be strings
+ * @return Map of string, nil if src is nil
+ */
+func ExpandOciTaskStringMap(src interface{}) map[string]string {
+	srcMap, ok := src.(map[string]interface{})
+	if !ok || srcMap == nil {
+		return nil
+	}
+
+	result := make(map[string]string)
+	for key, value := range srcMap {
+		result[key], _ = value.(string)
+	}
+
+	return result
+}
+
+/**
+ * @brief Convert map of string into generic map for Terraform resource data
+ * @param src Map of string
+ * @return Generic map
+ */
+func FlattenOciTaskStringMap(src map[string]string) map[string]interface{} {
+	result := make(map[string]interface{})
+	for key, value := range src {
+		result[key] = value
+	}
+
+	return result
+}
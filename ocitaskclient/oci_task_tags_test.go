@@ -0,0 +1,101 @@
+package ocitaskclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeOciTaskTagsSuccess(test *testing.T) {
+	tags := map[string]string{
+		" Team ":      "Platform",
+		"environment": "prod",
+	}
+
+	result, err := NormalizeOciTaskTags(tags)
+
+	assert.NoError(test, err, "TestNormalizeOciTaskTagsSuccess Failed: No error expected")
+	assert.Equal(test, map[string]string{"team": "Platform", "environment": "prod"}, result, "TestNormalizeOciTaskTagsSuccess Failed: Wrong normalised tags")
+}
+
+func TestNormalizeOciTaskTagsFailedDuplicate(test *testing.T) {
+	tags := map[string]string{
+		"Team": "Platform",
+		"team": "Storage",
+	}
+
+	result, err := NormalizeOciTaskTags(tags)
+
+	assert.Error(test, err, "TestNormalizeOciTaskTagsFailedDuplicate Failed: Error expected")
+	assert.Nil(test, result, "TestNormalizeOciTaskTagsFailedDuplicate Failed: No tags expected")
+}
+
+func TestNormalizeOciTaskTagsFailedEmptyKey(test *testing.T) {
+	result, err := NormalizeOciTaskTags(map[string]string{" ": "Platform"})
+
+	assert.Error(test, err, "TestNormalizeOciTaskTagsFailedEmptyKey Failed: Error expected")
+	assert.Nil(test, result, "TestNormalizeOciTaskTagsFailedEmptyKey Failed: No tags expected")
+}
+
+func TestNormalizeOciTaskTagsNil(test *testing.T) {
+	result, err := NormalizeOciTaskTags(nil)
+
+	assert.NoError(test, err, "TestNormalizeOciTaskTagsNil Failed: No error expected")
+	assert.Nil(test, result, "TestNormalizeOciTaskTagsNil Failed: No tags expected")
+}
+
+func TestReconcileOciTaskTags(test *testing.T) {
+	tags := map[string]string{"team": "Platform", "sprint": "42"}
+	configured := map[string]string{"Team": "Platform"}
+
+	result := ReconcileOciTaskTags(tags, configured)
+
+	assert.Equal(test, map[string]string{"Team": "Platform", "sprint": "42"}, result, "TestReconcileOciTaskTags Failed: Configured casing expected")
+}
+
+func TestMatchOciTaskTags(test *testing.T) {
+	ociTask := OciTask{Tags: map[string]string{"team": "Platform", "environment": "prod"}}
+
+	assert.True(test, MatchOciTaskTags(&ociTask, map[string]string{"Team": "Platform"}), "TestMatchOciTaskTags Failed: Task should match")
+	assert.True(test, MatchOciTaskTags(&ociTask, nil), "TestMatchOciTaskTags Failed: Task should match empty filter")
+	assert.False(test, MatchOciTaskTags(&ociTask, map[string]string{"team": "platform"}), "TestMatchOciTaskTags Failed: Tag values should be compared exactly")
+	assert.False(test, MatchOciTaskTags(&ociTask, map[string]string{"sprint": "42"}), "TestMatchOciTaskTags Failed: Task should not match missing tag")
+	assert.False(test, MatchOciTaskTags(nil, nil), "TestMatchOciTaskTags Failed: Nil Task should not match")
+}
+
+func TestExpandOciTaskStringMap(test *testing.T) {
+	src := map[string]interface{}{"team": "Platform"}
+
+	assert.Equal(test, map[string]string{"team": "Platform"}, ExpandOciTaskStringMap(src), "TestExpandOciTaskStringMap Failed: Wrong map")
+	assert.Nil(test, ExpandOciTaskStringMap(nil), "TestExpandOciTaskStringMap Failed: Nil map expected")
+	assert.Equal(test, map[string]interface{}{"team": "Platform"}, FlattenOciTaskStringMap(map[string]string{"team": "Platform"}), "TestExpandOciTaskStringMap Failed: Wrong generic map")
+}
+
+func TestMergeOciTaskTags(test *testing.T) {
+	defaultTags := map[string]string{"Owner": "platform", "cost_center": "42"}
+	tags := map[string]string{"owner": "storage", "tier": "gold"}
+
+	result := MergeOciTaskTags(defaultTags, tags)
+
+	assert.Equal(test, map[string]string{"owner": "storage", "cost_center": "42", "tier": "gold"}, result, "TestMergeOciTaskTags Failed: Task tags expected to win over default tags")
+}
+
+func TestMergeOciTaskTagsEmpty(test *testing.T) {
+	assert.Nil(test, MergeOciTaskTags(nil, map[string]string{}), "TestMergeOciTaskTagsEmpty Failed: No tags expected")
+}
+
+func TestStripOciTaskDefaultTags(test *testing.T) {
+	tags := map[string]string{"owner": "platform", "cost_center": "7", "tier": "gold", "env": "prod"}
+	defaultTags := map[string]string{"Owner": "platform", "cost_center": "42", "env": "prod"}
+	configured := map[string]string{"env": "prod", "tier": "gold"}
+
+	result := StripOciTaskDefaultTags(tags, defaultTags, configured)
+
+	assert.Equal(test, map[string]string{"cost_center": "7", "tier": "gold", "env": "prod"}, result, "TestStripOciTaskDefaultTags Failed: Wrong tags owned by Task")
+}
+
+func TestEqualOciTaskTags(test *testing.T) {
+	assert.True(test, EqualOciTaskTags(map[string]string{"Team": "a"}, map[string]string{"team": "a"}), "TestEqualOciTaskTags Failed: Tags expected to be equal")
+	assert.False(test, EqualOciTaskTags(map[string]string{"team": "a"}, map[string]string{"team": "b"}), "TestEqualOciTaskTags Failed: Tags expected to differ by value")
+	assert.False(test, EqualOciTaskTags(map[string]string{"team": "a"}, map[string]string{}), "TestEqualOciTaskTags Failed: Tags expected to differ by key")
+}
@@ -113,6 +113,7 @@ func TestFlattenOciTaskSuccess(test *testing.T) {
 	ociTask.TimeCreated = &taskTimeCreated
 	ociTask.TimeUpdated = &taskTimeUpdated
 	ociTask.Title = &taskTitle
+	ociTask.Tags = map[string]string{"team": "Platform"}
 
 	genericOciTasks, diags := FlattenOciTask(&ociTask)
 
@@ -134,6 +135,9 @@ func TestFlattenOciTaskSuccess(test *testing.T) {
 	assert.Equal(test, "Test Task Desc", data["description"].(string), "TestOciTaskSerializeSuccess Failed: Wrong Task Description")
 	assert.Equal(test, 2, int(data["priority"].(float64)), "TestOciTaskSerializeSuccess Failed: Wrong Task Priority")
 	assert.Equal(test, true, data["completed"].(bool), "TestOciTaskSerializeSuccess Failed: Wrong Task Completed")
+	assert.Equal(test, "Platform", data["tags"].(map[string]interface{})["team"], "TestOciTaskSerializeSuccess Failed: Wrong Task Tags")
+	assert.Equal(test, OciTaskStatusDone, data["status"].(string), "TestOciTaskSerializeSuccess Failed: Wrong Task Status")
+	assert.Equal(test, "", data["status_changed_at"].(string), "TestOciTaskSerializeSuccess Failed: Wrong Task Status Changed At")
 
 	taskDate := currTime.Format("yyyy-MM-dd")
 	assert.Equal(test, taskDate, data["start_date"].(string), "TestOciTaskSerializeSuccess Failed: Wrong Task Start Date")
@@ -143,6 +147,35 @@ func TestFlattenOciTaskSuccess(test *testing.T) {
 
 }
 
+func TestFlattenOciTaskWithStatus(test *testing.T) {
+	taskId := int64(1001)
+	taskTitle := "Test Task"
+	taskCompleted := false
+	taskStatus := OciTaskStatusInProgress
+	taskDate := time.Now().Unix() * 1000
+	statusChangedAt := time.Date(2023, 2, 11, 10, 30, 0, 0, time.UTC).UnixMilli()
+
+	ociTask := OciTask{}
+	ociTask.Id = &taskId
+	ociTask.Title = &taskTitle
+	ociTask.Completed = &taskCompleted
+	ociTask.Status = &taskStatus
+	ociTask.StatusChangedAt = &statusChangedAt
+	ociTask.StartDate = &taskDate
+	ociTask.DueDate = &taskDate
+	ociTask.TimeCreated = &taskDate
+	ociTask.TimeUpdated = &taskDate
+
+	genericOciTasks, diags := FlattenOciTask(&ociTask)
+
+	assert.Equal(test, 0, len(diags), "TestFlattenOciTaskWithStatus Failed: Wrong Diag Response")
+
+	data := genericOciTasks[0].(map[string]interface{})
+
+	assert.Equal(test, OciTaskStatusInProgress, data["status"], "TestFlattenOciTaskWithStatus Failed: Wrong Task Status")
+	assert.Equal(test, "2023-02-11T10:30:00Z", data["status_changed_at"], "TestFlattenOciTaskWithStatus Failed: Wrong Task Status Changed At")
+}
+
 func TestFlattenOciTaskFailed(test *testing.T) {
 
 	genericOciTasks, diags := FlattenOciTask(nil)
@@ -153,3 +186,14 @@ func TestFlattenOciTaskFailed(test *testing.T) {
 	assert.Equal(test, "Invalid Argument", diags[0].Summary, "TestFlattenOciTaskFailed Failed: Wrong Diag Summary")
 	assert.Equal(test, "Invalid OciTask instance passed", diags[0].Detail, "TestFlattenOciTaskFailed Failed: Wrong Diag Detail")
 }
+
+func TestOciTaskArchived(test *testing.T) {
+	archived := true
+	timeArchived := time.Date(2023, 3, 15, 10, 0, 0, 0, time.UTC).UnixMilli()
+	task := OciTask{Archived: &archived, TimeArchived: &timeArchived}
+
+	assert.True(test, task.IsArchived(), "TestOciTaskArchived Failed: Task expected to be archived")
+	assert.Equal(test, "2023-03-15T10:00:00Z", task.GetTimeArchived(), "TestOciTaskArchived Failed: Wrong archive time")
+	assert.False(test, (&OciTask{}).IsArchived(), "TestOciTaskArchived Failed: Task expected to be active")
+	assert.Equal(test, "", (&OciTask{}).GetTimeArchived(), "TestOciTaskArchived Failed: Empty archive time expected")
+}
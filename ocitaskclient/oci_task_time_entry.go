@@ -0,0 +1,180 @@
+package ocitaskclient
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+)
+
+/**
+ * @brief Format of date time is logged on, e.g. 2023-03-15
+ */
+const OciTaskTimeEntryDateFormat string = "2006-01-02"
+
+/**
+ * @brief Container for time logged on Task in OCI Task System
+ */
+type OciTaskTimeEntry struct {
+	Id          *int64  `json:"id,omitempty"`
+	TaskId      *int64  `json:"taskId,omitempty"`
+	Minutes     *int64  `json:"minutes,omitempty"`
+	Date        *string `json:"date,omitempty"`
+	Note        *string `json:"note,omitempty"`
+	Author      *string `json:"author,omitempty"`
+	TimeCreated *int64  `json:"timeCreated,omitempty"`
+}
+
+/**
+ * @brief Effort of Task rolled up over Task and all its descendants
+ */
+type OciTaskEffort struct {
+	EstimateMinutes  int64
+	LoggedMinutes    int64
+	RemainingMinutes int64
+}
+
+/**
+ * @brief Convert OciTaskTimeEntry instance into generic map for Terraform resource data
+ * @return Generic map equivalent to OciTaskTimeEntry. Timestamps are in RFC3339 format, empty if not set.
+ */
+func (ociTaskTimeEntry *OciTaskTimeEntry) Flatten() map[string]interface{} {
+	result := make(map[string]interface{})
+	result["id"] = 0
+	if ociTaskTimeEntry.Id != nil {
+		result["id"] = int(*ociTaskTimeEntry.Id)
+	}
+
+	result["minutes"] = 0
+	if ociTaskTimeEntry.Minutes != nil {
+		result["minutes"] = int(*ociTaskTimeEntry.Minutes)
+	}
+
+	result["date"] = ""
+	if ociTaskTimeEntry.Date != nil {
+		result["date"] = *ociTaskTimeEntry.Date
+	}
+
+	result["note"] = ""
+	if ociTaskTimeEntry.Note != nil {
+		result["note"] = *ociTaskTimeEntry.Note
+	}
+
+	result["author"] = ""
+	if ociTaskTimeEntry.Author != nil {
+		result["author"] = *ociTaskTimeEntry.Author
+	}
+
+	result["time_created"] = formatOciTaskTimestamp(ociTaskTimeEntry.TimeCreated)
+
+	return result
+}
+
+/**
+ * @brief Convert OciTaskTimeEntry object into JSON String
+ * @return JSON String equivalent to OciTaskTimeEntry object if succeeded
+ * @return Instance of error if failed
+ */
+func (ociTaskTimeEntry *OciTaskTimeEntry) Serialize() (string, error) {
+	result := ""
+	data, err := json.Marshal(ociTaskTimeEntry)
+	if err == nil {
+		result = string(data)
+	}
+
+	return result, err
+}
+
+/**
+ * @brief Convert JSON String into OciTaskTimeEntry object
+ * @param data JSON String equivalent to OciTaskTimeEntry object
+ * @return Instance of error if failed
+ */
+func (ociTaskTimeEntry *OciTaskTimeEntry) Deserialize(data []byte) error {
+	return json.Unmarshal(data, ociTaskTimeEntry)
+}
+
+/**
+ * @brief Sort time entries by date they are logged on, ties broken by Identifier
+ * @param timeEntries Time entries of Task, sorted in place
+ */
+func SortOciTaskTimeEntries(timeEntries []OciTaskTimeEntry) {
+	sort.SliceStable(timeEntries, func(i, j int) bool {
+		left, right := "", ""
+		if timeEntries[i].Date != nil {
+			left = *timeEntries[i].Date
+		}
+		if timeEntries[j].Date != nil {
+			right = *timeEntries[j].Date
+		}
+		if left != right {
+			return left < right
+		}
+
+		leftId, rightId := int64(0), int64(0)
+		if timeEntries[i].Id != nil {
+			leftId = *timeEntries[i].Id
+		}
+		if timeEntries[j].Id != nil {
+			rightId = *timeEntries[j].Id
+		}
+		return leftId < rightId
+	})
+}
+
+/**
+ * @brief Sum minutes of time entries
+ * @param timeEntries Time entries of Task
+ * @return Total of logged minutes
+ */
+func SumOciTaskTimeEntries(timeEntries []OciTaskTimeEntry) int64 {
+	total := int64(0)
+	for _, timeEntry := range timeEntries {
+		if timeEntry.Minutes != nil {
+			total += *timeEntry.Minutes
+		}
+	}
+
+	return total
+}
+
+/**
+ * @brief Roll up estimated and logged effort over Task and all its descendants.
+ *			Remaining effort is rolled up estimate less rolled up logged time, never below zero.
+ * @param ctx Context for logging and cancellation
+ * @param ociClient Client to OCI Task Service
+ * @param root Task already read from OCI Task Service
+ * @return Instance of OciTaskEffort if succeeded
+ * @return Instance of error if descendants or time entries can't be read
+ */
+func ReadOciTaskEffort(ctx context.Context, ociClient OciTaskServClientInterface, root OciTask) (*OciTaskEffort, error) {
+	nodes, err := WalkOciTaskTree(ctx, ociClient, root, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	effort := OciTaskEffort{}
+	for _, node := range nodes {
+		if node.Task.EstimateMinutes != nil {
+			effort.EstimateMinutes += *node.Task.EstimateMinutes
+		}
+
+		taskId := node.Path[len(node.Path)-1]
+		ociResponse, err := ociClient.ListTaskTimeEntries(ctx, &taskId)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ociTaskResponseError("ListTaskTimeEntries", ociResponse)
+		if err != nil {
+			return nil, err
+		}
+
+		effort.LoggedMinutes += SumOciTaskTimeEntries(ociResponse.TimeEntries)
+	}
+
+	if effort.EstimateMinutes > effort.LoggedMinutes {
+		effort.RemainingMinutes = effort.EstimateMinutes - effort.LoggedMinutes
+	}
+
+	return &effort, nil
+}
@@ -0,0 +1,110 @@
+package ocitaskclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func makeTestOciTaskTimeEntry(id int64, minutes int64, date string) OciTaskTimeEntry {
+	return OciTaskTimeEntry{Id: &id, Minutes: &minutes, Date: &date}
+}
+
+func TestOciTaskTimeEntryFlatten(test *testing.T) {
+	note, author := "Code review", "jdoe@example.com"
+	timeCreated := time.Date(2023, 3, 15, 17, 0, 0, 0, time.UTC).UnixMilli()
+	timeEntry := makeTestOciTaskTimeEntry(4, 45, "2023-03-15")
+	timeEntry.Note = &note
+	timeEntry.Author = &author
+	timeEntry.TimeCreated = &timeCreated
+
+	data := timeEntry.Flatten()
+
+	assert.Equal(test, 4, data["id"], "TestOciTaskTimeEntryFlatten Failed: Wrong Time Entry Id")
+	assert.Equal(test, 45, data["minutes"], "TestOciTaskTimeEntryFlatten Failed: Wrong Time Entry Minutes")
+	assert.Equal(test, "2023-03-15", data["date"], "TestOciTaskTimeEntryFlatten Failed: Wrong Time Entry Date")
+	assert.Equal(test, "Code review", data["note"], "TestOciTaskTimeEntryFlatten Failed: Wrong Time Entry Note")
+	assert.Equal(test, "jdoe@example.com", data["author"], "TestOciTaskTimeEntryFlatten Failed: Wrong Time Entry Author")
+	assert.Equal(test, "2023-03-15T17:00:00Z", data["time_created"], "TestOciTaskTimeEntryFlatten Failed: Wrong Time Entry Time Created")
+}
+
+func TestOciTaskTimeEntryDeserializeFailed(test *testing.T) {
+	timeEntry := OciTaskTimeEntry{}
+
+	err := timeEntry.Deserialize([]byte("\"Test Error Message\""))
+
+	assert.Error(test, err, "TestOciTaskTimeEntryDeserializeFailed Failed")
+}
+
+func TestSortOciTaskTimeEntries(test *testing.T) {
+	timeEntries := []OciTaskTimeEntry{makeTestOciTaskTimeEntry(3, 10, "2023-03-16"), makeTestOciTaskTimeEntry(2, 20, "2023-03-15"), makeTestOciTaskTimeEntry(1, 30, "2023-03-16")}
+
+	SortOciTaskTimeEntries(timeEntries)
+
+	assert.Equal(test, int64(2), *timeEntries[0].Id, "TestSortOciTaskTimeEntries Failed: Earliest date expected first")
+	assert.Equal(test, int64(1), *timeEntries[1].Id, "TestSortOciTaskTimeEntries Failed: Ties expected in Id order")
+	assert.Equal(test, int64(60), SumOciTaskTimeEntries(timeEntries), "TestSortOciTaskTimeEntries Failed: Wrong total of minutes")
+}
+
+func TestReadOciTaskEffortRollsUpDescendants(test *testing.T) {
+	ociTaskServClientMock := OciTaskServClientMock{}
+
+	parentEstimate, childEstimate := int64(60), int64(120)
+	root := makeTreeTestTask(1, 0)
+	root.EstimateMinutes = &parentEstimate
+	child := makeTreeTestTask(2, 1)
+	child.EstimateMinutes = &childEstimate
+
+	ociTaskServClientMock.On("ListChildTasks", mock.Anything, matchTaskId(1)).Return(&OciTaskServResponse{Tasks: []OciTask{child}}, nil).Once()
+	ociTaskServClientMock.On("ListChildTasks", mock.Anything, matchTaskId(2)).Return(&OciTaskServResponse{}, nil).Once()
+	ociTaskServClientMock.On("ListTaskTimeEntries", mock.Anything, matchTaskId(1)).Return(&OciTaskServResponse{
+		TimeEntries: []OciTaskTimeEntry{makeTestOciTaskTimeEntry(1, 30, "2023-03-15")},
+	}, nil).Once()
+	ociTaskServClientMock.On("ListTaskTimeEntries", mock.Anything, matchTaskId(2)).Return(&OciTaskServResponse{
+		TimeEntries: []OciTaskTimeEntry{makeTestOciTaskTimeEntry(2, 45, "2023-03-15"), makeTestOciTaskTimeEntry(3, 15, "2023-03-16")},
+	}, nil).Once()
+
+	effort, err := ReadOciTaskEffort(context.Background(), &ociTaskServClientMock, root)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestReadOciTaskEffortRollsUpDescendants Failed: No error expected")
+	assert.Equal(test, int64(180), effort.EstimateMinutes, "TestReadOciTaskEffortRollsUpDescendants Failed: Wrong rolled up estimate")
+	assert.Equal(test, int64(90), effort.LoggedMinutes, "TestReadOciTaskEffortRollsUpDescendants Failed: Wrong rolled up logged time")
+	assert.Equal(test, int64(90), effort.RemainingMinutes, "TestReadOciTaskEffortRollsUpDescendants Failed: Wrong remaining time")
+}
+
+func TestReadOciTaskEffortOverrun(test *testing.T) {
+	ociTaskServClientMock := OciTaskServClientMock{}
+
+	estimate := int64(30)
+	root := makeTreeTestTask(1, 0)
+	root.EstimateMinutes = &estimate
+
+	ociTaskServClientMock.On("ListChildTasks", mock.Anything, matchTaskId(1)).Return(&OciTaskServResponse{}, nil).Once()
+	ociTaskServClientMock.On("ListTaskTimeEntries", mock.Anything, matchTaskId(1)).Return(&OciTaskServResponse{
+		TimeEntries: []OciTaskTimeEntry{makeTestOciTaskTimeEntry(1, 45, "2023-03-15")},
+	}, nil).Once()
+
+	effort, err := ReadOciTaskEffort(context.Background(), &ociTaskServClientMock, root)
+
+	assert.NoError(test, err, "TestReadOciTaskEffortOverrun Failed: No error expected")
+	assert.Equal(test, int64(0), effort.RemainingMinutes, "TestReadOciTaskEffortOverrun Failed: Remaining time expected not to go below zero")
+}
+
+func TestReadOciTaskEffortFailedListTimeEntries(test *testing.T) {
+	ociTaskServClientMock := OciTaskServClientMock{}
+
+	root := makeTreeTestTask(1, 0)
+	ociTaskServClientMock.On("ListChildTasks", mock.Anything, matchTaskId(1)).Return(&OciTaskServResponse{}, nil).Once()
+	ociTaskServClientMock.On("ListTaskTimeEntries", mock.Anything, matchTaskId(1)).Return(nil, errors.New("List Time Entries Failed")).Once()
+
+	effort, err := ReadOciTaskEffort(context.Background(), &ociTaskServClientMock, root)
+
+	assert.Error(test, err, "TestReadOciTaskEffortFailedListTimeEntries Failed: Error expected")
+	assert.Nil(test, effort, "TestReadOciTaskEffortFailedListTimeEntries Failed: No effort expected")
+}
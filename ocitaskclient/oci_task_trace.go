@@ -0,0 +1,58 @@
+package ocitaskclient
+
+import (
+	"context"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+/**
+ * @brief Instrumentation name used for spans created by OCI Task Service Client
+ */
+const OciTaskTracerName string = "ocitaskclient"
+
+/**
+ * @brief Start span for HTTP call to OCI Task Service and propagate W3C trace context.
+ *			Uses tracer provider registered globally with OpenTelemetry, no-op if none registered.
+ * @param ctx Parent context
+ * @param apiRequest Instance of http.Request, traceparent header is added to it
+ * @return Context carrying the new span
+ * @return Instance of trace.Span, caller must end it
+ */
+func StartOciTaskHttpSpan(ctx context.Context, apiRequest *http.Request) (context.Context, trace.Span) {
+	ctx, span := otel.Tracer(OciTaskTracerName).Start(ctx, "HTTP "+apiRequest.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.method", apiRequest.Method),
+			attribute.String("http.url", apiRequest.URL.String()),
+		))
+
+	propagation.TraceContext{}.Inject(ctx, propagation.HeaderCarrier(apiRequest.Header))
+
+	return ctx, span
+}
+
+/**
+ * @brief Record outcome of HTTP call to OCI Task Service on span
+ * @param span Span started by StartOciTaskHttpSpan
+ * @param apiResp Instance of http.Response. Nil if request failed.
+ * @param err Instance of error if request failed
+ */
+func EndOciTaskHttpSpan(span trace.Span, apiResp *http.Response, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	} else if apiResp != nil {
+		span.SetAttributes(attribute.Int("http.status_code", apiResp.StatusCode))
+		if apiResp.StatusCode >= http.StatusBadRequest {
+			span.SetStatus(codes.Error, http.StatusText(apiResp.StatusCode))
+		}
+	}
+
+	span.End()
+}
@@ -0,0 +1,41 @@
+package ocitaskclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestStartOciTaskHttpSpanPropagatesTraceContext(test *testing.T) {
+	traceId, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanId, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceId,
+		SpanID:     spanId,
+		TraceFlags: trace.FlagsSampled,
+	})
+	ctx := trace.ContextWithSpanContext(context.Background(), spanContext)
+
+	apiRequest, _ := http.NewRequest("GET", HostUrl+"/tasks/1001", nil)
+
+	_, span := StartOciTaskHttpSpan(ctx, apiRequest)
+	EndOciTaskHttpSpan(span, &http.Response{StatusCode: 200}, nil)
+
+	traceParent := apiRequest.Header.Get("traceparent")
+
+	assert.True(test, strings.HasPrefix(traceParent, "00-4bf92f3577b34da6a3ce929d0e0e4736-"), "TestStartOciTaskHttpSpanPropagatesTraceContext Failed: traceparent header doesn't carry Trace Id")
+}
+
+func TestStartOciTaskHttpSpanWithoutTraceContext(test *testing.T) {
+	apiRequest, _ := http.NewRequest("GET", HostUrl+"/tasks/1001", nil)
+
+	_, span := StartOciTaskHttpSpan(context.Background(), apiRequest)
+	EndOciTaskHttpSpan(span, nil, errors.New("SendRequest Failed"))
+
+	assert.Equal(test, "", apiRequest.Header.Get("traceparent"), "TestStartOciTaskHttpSpanWithoutTraceContext Failed: traceparent header not expected")
+}
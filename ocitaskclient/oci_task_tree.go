@@ -0,0 +1,181 @@
+package ocitaskclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+/**
+ * @brief Upper bound for depth of Task hierarchy walked by client. Protects against cycles stored by OCI Task Service.
+ */
+const OciTaskMaxTreeDepth int = 100
+
+/**
+ * @brief Container for Task in Task tree
+ */
+type OciTaskTreeNode struct {
+	Task  OciTask
+	Depth int
+	Path  []int64
+}
+
+/**
+ * @brief Read Task and all its descendants in depth-first order
+ * @param ctx Context for logging and cancellation
+ * @param ociClient Client to OCI Task Service
+ * @param rootId Identifier of root Task
+ * @param maxDepth Deepest level to read, root is level 0. 0 or less reads whole tree.
+ * @return Nodes of Task tree, root first and children after their parent, if succeeded
+ * @return Instance of error if failed or if hierarchy contains cycle
+ */
+func ReadOciTaskTree(ctx context.Context, ociClient OciTaskServClientInterface, rootId int64, maxDepth int) ([]OciTaskTreeNode, error) {
+	ociResponse, err := ociClient.GetTask(ctx, &rootId)
+	if err != nil {
+		return nil, err
+	}
+
+	err = ociTaskResponseError("GetTask", ociResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	if ociResponse.Task == nil {
+		return nil, fmt.Errorf("Task %d not found", rootId)
+	}
+
+	return WalkOciTaskTree(ctx, ociClient, *ociResponse.Task, maxDepth)
+}
+
+/**
+ * @brief Walk descendants of Task already read from OCI Task Service in depth-first order
+ * @param ctx Context for logging and cancellation
+ * @param ociClient Client to OCI Task Service
+ * @param root Root Task
+ * @param maxDepth Deepest level to read, root is level 0. 0 or less reads whole tree.
+ * @return Nodes of Task tree, root first and children after their parent, if succeeded
+ * @return Instance of error if failed or if hierarchy contains cycle
+ */
+func WalkOciTaskTree(ctx context.Context, ociClient OciTaskServClientInterface, root OciTask, maxDepth int) ([]OciTaskTreeNode, error) {
+	if maxDepth <= 0 || maxDepth > OciTaskMaxTreeDepth {
+		maxDepth = OciTaskMaxTreeDepth
+	}
+
+	nodes := make([]OciTaskTreeNode, 0)
+	visited := make(map[int64]bool)
+
+	var walk func(task OciTask, depth int, path []int64) error
+	walk = func(task OciTask, depth int, path []int64) error {
+		taskId := int64(0)
+		if task.Id != nil {
+			taskId = *task.Id
+		}
+
+		if visited[taskId] {
+			return fmt.Errorf("Cycle detected in Task hierarchy at Task %d", taskId)
+		}
+		visited[taskId] = true
+
+		path = append(append([]int64(nil), path...), taskId)
+		nodes = append(nodes, OciTaskTreeNode{Task: task, Depth: depth, Path: path})
+
+		if depth >= maxDepth {
+			return nil
+		}
+
+		childResponse, err := ociClient.ListChildTasks(ctx, &taskId)
+		if err != nil {
+			return err
+		}
+
+		err = ociTaskResponseError("ListChildTasks", childResponse)
+		if err != nil {
+			return err
+		}
+
+		for _, child := range childResponse.Tasks {
+			err = walk(child, depth+1, path)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	err := walk(root, 0, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return nodes, nil
+}
+
+/**
+ * @brief Check that Task may be moved under new parent without creating cycle.
+ *			Walks ancestors of new parent and fails if Task is one of them.
+ * @param ctx Context for logging and cancellation
+ * @param ociClient Client to OCI Task Service
+ * @param taskId Identifier of Task to move
+ * @param parentId Identifier of new parent, 0 to move Task to top level
+ * @return Instance of error if move would create cycle or if ancestors can't be read
+ */
+func ValidateOciTaskParent(ctx context.Context, ociClient OciTaskServClientInterface, taskId int64, parentId int64) error {
+	if parentId == 0 {
+		return nil
+	}
+
+	if parentId == taskId {
+		return fmt.Errorf("Task %d can't be its own parent", taskId)
+	}
+
+	visited := make(map[int64]bool)
+	ancestorId := parentId
+	for depth := 0; ancestorId != 0; depth++ {
+		if ancestorId == taskId {
+			return fmt.Errorf("Moving Task %d under Task %d would create cycle - Task %d is an ancestor of Task %d", taskId, parentId, taskId, parentId)
+		}
+
+		if visited[ancestorId] || depth >= OciTaskMaxTreeDepth {
+			return fmt.Errorf("Cycle detected in ancestors of Task %d", parentId)
+		}
+		visited[ancestorId] = true
+
+		currentId := ancestorId
+		ociResponse, err := ociClient.GetTask(ctx, &currentId)
+		if err != nil {
+			return err
+		}
+
+		err = ociTaskResponseError("GetTask", ociResponse)
+		if err != nil {
+			return err
+		}
+
+		ancestorId = 0
+		if ociResponse.Task != nil && ociResponse.Task.ParentId != nil {
+			ancestorId = *ociResponse.Task.ParentId
+		}
+	}
+
+	return nil
+}
+
+/**
+ * @brief Convert error returned in body of OCI Task Service response into error
+ * @param operation Name of the operation, e.g. GetTask
+ * @param ociResponse Response from OCI Task Service
+ * @return Instance of error if response carries error or is missing
+ */
+func ociTaskResponseError(operation string, ociResponse *OciTaskServResponse) error {
+	if ociResponse == nil {
+		return errors.New(operation + " failed - no response")
+	}
+
+	if ociResponse.Err != nil {
+		ociErr, _ := ociResponse.Err.Serialize()
+		return fmt.Errorf("%s failed - error: %s%s", operation, ociErr, FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId))
+	}
+
+	return nil
+}
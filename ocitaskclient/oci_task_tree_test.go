@@ -0,0 +1,126 @@
+package ocitaskclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func makeTreeTestTask(id int64, parentId int64) OciTask {
+	title := "Task"
+	task := OciTask{Id: &id, Title: &title}
+	if parentId != 0 {
+		task.ParentId = &parentId
+	}
+
+	return task
+}
+
+func matchTaskId(id int64) interface{} {
+	return mock.MatchedBy(func(taskId *int64) bool { return *taskId == id })
+}
+
+func TestReadOciTaskTreeSuccess(test *testing.T) {
+	ociTaskServClientMock := OciTaskServClientMock{}
+
+	root := makeTreeTestTask(1, 0)
+	ociTaskServClientMock.On("GetTask", mock.Anything, matchTaskId(1)).Return(&OciTaskServResponse{Task: &root}, nil).Once()
+	ociTaskServClientMock.On("ListChildTasks", mock.Anything, matchTaskId(1)).Return(&OciTaskServResponse{Tasks: []OciTask{makeTreeTestTask(2, 1), makeTreeTestTask(3, 1)}}, nil).Once()
+	ociTaskServClientMock.On("ListChildTasks", mock.Anything, matchTaskId(2)).Return(&OciTaskServResponse{Tasks: []OciTask{makeTreeTestTask(4, 2)}}, nil).Once()
+	ociTaskServClientMock.On("ListChildTasks", mock.Anything, matchTaskId(3)).Return(&OciTaskServResponse{}, nil).Once()
+	ociTaskServClientMock.On("ListChildTasks", mock.Anything, matchTaskId(4)).Return(&OciTaskServResponse{}, nil).Once()
+
+	nodes, err := ReadOciTaskTree(context.Background(), &ociTaskServClientMock, 1, 0)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestReadOciTaskTreeSuccess Failed: No error expected")
+	assert.Equal(test, 4, len(nodes), "TestReadOciTaskTreeSuccess Failed: Four nodes expected")
+	assert.Equal(test, []int64{1, 2, 4}, nodes[2].Path, "TestReadOciTaskTreeSuccess Failed: Wrong path of grandchild")
+	assert.Equal(test, 2, nodes[2].Depth, "TestReadOciTaskTreeSuccess Failed: Wrong depth of grandchild")
+	assert.Equal(test, int64(3), *nodes[3].Task.Id, "TestReadOciTaskTreeSuccess Failed: Depth-first order expected")
+}
+
+func TestReadOciTaskTreeMaxDepth(test *testing.T) {
+	ociTaskServClientMock := OciTaskServClientMock{}
+
+	root := makeTreeTestTask(1, 0)
+	ociTaskServClientMock.On("GetTask", mock.Anything, matchTaskId(1)).Return(&OciTaskServResponse{Task: &root}, nil).Once()
+	ociTaskServClientMock.On("ListChildTasks", mock.Anything, matchTaskId(1)).Return(&OciTaskServResponse{Tasks: []OciTask{makeTreeTestTask(2, 1)}}, nil).Once()
+
+	nodes, err := ReadOciTaskTree(context.Background(), &ociTaskServClientMock, 1, 1)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestReadOciTaskTreeMaxDepth Failed: No error expected")
+	assert.Equal(test, 2, len(nodes), "TestReadOciTaskTreeMaxDepth Failed: Children below max depth not expected")
+}
+
+func TestReadOciTaskTreeFailedCycle(test *testing.T) {
+	ociTaskServClientMock := OciTaskServClientMock{}
+
+	root := makeTreeTestTask(1, 2)
+	ociTaskServClientMock.On("GetTask", mock.Anything, matchTaskId(1)).Return(&OciTaskServResponse{Task: &root}, nil).Once()
+	ociTaskServClientMock.On("ListChildTasks", mock.Anything, matchTaskId(1)).Return(&OciTaskServResponse{Tasks: []OciTask{makeTreeTestTask(2, 1)}}, nil).Once()
+	ociTaskServClientMock.On("ListChildTasks", mock.Anything, matchTaskId(2)).Return(&OciTaskServResponse{Tasks: []OciTask{makeTreeTestTask(1, 2)}}, nil).Once()
+
+	nodes, err := ReadOciTaskTree(context.Background(), &ociTaskServClientMock, 1, 0)
+
+	assert.Error(test, err, "TestReadOciTaskTreeFailedCycle Failed: Error expected")
+	assert.Contains(test, err.Error(), "Cycle detected", "TestReadOciTaskTreeFailedCycle Failed: Wrong error")
+	assert.Nil(test, nodes, "TestReadOciTaskTreeFailedCycle Failed: No nodes expected")
+}
+
+func TestReadOciTaskTreeFailedGetTask(test *testing.T) {
+	ociTaskServClientMock := OciTaskServClientMock{}
+
+	ociTaskServClientMock.On("GetTask", mock.Anything, matchTaskId(1)).Return(nil, errors.New("Get Task Failed")).Once()
+
+	_, err := ReadOciTaskTree(context.Background(), &ociTaskServClientMock, 1, 0)
+
+	assert.EqualError(test, err, "Get Task Failed", "TestReadOciTaskTreeFailedGetTask Failed: Wrong error")
+}
+
+func TestValidateOciTaskParentSuccess(test *testing.T) {
+	ociTaskServClientMock := OciTaskServClientMock{}
+
+	parent := makeTreeTestTask(2, 3)
+	grandParent := makeTreeTestTask(3, 0)
+	ociTaskServClientMock.On("GetTask", mock.Anything, matchTaskId(2)).Return(&OciTaskServResponse{Task: &parent}, nil).Once()
+	ociTaskServClientMock.On("GetTask", mock.Anything, matchTaskId(3)).Return(&OciTaskServResponse{Task: &grandParent}, nil).Once()
+
+	err := ValidateOciTaskParent(context.Background(), &ociTaskServClientMock, 1, 2)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestValidateOciTaskParentSuccess Failed: No error expected")
+}
+
+func TestValidateOciTaskParentFailedCycle(test *testing.T) {
+	ociTaskServClientMock := OciTaskServClientMock{}
+
+	parent := makeTreeTestTask(2, 1)
+	ociTaskServClientMock.On("GetTask", mock.Anything, matchTaskId(2)).Return(&OciTaskServResponse{Task: &parent}, nil).Once()
+
+	err := ValidateOciTaskParent(context.Background(), &ociTaskServClientMock, 1, 2)
+
+	assert.Error(test, err, "TestValidateOciTaskParentFailedCycle Failed: Error expected")
+	assert.Contains(test, err.Error(), "would create cycle", "TestValidateOciTaskParentFailedCycle Failed: Wrong error")
+}
+
+func TestValidateOciTaskParentFailedSelf(test *testing.T) {
+	ociTaskServClientMock := OciTaskServClientMock{}
+
+	err := ValidateOciTaskParent(context.Background(), &ociTaskServClientMock, 1, 1)
+
+	assert.Error(test, err, "TestValidateOciTaskParentFailedSelf Failed: Error expected")
+}
+
+func TestValidateOciTaskParentTopLevel(test *testing.T) {
+	ociTaskServClientMock := OciTaskServClientMock{}
+
+	assert.NoError(test, ValidateOciTaskParent(context.Background(), &ociTaskServClientMock, 1, 0), "TestValidateOciTaskParentTopLevel Failed: No error expected")
+}
@@ -0,0 +1,161 @@
+package ocitaskclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+/**
+ * @brief Loose check of e-mail address format. Existence of user is checked against OCI Task Service.
+ */
+var ociTaskEmailPattern = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+
+/**
+ * @brief Container for user of OCI Task System. Users are identified by e-mail address.
+ */
+type OciTaskUser struct {
+	Id     *int64  `json:"id,omitempty"`
+	Email  *string `json:"email,omitempty"`
+	Name   *string `json:"name,omitempty"`
+	Active *bool   `json:"active,omitempty"`
+}
+
+/**
+ * @brief Convert OciTaskUser object into JSON String
+ * @return JSON String equivalent to OciTaskUser object if succeeded
+ * @return Instance of error if failed
+ */
+func (ociTaskUser *OciTaskUser) Serialize() (string, error) {
+	result := ""
+	data, err := json.Marshal(ociTaskUser)
+	if err == nil {
+		result = string(data)
+	}
+
+	return result, err
+}
+
+/**
+ * @brief Convert JSON String into OciTaskUser object
+ * @param data JSON String equivalent to OciTaskUser object
+ * @return Instance of error if failed
+ */
+func (ociTaskUser *OciTaskUser) Deserialize(data []byte) error {
+	return json.Unmarshal(data, ociTaskUser)
+}
+
+/**
+ * @brief Normalize e-mail address of user. E-mail addresses are case-insensitive and stored in lower case.
+ * @param email E-mail address
+ * @return E-mail address in lower case without surrounding spaces
+ */
+func NormalizeOciTaskUserEmail(email string) string {
+	return strings.ToLower(strings.TrimSpace(email))
+}
+
+/**
+ * @brief Check format of e-mail address
+ * @param email E-mail address
+ * @return Instance of error if e-mail address is malformed
+ */
+func ValidateOciTaskUserEmail(email string) error {
+	if !ociTaskEmailPattern.MatchString(strings.TrimSpace(email)) {
+		return fmt.Errorf("Invalid e-mail address %q", email)
+	}
+
+	return nil
+}
+
+/**
+ * @brief Normalize list of e-mail addresses, dropping empty entries and duplicates
+ * @param emails E-mail addresses
+ * @return Normalized e-mail addresses in ascending order, nil if none left
+ */
+func NormalizeOciTaskUserEmails(emails []string) []string {
+	seen := make(map[string]bool)
+	result := make([]string, 0, len(emails))
+	for _, email := range emails {
+		email = NormalizeOciTaskUserEmail(email)
+		if email != "" && !seen[email] {
+			seen[email] = true
+			result = append(result, email)
+		}
+	}
+
+	if len(result) == 0 {
+		return nil
+	}
+	sort.Strings(result)
+
+	return result
+}
+
+/**
+ * @brief Check that users exist in OCI Task System
+ * @param ctx Context for logging and cancellation
+ * @param ociClient Client to OCI Task Service
+ * @param emails E-mail addresses of users
+ * @return Instance of error naming first unknown user, or if users can't be read
+ */
+func ValidateOciTaskUsers(ctx context.Context, ociClient OciTaskServClientInterface, emails []string) error {
+	for _, email := range NormalizeOciTaskUserEmails(emails) {
+		ociResponse, err := ociClient.GetUserByEmail(ctx, email)
+		if err != nil {
+			return err
+		}
+
+		err = ociTaskResponseError("GetUserByEmail", ociResponse)
+		if err != nil {
+			return err
+		}
+
+		if ociResponse.User == nil {
+			return fmt.Errorf("User %q not found", email)
+		}
+	}
+
+	return nil
+}
+
+/**
+ * @brief Convert generic list or set of strings from Terraform resource data into list of string
+ * @param src Generic list, or set exposing List()
+ * @return List of string, nil if src is not a list
+ */
+func ExpandOciTaskStringList(src interface{}) []string {
+	if set, ok := src.(interface{ List() []interface{} }); ok {
+		src = set.List()
+	}
+
+	srcList, ok := src.([]interface{})
+	if !ok {
+		return nil
+	}
+
+	result := make([]string, 0, len(srcList))
+	for _, value := range srcList {
+		if str, ok := value.(string); ok {
+			result = append(result, str)
+		}
+	}
+
+	return result
+}
+
+/**
+ * @brief Convert list of string into generic list for Terraform resource data
+ * @param src List of string
+ * @return Generic list
+ */
+func FlattenOciTaskStringList(src []string) []interface{} {
+	result := make([]interface{}, 0, len(src))
+	for _, value := range src {
+		result = append(result, value)
+	}
+
+	return result
+}
@@ -0,0 +1,60 @@
+package ocitaskclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestValidateOciTaskUserEmail(test *testing.T) {
+	assert.NoError(test, ValidateOciTaskUserEmail("jdoe@example.com"), "TestValidateOciTaskUserEmail Failed: Valid address expected")
+	assert.Error(test, ValidateOciTaskUserEmail("jdoe"), "TestValidateOciTaskUserEmail Failed: Address without domain expected to fail")
+	assert.Error(test, ValidateOciTaskUserEmail("jdoe@example"), "TestValidateOciTaskUserEmail Failed: Address without top level domain expected to fail")
+}
+
+func TestNormalizeOciTaskUserEmails(test *testing.T) {
+	assert.Equal(test, []string{"a@example.com", "b@example.com"}, NormalizeOciTaskUserEmails([]string{"B@example.com", " a@example.com", "", "A@Example.com"}), "TestNormalizeOciTaskUserEmails Failed: Wrong addresses")
+	assert.Nil(test, NormalizeOciTaskUserEmails([]string{""}), "TestNormalizeOciTaskUserEmails Failed: nil expected without addresses")
+}
+
+func TestValidateOciTaskUsersSuccess(test *testing.T) {
+	ociTaskServClientMock := OciTaskServClientMock{}
+
+	email := "jdoe@example.com"
+	ociTaskServClientMock.On("GetUserByEmail", mock.Anything, email).Return(&OciTaskServResponse{User: &OciTaskUser{Email: &email}}, nil).Once()
+
+	err := ValidateOciTaskUsers(context.Background(), &ociTaskServClientMock, []string{"JDoe@example.com", email})
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestValidateOciTaskUsersSuccess Failed: No error expected")
+}
+
+func TestValidateOciTaskUsersFailedUnknown(test *testing.T) {
+	ociTaskServClientMock := OciTaskServClientMock{}
+
+	ociTaskServClientMock.On("GetUserByEmail", mock.Anything, "ghost@example.com").Return(&OciTaskServResponse{}, nil).Once()
+
+	err := ValidateOciTaskUsers(context.Background(), &ociTaskServClientMock, []string{"ghost@example.com"})
+
+	assert.Error(test, err, "TestValidateOciTaskUsersFailedUnknown Failed: Error expected")
+	assert.Contains(test, err.Error(), "not found", "TestValidateOciTaskUsersFailedUnknown Failed: Wrong error")
+}
+
+func TestValidateOciTaskUsersFailedLookup(test *testing.T) {
+	ociTaskServClientMock := OciTaskServClientMock{}
+
+	ociTaskServClientMock.On("GetUserByEmail", mock.Anything, mock.Anything).Return(nil, errors.New("Lookup Failed")).Once()
+
+	err := ValidateOciTaskUsers(context.Background(), &ociTaskServClientMock, []string{"jdoe@example.com"})
+
+	assert.Error(test, err, "TestValidateOciTaskUsersFailedLookup Failed: Error expected")
+}
+
+func TestExpandOciTaskStringList(test *testing.T) {
+	assert.Equal(test, []string{"a", "b"}, ExpandOciTaskStringList([]interface{}{"a", "b"}), "TestExpandOciTaskStringList Failed: Wrong list")
+	assert.Nil(test, ExpandOciTaskStringList(nil), "TestExpandOciTaskStringList Failed: nil expected")
+}
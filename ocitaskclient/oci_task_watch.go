@@ -0,0 +1,374 @@
+package ocitaskclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+/**
+ * @brief Types of Task change events delivered by WatchTasks
+ */
+const (
+	OciTaskEventCreated string = "created"
+	OciTaskEventUpdated string = "updated"
+	OciTaskEventDeleted string = "deleted"
+)
+
+/**
+ * @brief HTTP header carrying cursor of last event received, as defined for server-sent events
+ */
+const OciTaskWatchCursorHeader string = "Last-Event-ID"
+
+/**
+ * @brief How long OCI Task Service keeps one watch stream open. Kept below timeout of HTTP client, stream is resumed from cursor afterwards.
+ */
+const ociTaskWatchWindow time.Duration = 8 * time.Second
+
+/**
+ * @brief Delays between reconnection attempts after watch stream failed, doubling from minimum up to maximum
+ */
+const (
+	ociTaskWatchMinRetryDelay time.Duration = 250 * time.Millisecond
+	ociTaskWatchMaxRetryDelay time.Duration = 30 * time.Second
+)
+
+/**
+ * @brief Container for Task change event in OCI Task System
+ */
+type OciTaskEvent struct {
+	Type   string   `json:"type,omitempty"`
+	Cursor string   `json:"cursor,omitempty"`
+	TaskId *int64   `json:"taskId,omitempty"`
+	Task   *OciTask `json:"task,omitempty"`
+}
+
+/**
+ * @brief Convert OciTaskEvent object into JSON String
+ * @return JSON String equivalent to OciTaskEvent object if succeeded
+ * @return Instance of error if failed
+ */
+func (ociTaskEvent *OciTaskEvent) Serialize() (string, error) {
+	result := ""
+	data, err := json.Marshal(ociTaskEvent)
+	if err == nil {
+		result = string(data)
+	}
+
+	return result, err
+}
+
+/**
+ * @brief Convert JSON String into OciTaskEvent object
+ * @param data JSON String equivalent to OciTaskEvent object
+ * @return Instance of error if failed
+ */
+func (ociTaskEvent *OciTaskEvent) Deserialize(data []byte) error {
+	return json.Unmarshal(data, ociTaskEvent)
+}
+
+/**
+ * @brief Running watch on Task changes started by WatchTasks
+ */
+type OciTaskWatch struct {
+	events chan OciTaskEvent
+	mutex  sync.Mutex
+	cursor string
+	err    error
+}
+
+/**
+ * @brief Getter function for events channel. Channel is closed when watch stops.
+ * @return Channel delivering Task change events in order
+ */
+func (ociTaskWatch *OciTaskWatch) Events() <-chan OciTaskEvent {
+	return ociTaskWatch.events
+}
+
+/**
+ * @brief Getter function for cursor of last event delivered. Pass it to WatchTasks to resume after restart.
+ * @return Cursor of last event delivered, cursor watch was started from if none delivered yet
+ */
+func (ociTaskWatch *OciTaskWatch) Cursor() string {
+	ociTaskWatch.mutex.Lock()
+	defer ociTaskWatch.mutex.Unlock()
+
+	return ociTaskWatch.cursor
+}
+
+/**
+ * @brief Getter function for reason watch stopped. Only meaningful once events channel is closed.
+ * @return Instance of error which can't be recovered by reconnecting, nil if watch was stopped by cancelling its context
+ */
+func (ociTaskWatch *OciTaskWatch) Err() error {
+	ociTaskWatch.mutex.Lock()
+	defer ociTaskWatch.mutex.Unlock()
+
+	return ociTaskWatch.err
+}
+
+/**
+ * @brief Setter function for cursor of last event delivered
+ * @param cursor Cursor of event
+ */
+func (ociTaskWatch *OciTaskWatch) setCursor(cursor string) {
+	ociTaskWatch.mutex.Lock()
+	defer ociTaskWatch.mutex.Unlock()
+
+	ociTaskWatch.cursor = cursor
+}
+
+/**
+ * @brief Setter function for reason watch stopped
+ * @param err Instance of error
+ */
+func (ociTaskWatch *OciTaskWatch) setErr(err error) {
+	ociTaskWatch.mutex.Lock()
+	defer ociTaskWatch.mutex.Unlock()
+
+	ociTaskWatch.err = err
+}
+
+/**
+ * @brief Public method to follow Task changes using server-sent events of OCI Task Service.
+ *			Reconnects automatically and resumes from cursor of last event delivered, so no event is lost.
+ *			Event delivered just before connection was lost may be delivered again.
+ *			Watch stops when ctx is cancelled or OCI Task Service rejects it, e.g. because cursor expired.
+ * @param ctx Context for logging and cancellation
+ * @param cursor Cursor of last event already processed, empty to receive only new events
+ * @return Instance of OciTaskWatch
+ * @return Instance of error if failed
+ */
+func (ociTaskServClient *OciTaskServClient) WatchTasks(ctx context.Context, cursor string) (*OciTaskWatch, error) {
+	if ociTaskServClient.hostUrl == nil {
+		return nil, errors.New("Invalid Argument - please check host URL")
+	}
+
+	ociTaskWatch := &OciTaskWatch{events: make(chan OciTaskEvent), cursor: cursor}
+	go ociTaskServClient.watchTasks(MakeOciTaskLogContext(ctx), ociTaskWatch)
+
+	return ociTaskWatch, nil
+}
+
+/**
+ * @brief Private method to keep watch stream open until watch stops, reconnecting with backoff after failures
+ * @param ctx Context for logging and cancellation
+ * @param ociTaskWatch Watch to deliver events to
+ */
+func (ociTaskServClient *OciTaskServClient) watchTasks(ctx context.Context, ociTaskWatch *OciTaskWatch) {
+	defer close(ociTaskWatch.events)
+
+	delay := ociTaskWatchMinRetryDelay
+	for {
+		received, err := ociTaskServClient.watchTasksOnce(ctx, ociTaskWatch)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err == nil {
+			// Stream window ended, resume right away
+			delay = ociTaskWatchMinRetryDelay
+			continue
+		}
+
+		if isOciTaskWatchErrorPermanent(err) {
+			ociTaskWatch.setErr(err)
+			return
+		}
+
+		if received {
+			delay = ociTaskWatchMinRetryDelay
+		}
+
+		tflog.SubsystemWarn(ctx, OciTaskLogSubsystem, "Watch stream to OCI Task Management Service lost, reconnecting", map[string]interface{}{
+			"cursor":   ociTaskWatch.Cursor(),
+			"delay_ms": delay.Milliseconds(),
+			"error":    err.Error(),
+		})
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		}
+
+		delay *= 2
+		if delay > ociTaskWatchMaxRetryDelay {
+			delay = ociTaskWatchMaxRetryDelay
+		}
+	}
+}
+
+/**
+ * @brief Private method to open one watch stream and deliver its events until stream ends
+ * @param ctx Context for logging and cancellation
+ * @param ociTaskWatch Watch to deliver events to
+ * @return True if any event was delivered
+ * @return Instance of error if stream failed, nil if it ended normally
+ */
+func (ociTaskServClient *OciTaskServClient) watchTasksOnce(ctx context.Context, ociTaskWatch *OciTaskWatch) (bool, error) {
+	ctx = ociTaskServClient.requestContext(ctx)
+
+	apiRequest, err := ociTaskServClient.newRequest(ctx, "GET", fmt.Sprintf("%s/tasks/watch?wait=%d", *ociTaskServClient.hostUrl, int(ociTaskWatchWindow.Seconds())), nil)
+	if err != nil {
+		return false, err
+	}
+
+	apiRequest.Header.Set("Accept", "text/event-stream")
+	cursor := ociTaskWatch.Cursor()
+	if cursor != "" {
+		apiRequest.Header.Set(OciTaskWatchCursorHeader, cursor)
+	}
+
+	stream := &ociTaskEventStream{ctx: ctx, watch: ociTaskWatch}
+	apiResp, body, err := ociTaskServClient.sendRequest(ctx, "WatchTasks", apiRequest, stream)
+	if err != nil {
+		return stream.received, err
+	}
+
+	return stream.received, ociTaskServClient.checkStatus(ctx, "WatchTasks", apiRequest, apiResp, body, http.StatusOK)
+}
+
+/**
+ * @brief Check if watch failed for good. Client errors other than timeouts and throttling won't be fixed by reconnecting.
+ * @param err Instance of error watch stream failed with
+ * @return True if watch should stop
+ */
+func isOciTaskWatchErrorPermanent(err error) bool {
+	var ociTaskServError *OciTaskServError
+	if errors.As(err, &ociTaskServError) && ociTaskServError.Err == nil {
+		statusCode := ociTaskServError.StatusCode
+		return statusCode >= 400 && statusCode < 500 && statusCode != http.StatusRequestTimeout && statusCode != http.StatusTooManyRequests
+	}
+
+	return false
+}
+
+/**
+ * @brief Parser for server-sent events stream. Written to as response body arrives, delivers each complete event to watch.
+ */
+type ociTaskEventStream struct {
+	ctx       context.Context
+	watch     *OciTaskWatch
+	buffer    []byte
+	id        string
+	eventType string
+	data      []string
+	received  bool
+}
+
+/**
+ * @brief Consume chunk of stream
+ * @param chunk Bytes of stream, may end in the middle of a line
+ * @return Number of bytes consumed
+ * @return Instance of error if watch was cancelled while delivering event
+ */
+func (ociTaskEventStream *ociTaskEventStream) Write(chunk []byte) (int, error) {
+	ociTaskEventStream.buffer = append(ociTaskEventStream.buffer, chunk...)
+	for {
+		index := bytes.IndexByte(ociTaskEventStream.buffer, '\n')
+		if index < 0 {
+			break
+		}
+
+		line := strings.TrimSuffix(string(ociTaskEventStream.buffer[:index]), "\r")
+		ociTaskEventStream.buffer = ociTaskEventStream.buffer[index+1:]
+
+		err := ociTaskEventStream.processLine(line)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return len(chunk), nil
+}
+
+/**
+ * @brief Process one line of stream. Blank line completes event, lines starting with colon are comments.
+ * @param line Line without line terminator
+ * @return Instance of error if watch was cancelled while delivering event
+ */
+func (ociTaskEventStream *ociTaskEventStream) processLine(line string) error {
+	if line == "" {
+		return ociTaskEventStream.dispatch()
+	}
+
+	if strings.HasPrefix(line, ":") {
+		return nil
+	}
+
+	field, value := line, ""
+	index := strings.IndexByte(line, ':')
+	if index >= 0 {
+		field = line[:index]
+		value = strings.TrimPrefix(line[index+1:], " ")
+	}
+
+	switch field {
+	case "id":
+		ociTaskEventStream.id = value
+	case "event":
+		ociTaskEventStream.eventType = value
+	case "data":
+		ociTaskEventStream.data = append(ociTaskEventStream.data, value)
+	}
+
+	return nil
+}
+
+/**
+ * @brief Deliver event collected so far to watch. Cursor moves past event only once it was delivered.
+ * @return Instance of error if watch was cancelled while delivering event
+ */
+func (ociTaskEventStream *ociTaskEventStream) dispatch() error {
+	id, eventType, data := ociTaskEventStream.id, ociTaskEventStream.eventType, ociTaskEventStream.data
+	ociTaskEventStream.id, ociTaskEventStream.eventType, ociTaskEventStream.data = "", "", nil
+
+	if len(data) == 0 {
+		return nil
+	}
+
+	ociTaskEvent := OciTaskEvent{}
+	err := ociTaskEvent.Deserialize([]byte(strings.Join(data, "\n")))
+	if err != nil {
+		// Same event would be sent again after reconnecting, skip it instead
+		tflog.SubsystemWarn(ociTaskEventStream.ctx, OciTaskLogSubsystem, "Skipping malformed event from OCI Task Management Service", map[string]interface{}{
+			"cursor": id,
+			"error":  err.Error(),
+		})
+		if id != "" {
+			ociTaskEventStream.watch.setCursor(id)
+		}
+		return nil
+	}
+
+	if eventType != "" {
+		ociTaskEvent.Type = eventType
+	}
+	if id != "" {
+		ociTaskEvent.Cursor = id
+	}
+
+	select {
+	case <-ociTaskEventStream.ctx.Done():
+		return ociTaskEventStream.ctx.Err()
+	case ociTaskEventStream.watch.events <- ociTaskEvent:
+	}
+
+	ociTaskEventStream.received = true
+	if ociTaskEvent.Cursor != "" {
+		ociTaskEventStream.watch.setCursor(ociTaskEvent.Cursor)
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package ocitaskclient_test
+
+import (
+	"context"
+	"net/http"
+	"ocitaskclient"
+	"ocitaskclient/ocitaskfake"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func receiveTestOciTaskEvent(test *testing.T, ociTaskWatch *ocitaskclient.OciTaskWatch) (ocitaskclient.OciTaskEvent, bool) {
+	select {
+	case ociTaskEvent, ok := <-ociTaskWatch.Events():
+		return ociTaskEvent, ok
+	case <-time.After(5 * time.Second):
+		test.Fatal("Timed out waiting for task event")
+		return ocitaskclient.OciTaskEvent{}, false
+	}
+}
+
+func makeTestOciTaskWatch(test *testing.T, cursor string) (*ocitaskfake.OciTaskFakeServer, *ocitaskclient.OciTaskWatch, context.CancelFunc) {
+	fakeServer := ocitaskfake.MakeOciTaskFakeServer()
+	url := fakeServer.GetUrl()
+	ociTaskServClient := ocitaskclient.MakeOciTaskServClient(&url)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ociTaskWatch, err := ociTaskServClient.WatchTasks(ctx, cursor)
+	assert.NoError(test, err, "makeTestOciTaskWatch Failed: No error expected")
+
+	return fakeServer, ociTaskWatch, cancel
+}
+
+func TestWatchTasksDeliversTypedEvents(test *testing.T) {
+	fakeServer, ociTaskWatch, cancel := makeTestOciTaskWatch(test, "0")
+	defer fakeServer.Close()
+	defer cancel()
+
+	title := "Write docs"
+	ociTask := fakeServer.CreateTask(ocitaskclient.OciTask{Title: &title})
+	newTitle := "Write user docs"
+	ociTask.Title = &newTitle
+	fakeServer.UpdateTask(ociTask)
+	fakeServer.DeleteTask(*ociTask.Id)
+
+	created, _ := receiveTestOciTaskEvent(test, ociTaskWatch)
+	updated, _ := receiveTestOciTaskEvent(test, ociTaskWatch)
+	deleted, _ := receiveTestOciTaskEvent(test, ociTaskWatch)
+
+	assert.Equal(test, ocitaskclient.OciTaskEventCreated, created.Type, "TestWatchTasksDeliversTypedEvents Failed: Created event expected first")
+	assert.Equal(test, "Write docs", *created.Task.Title, "TestWatchTasksDeliversTypedEvents Failed: Wrong created task")
+	assert.Equal(test, ocitaskclient.OciTaskEventUpdated, updated.Type, "TestWatchTasksDeliversTypedEvents Failed: Updated event expected second")
+	assert.Equal(test, "Write user docs", *updated.Task.Title, "TestWatchTasksDeliversTypedEvents Failed: Wrong updated task")
+	assert.Equal(test, ocitaskclient.OciTaskEventDeleted, deleted.Type, "TestWatchTasksDeliversTypedEvents Failed: Deleted event expected last")
+	assert.Equal(test, *ociTask.Id, *deleted.TaskId, "TestWatchTasksDeliversTypedEvents Failed: Wrong deleted task Id")
+	assert.Nil(test, deleted.Task, "TestWatchTasksDeliversTypedEvents Failed: Deleted event should carry no task")
+	assert.Equal(test, "3", ociTaskWatch.Cursor(), "TestWatchTasksDeliversTypedEvents Failed: Wrong cursor")
+}
+
+func TestWatchTasksResumesAfterConnectionLost(test *testing.T) {
+	fakeServer, ociTaskWatch, cancel := makeTestOciTaskWatch(test, "0")
+	defer fakeServer.Close()
+	defer cancel()
+
+	first, second := "First", "Second"
+	fakeServer.CreateTask(ocitaskclient.OciTask{Title: &first})
+	firstEvent, _ := receiveTestOciTaskEvent(test, ociTaskWatch)
+
+	fakeServer.DropConnections()
+	fakeServer.CreateTask(ocitaskclient.OciTask{Title: &second})
+	secondEvent, _ := receiveTestOciTaskEvent(test, ociTaskWatch)
+
+	assert.Equal(test, "First", *firstEvent.Task.Title, "TestWatchTasksResumesAfterConnectionLost Failed: Wrong first event")
+	assert.Equal(test, "Second", *secondEvent.Task.Title, "TestWatchTasksResumesAfterConnectionLost Failed: Event after reconnect should follow cursor")
+	assert.Equal(test, "2", secondEvent.Cursor, "TestWatchTasksResumesAfterConnectionLost Failed: Wrong cursor")
+}
+
+func TestWatchTasksRetriesServerError(test *testing.T) {
+	fakeServer := ocitaskfake.MakeOciTaskFakeServer()
+	defer fakeServer.Close()
+	fakeServer.FailWatch(2, http.StatusServiceUnavailable)
+
+	title := "Write docs"
+	fakeServer.CreateTask(ocitaskclient.OciTask{Title: &title})
+
+	url := fakeServer.GetUrl()
+	ociTaskServClient := ocitaskclient.MakeOciTaskServClient(&url)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ociTaskWatch, _ := ociTaskServClient.WatchTasks(ctx, "0")
+	ociTaskEvent, ok := receiveTestOciTaskEvent(test, ociTaskWatch)
+
+	assert.True(test, ok, "TestWatchTasksRetriesServerError Failed: Watch should survive server errors")
+	assert.Equal(test, ocitaskclient.OciTaskEventCreated, ociTaskEvent.Type, "TestWatchTasksRetriesServerError Failed: Wrong event type")
+}
+
+func TestWatchTasksStopsOnExpiredCursor(test *testing.T) {
+	fakeServer, ociTaskWatch, cancel := makeTestOciTaskWatch(test, "99")
+	defer fakeServer.Close()
+	defer cancel()
+
+	_, ok := receiveTestOciTaskEvent(test, ociTaskWatch)
+
+	assert.False(test, ok, "TestWatchTasksStopsOnExpiredCursor Failed: Events channel should be closed")
+	assert.Error(test, ociTaskWatch.Err(), "TestWatchTasksStopsOnExpiredCursor Failed: Error expected")
+	assert.Contains(test, ociTaskWatch.Err().Error(), "status: 410", "TestWatchTasksStopsOnExpiredCursor Failed: Wrong error")
+}
+
+func TestWatchTasksStopsOnCancel(test *testing.T) {
+	fakeServer, ociTaskWatch, cancel := makeTestOciTaskWatch(test, "")
+	defer fakeServer.Close()
+
+	cancel()
+	_, ok := receiveTestOciTaskEvent(test, ociTaskWatch)
+
+	assert.False(test, ok, "TestWatchTasksStopsOnCancel Failed: Events channel should be closed")
+	assert.NoError(test, ociTaskWatch.Err(), "TestWatchTasksStopsOnCancel Failed: No error expected after cancel")
+}
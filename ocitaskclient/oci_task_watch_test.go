@@ -0,0 +1,35 @@
+package ocitaskclient
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOciTaskEventStreamParse(test *testing.T) {
+	ociTaskWatch := &OciTaskWatch{events: make(chan OciTaskEvent, 4)}
+	stream := &ociTaskEventStream{ctx: context.Background(), watch: ociTaskWatch}
+
+	chunks := []string{
+		": keepalive\n",
+		"id: 7\nevent: upd",
+		"ated\r\ndata: {\"taskId\":1001,\n",
+		"data: \"task\":{\"title\":\"Write docs\"}}\n\n",
+		"id: 8\ndata: not json\n\n",
+		"id: 9\nevent: deleted\ndata: {\"taskId\":1002}\n",
+	}
+	for _, chunk := range chunks {
+		size, err := stream.Write([]byte(chunk))
+		assert.NoError(test, err, "TestOciTaskEventStreamParse Failed: No error expected")
+		assert.Equal(test, len(chunk), size, "TestOciTaskEventStreamParse Failed: Whole chunk should be consumed")
+	}
+
+	assert.Equal(test, 1, len(ociTaskWatch.events), "TestOciTaskEventStreamParse Failed: Only complete well formed event expected")
+	ociTaskEvent := <-ociTaskWatch.events
+	assert.Equal(test, OciTaskEventUpdated, ociTaskEvent.Type, "TestOciTaskEventStreamParse Failed: Wrong event type")
+	assert.Equal(test, "7", ociTaskEvent.Cursor, "TestOciTaskEventStreamParse Failed: Wrong cursor")
+	assert.Equal(test, int64(1001), *ociTaskEvent.TaskId, "TestOciTaskEventStreamParse Failed: Wrong task Id")
+	assert.Equal(test, "Write docs", *ociTaskEvent.Task.Title, "TestOciTaskEventStreamParse Failed: Wrong task")
+	assert.Equal(test, "8", ociTaskWatch.Cursor(), "TestOciTaskEventStreamParse Failed: Malformed event should be skipped")
+}
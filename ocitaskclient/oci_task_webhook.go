@@ -0,0 +1,135 @@
+package ocitaskclient
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+)
+
+/**
+ * @brief Events of OCI Task System webhook subscriptions can be notified of
+ */
+const (
+	OciTaskWebhookEventTaskCreated    string = "task.created"
+	OciTaskWebhookEventTaskUpdated    string = "task.updated"
+	OciTaskWebhookEventTaskDeleted    string = "task.deleted"
+	OciTaskWebhookEventTaskArchived   string = "task.archived"
+	OciTaskWebhookEventTaskRestored   string = "task.restored"
+	OciTaskWebhookEventCommentCreated string = "comment.created"
+)
+
+/**
+ * @brief All events webhook subscriptions can be notified of
+ */
+var OciTaskWebhookEvents = []string{
+	OciTaskWebhookEventTaskCreated,
+	OciTaskWebhookEventTaskUpdated,
+	OciTaskWebhookEventTaskDeleted,
+	OciTaskWebhookEventTaskArchived,
+	OciTaskWebhookEventTaskRestored,
+	OciTaskWebhookEventCommentCreated,
+}
+
+/**
+ * @brief HTTP header OCI Task System sends HMAC signature of webhook payload in
+ */
+const OciTaskWebhookSignatureHeader string = "X-OciTask-Signature"
+
+/**
+ * @brief Prefix of webhook signature naming its hash algorithm
+ */
+const ociTaskWebhookSignaturePrefix string = "sha256="
+
+/**
+ * @brief Container for webhook subscription in OCI Task System. OCI Task System posts events to URL of active subscriptions.
+ */
+type OciTaskWebhook struct {
+	Id          *int64   `json:"id,omitempty"`
+	Url         *string  `json:"url,omitempty"`
+	Events      []string `json:"events,omitempty"`
+	Secret      *string  `json:"secret,omitempty"`
+	Active      *bool    `json:"active,omitempty"`
+	TimeCreated *int64   `json:"timeCreated,omitempty"`
+}
+
+/**
+ * @brief Convert OciTaskWebhook instance into generic map for Terraform resource data.
+ *			Secret is left out, OCI Task System never returns it.
+ * @return Generic map equivalent to OciTaskWebhook. Timestamps are in RFC3339 format, empty if not set.
+ */
+func (ociTaskWebhook *OciTaskWebhook) Flatten() map[string]interface{} {
+	result := make(map[string]interface{})
+	result["url"] = ""
+	if ociTaskWebhook.Url != nil {
+		result["url"] = *ociTaskWebhook.Url
+	}
+
+	result["events"] = FlattenOciTaskStringList(ociTaskWebhook.Events)
+
+	result["active"] = false
+	if ociTaskWebhook.Active != nil {
+		result["active"] = *ociTaskWebhook.Active
+	}
+
+	result["time_created"] = formatOciTaskTimestamp(ociTaskWebhook.TimeCreated)
+
+	return result
+}
+
+/**
+ * @brief Convert OciTaskWebhook object into JSON String
+ * @return JSON String equivalent to OciTaskWebhook object if succeeded
+ * @return Instance of error if failed
+ */
+func (ociTaskWebhook *OciTaskWebhook) Serialize() (string, error) {
+	result := ""
+	data, err := json.Marshal(ociTaskWebhook)
+	if err == nil {
+		result = string(data)
+	}
+
+	return result, err
+}
+
+/**
+ * @brief Convert JSON String into OciTaskWebhook object
+ * @param data JSON String equivalent to OciTaskWebhook object
+ * @return Instance of error if failed
+ */
+func (ociTaskWebhook *OciTaskWebhook) Deserialize(data []byte) error {
+	return json.Unmarshal(data, ociTaskWebhook)
+}
+
+/**
+ * @brief Sign webhook payload the way OCI Task System does
+ * @param secret Signing secret of webhook subscription
+ * @param payload Raw body of webhook request
+ * @return Signature in form sha256=<hex encoded HMAC-SHA256 of payload>
+ */
+func SignOciTaskWebhookPayload(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return ociTaskWebhookSignaturePrefix + hex.EncodeToString(mac.Sum(nil))
+}
+
+/**
+ * @brief Verify signature of incoming webhook payload. Comparison takes constant time.
+ * @param secret Signing secret of webhook subscription
+ * @param payload Raw body of webhook request, exactly as received
+ * @param signature Value of X-OciTask-Signature header, with or without sha256= prefix
+ * @return True if payload was signed with secret
+ */
+func VerifyOciTaskWebhookSignature(secret string, payload []byte, signature string) bool {
+	received, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(signature), ociTaskWebhookSignaturePrefix))
+	if err != nil || len(received) == 0 {
+		return false
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+
+	return hmac.Equal(received, mac.Sum(nil))
+}
@@ -0,0 +1,40 @@
+package ocitaskclient
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOciTaskWebhookFlatten(test *testing.T) {
+	webhookId := int64(5)
+	hookUrl, secret, active := "https://hooks.example.com/tasks", "s3cr3t", true
+	webhook := OciTaskWebhook{Id: &webhookId, Url: &hookUrl, Events: []string{OciTaskWebhookEventTaskCreated, OciTaskWebhookEventTaskDeleted}, Secret: &secret, Active: &active}
+
+	result := webhook.Flatten()
+
+	assert.Equal(test, hookUrl, result["url"], "TestOciTaskWebhookFlatten Failed: Wrong URL")
+	assert.Equal(test, []interface{}{"task.created", "task.deleted"}, result["events"], "TestOciTaskWebhookFlatten Failed: Wrong events")
+	assert.Equal(test, true, result["active"], "TestOciTaskWebhookFlatten Failed: Webhook should be active")
+	assert.NotContains(test, result, "secret", "TestOciTaskWebhookFlatten Failed: Secret must not be flattened")
+}
+
+func TestSignOciTaskWebhookPayload(test *testing.T) {
+	// Reference value from RFC 4231 test case 2
+	signature := SignOciTaskWebhookPayload("Jefe", []byte("what do ya want for nothing?"))
+
+	assert.Equal(test, "sha256=5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843", signature, "TestSignOciTaskWebhookPayload Failed: Wrong signature")
+}
+
+func TestVerifyOciTaskWebhookSignature(test *testing.T) {
+	payload := []byte(`{"event":"task.created","taskId":1001}`)
+	signature := SignOciTaskWebhookPayload("s3cr3t", payload)
+
+	assert.True(test, VerifyOciTaskWebhookSignature("s3cr3t", payload, signature), "TestVerifyOciTaskWebhookSignature Failed: Valid signature rejected")
+	assert.True(test, VerifyOciTaskWebhookSignature("s3cr3t", payload, strings.TrimPrefix(signature, "sha256=")), "TestVerifyOciTaskWebhookSignature Failed: Signature without prefix rejected")
+	assert.False(test, VerifyOciTaskWebhookSignature("other", payload, signature), "TestVerifyOciTaskWebhookSignature Failed: Signature with wrong secret accepted")
+	assert.False(test, VerifyOciTaskWebhookSignature("s3cr3t", []byte(`{"event":"task.deleted","taskId":1001}`), signature), "TestVerifyOciTaskWebhookSignature Failed: Tampered payload accepted")
+	assert.False(test, VerifyOciTaskWebhookSignature("s3cr3t", payload, "sha256=not-hex"), "TestVerifyOciTaskWebhookSignature Failed: Malformed signature accepted")
+	assert.False(test, VerifyOciTaskWebhookSignature("s3cr3t", payload, ""), "TestVerifyOciTaskWebhookSignature Failed: Empty signature accepted")
+}
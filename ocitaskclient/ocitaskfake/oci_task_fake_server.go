@@ -0,0 +1,394 @@
+package ocitaskfake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"ocitaskclient"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+/**
+ * @brief Local fake of OCI Task Service for tests of tooling built on ocitaskclient.
+ *			Serves create, read, update and delete of Tasks and the watch stream of Task change events.
+ */
+type OciTaskFakeServer struct {
+	server       *httptest.Server
+	mutex        sync.Mutex
+	nextId       int64
+	tasks        map[int64]ocitaskclient.OciTask
+	events       []ocitaskclient.OciTaskEvent
+	notify       chan struct{}
+	drop         chan struct{}
+	watchFailure int
+	watchStatus  int
+}
+
+/**
+ * @brief Constructor to start instance of OciTaskFakeServer on local port
+ * @return Instance of OciTaskFakeServer, must be closed with Close
+ */
+func MakeOciTaskFakeServer() *OciTaskFakeServer {
+	ociTaskFakeServer := &OciTaskFakeServer{
+		nextId: 1001,
+		tasks:  make(map[int64]ocitaskclient.OciTask),
+		notify: make(chan struct{}),
+		drop:   make(chan struct{}),
+	}
+	ociTaskFakeServer.server = httptest.NewServer(ociTaskFakeServer)
+
+	return ociTaskFakeServer
+}
+
+/**
+ * @brief Getter function for host URL to pass to MakeOciTaskServClient
+ * @return Host URL of fake server
+ */
+func (ociTaskFakeServer *OciTaskFakeServer) GetUrl() string {
+	return ociTaskFakeServer.server.URL
+}
+
+/**
+ * @brief Stop fake server, ending open watch streams
+ */
+func (ociTaskFakeServer *OciTaskFakeServer) Close() {
+	ociTaskFakeServer.DropConnections()
+	ociTaskFakeServer.server.Close()
+}
+
+/**
+ * @brief End all open watch streams, as if connections were lost. Clients resume from their cursor.
+ */
+func (ociTaskFakeServer *OciTaskFakeServer) DropConnections() {
+	ociTaskFakeServer.mutex.Lock()
+	defer ociTaskFakeServer.mutex.Unlock()
+
+	close(ociTaskFakeServer.drop)
+	ociTaskFakeServer.drop = make(chan struct{})
+}
+
+/**
+ * @brief Make next watch requests fail
+ * @param count Number of watch requests to fail
+ * @param statusCode HTTP status to fail them with
+ */
+func (ociTaskFakeServer *OciTaskFakeServer) FailWatch(count int, statusCode int) {
+	ociTaskFakeServer.mutex.Lock()
+	defer ociTaskFakeServer.mutex.Unlock()
+
+	ociTaskFakeServer.watchFailure = count
+	ociTaskFakeServer.watchStatus = statusCode
+}
+
+/**
+ * @brief Store new Task and publish created event
+ * @param ociTask Task to store, Identifier is assigned
+ * @return Stored Task
+ */
+func (ociTaskFakeServer *OciTaskFakeServer) CreateTask(ociTask ocitaskclient.OciTask) ocitaskclient.OciTask {
+	ociTaskFakeServer.mutex.Lock()
+	defer ociTaskFakeServer.mutex.Unlock()
+
+	taskId := ociTaskFakeServer.nextId
+	ociTaskFakeServer.nextId++
+
+	now := time.Now().UnixMilli()
+	ociTask.Id = &taskId
+	ociTask.TimeCreated = &now
+	ociTask.TimeUpdated = &now
+	ociTaskFakeServer.tasks[taskId] = ociTask
+	ociTaskFakeServer.publish(ocitaskclient.OciTaskEventCreated, taskId, &ociTask)
+
+	return ociTask
+}
+
+/**
+ * @brief Replace stored Task and publish updated event
+ * @param ociTask Task with Identifier of stored Task
+ * @return True if Task exists
+ */
+func (ociTaskFakeServer *OciTaskFakeServer) UpdateTask(ociTask ocitaskclient.OciTask) bool {
+	ociTaskFakeServer.mutex.Lock()
+	defer ociTaskFakeServer.mutex.Unlock()
+
+	if ociTask.Id == nil {
+		return false
+	}
+
+	stored, found := ociTaskFakeServer.tasks[*ociTask.Id]
+	if !found {
+		return false
+	}
+
+	now := time.Now().UnixMilli()
+	ociTask.TimeCreated = stored.TimeCreated
+	ociTask.TimeUpdated = &now
+	ociTaskFakeServer.tasks[*ociTask.Id] = ociTask
+	ociTaskFakeServer.publish(ocitaskclient.OciTaskEventUpdated, *ociTask.Id, &ociTask)
+
+	return true
+}
+
+/**
+ * @brief Remove stored Task and publish deleted event
+ * @param taskId Identifier of Task
+ * @return True if Task existed
+ */
+func (ociTaskFakeServer *OciTaskFakeServer) DeleteTask(taskId int64) bool {
+	ociTaskFakeServer.mutex.Lock()
+	defer ociTaskFakeServer.mutex.Unlock()
+
+	if _, found := ociTaskFakeServer.tasks[taskId]; !found {
+		return false
+	}
+
+	delete(ociTaskFakeServer.tasks, taskId)
+	ociTaskFakeServer.publish(ocitaskclient.OciTaskEventDeleted, taskId, nil)
+
+	return true
+}
+
+/**
+ * @brief Look up stored Task
+ * @param taskId Identifier of Task
+ * @return Stored Task
+ * @return True if Task exists
+ */
+func (ociTaskFakeServer *OciTaskFakeServer) GetTask(taskId int64) (ocitaskclient.OciTask, bool) {
+	ociTaskFakeServer.mutex.Lock()
+	defer ociTaskFakeServer.mutex.Unlock()
+
+	ociTask, found := ociTaskFakeServer.tasks[taskId]
+	return ociTask, found
+}
+
+/**
+ * @brief Append event to event log and wake up open watch streams. Caller must hold mutex.
+ * @param eventType Type of event
+ * @param taskId Identifier of Task changed
+ * @param ociTask Task after change, nil if deleted
+ */
+func (ociTaskFakeServer *OciTaskFakeServer) publish(eventType string, taskId int64, ociTask *ocitaskclient.OciTask) {
+	cursor := strconv.Itoa(len(ociTaskFakeServer.events) + 1)
+	ociTaskFakeServer.events = append(ociTaskFakeServer.events, ocitaskclient.OciTaskEvent{Type: eventType, Cursor: cursor, TaskId: &taskId, Task: ociTask})
+
+	close(ociTaskFakeServer.notify)
+	ociTaskFakeServer.notify = make(chan struct{})
+}
+
+/**
+ * @brief Route request to handler of OCI Task Service endpoint
+ * @param writer Writer for HTTP response
+ * @param request HTTP request
+ */
+func (ociTaskFakeServer *OciTaskFakeServer) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	path := strings.TrimSuffix(request.URL.Path, "/")
+	switch {
+	case path == "/tasks/watch" && request.Method == "GET":
+		ociTaskFakeServer.serveWatch(writer, request)
+	case path == "/tasks" && request.Method == "POST":
+		ociTaskFakeServer.serveCreateTask(writer, request)
+	case strings.HasPrefix(path, "/tasks/"):
+		taskId, err := strconv.ParseInt(strings.TrimPrefix(path, "/tasks/"), 10, 64)
+		if err != nil {
+			writeOciTaskFakeError(writer, http.StatusNotFound, "Unknown endpoint "+path)
+			return
+		}
+		ociTaskFakeServer.serveTask(writer, request, taskId)
+	default:
+		writeOciTaskFakeError(writer, http.StatusNotFound, "Unknown endpoint "+path)
+	}
+}
+
+/**
+ * @brief Handle create Task request
+ * @param writer Writer for HTTP response
+ * @param request HTTP request with OciTaskServRequest body
+ */
+func (ociTaskFakeServer *OciTaskFakeServer) serveCreateTask(writer http.ResponseWriter, request *http.Request) {
+	ociTask := ocitaskclient.OciTask{}
+	err := decodeOciTaskFakeRequest(request, &ociTask)
+	if err != nil {
+		writeOciTaskFakeError(writer, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ociTask = ociTaskFakeServer.CreateTask(ociTask)
+	writeOciTaskFakeResponse(writer, http.StatusCreated, &ocitaskclient.OciTaskServResponse{TaskId: ociTask.Id, Task: &ociTask})
+}
+
+/**
+ * @brief Handle read, update and delete Task requests
+ * @param writer Writer for HTTP response
+ * @param request HTTP request
+ * @param taskId Identifier of Task in request path
+ */
+func (ociTaskFakeServer *OciTaskFakeServer) serveTask(writer http.ResponseWriter, request *http.Request, taskId int64) {
+	ociTask, found := ociTaskFakeServer.GetTask(taskId)
+	if !found {
+		writeOciTaskFakeError(writer, http.StatusNotFound, fmt.Sprintf("Task %d not found", taskId))
+		return
+	}
+
+	switch request.Method {
+	case "GET":
+		writeOciTaskFakeResponse(writer, http.StatusOK, &ocitaskclient.OciTaskServResponse{Task: &ociTask})
+	case "PUT":
+		err := decodeOciTaskFakeRequest(request, &ociTask)
+		if err != nil {
+			writeOciTaskFakeError(writer, http.StatusBadRequest, err.Error())
+			return
+		}
+		ociTask.Id = &taskId
+		ociTaskFakeServer.UpdateTask(ociTask)
+		ociTask, _ = ociTaskFakeServer.GetTask(taskId)
+		writeOciTaskFakeResponse(writer, http.StatusOK, &ocitaskclient.OciTaskServResponse{Task: &ociTask})
+	case "DELETE":
+		ociTaskFakeServer.DeleteTask(taskId)
+		writeOciTaskFakeResponse(writer, http.StatusOK, &ocitaskclient.OciTaskServResponse{})
+	default:
+		writeOciTaskFakeError(writer, http.StatusMethodNotAllowed, "Method not allowed")
+	}
+}
+
+/**
+ * @brief Handle watch request: stream events after cursor in Last-Event-ID header until wait seconds passed
+ * @param writer Writer for HTTP response, must support flushing
+ * @param request HTTP request
+ */
+func (ociTaskFakeServer *OciTaskFakeServer) serveWatch(writer http.ResponseWriter, request *http.Request) {
+	ociTaskFakeServer.mutex.Lock()
+	if ociTaskFakeServer.watchFailure > 0 {
+		ociTaskFakeServer.watchFailure--
+		statusCode := ociTaskFakeServer.watchStatus
+		ociTaskFakeServer.mutex.Unlock()
+		writeOciTaskFakeError(writer, statusCode, "Watch failure injected")
+		return
+	}
+	cursor := len(ociTaskFakeServer.events)
+	ociTaskFakeServer.mutex.Unlock()
+
+	if value := request.Header.Get(ocitaskclient.OciTaskWatchCursorHeader); value != "" {
+		parsed, err := strconv.Atoi(value)
+		if err != nil || parsed < 0 || parsed > cursor {
+			writeOciTaskFakeError(writer, http.StatusGone, fmt.Sprintf("Cursor %q is unknown or expired", value))
+			return
+		}
+		cursor = parsed
+	}
+
+	wait := 30 * time.Second
+	if value := request.URL.Query().Get("wait"); value != "" {
+		seconds, err := strconv.Atoi(value)
+		if err != nil || seconds <= 0 {
+			writeOciTaskFakeError(writer, http.StatusBadRequest, fmt.Sprintf("Invalid wait %q", value))
+			return
+		}
+		wait = time.Duration(seconds) * time.Second
+	}
+
+	flusher, ok := writer.(http.Flusher)
+	if !ok {
+		writeOciTaskFakeError(writer, http.StatusInternalServerError, "Streaming not supported")
+		return
+	}
+
+	writer.Header().Set("Content-Type", "text/event-stream")
+	writer.Header().Set("Cache-Control", "no-cache")
+	writer.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	deadline := time.NewTimer(wait)
+	defer deadline.Stop()
+
+	for {
+		ociTaskFakeServer.mutex.Lock()
+		pending := ociTaskFakeServer.events[cursor:]
+		notify, drop := ociTaskFakeServer.notify, ociTaskFakeServer.drop
+		ociTaskFakeServer.mutex.Unlock()
+
+		for _, ociTaskEvent := range pending {
+			data, _ := ociTaskEvent.Serialize()
+			fmt.Fprintf(writer, "id: %s\nevent: %s\ndata: %s\n\n", ociTaskEvent.Cursor, ociTaskEvent.Type, data)
+			cursor++
+		}
+		flusher.Flush()
+
+		select {
+		case <-notify:
+		case <-drop:
+			return
+		case <-deadline.C:
+			return
+		case <-request.Context().Done():
+			return
+		}
+	}
+}
+
+/**
+ * @brief Decode body of create or update Task request into Task. Fields missing from body keep their value.
+ * @param request HTTP request with OciTaskServRequest body
+ * @param ociTask Task to decode into
+ * @return Instance of error if body is malformed
+ */
+func decodeOciTaskFakeRequest(request *http.Request, ociTask *ocitaskclient.OciTask) error {
+	fields := make(map[string]json.RawMessage)
+	err := json.NewDecoder(request.Body).Decode(&fields)
+	if err != nil {
+		return err
+	}
+
+	// Dates are sent as text but stored as epoch milliseconds
+	delete(fields, "startDate")
+	delete(fields, "dueDate")
+
+	// Overlay on copy of stored fields so maps and lists are replaced, not merged
+	stored := make(map[string]json.RawMessage)
+	data, err := json.Marshal(ociTask)
+	if err == nil {
+		err = json.Unmarshal(data, &stored)
+	}
+	if err != nil {
+		return err
+	}
+
+	for name, value := range fields {
+		stored[name] = value
+	}
+
+	data, err = json.Marshal(stored)
+	if err != nil {
+		return err
+	}
+
+	*ociTask = ocitaskclient.OciTask{}
+	return json.Unmarshal(data, ociTask)
+}
+
+/**
+ * @brief Write OCI Task Service response
+ * @param writer Writer for HTTP response
+ * @param statusCode HTTP status
+ * @param ociTaskServResponse Response body
+ */
+func writeOciTaskFakeResponse(writer http.ResponseWriter, statusCode int, ociTaskServResponse *ocitaskclient.OciTaskServResponse) {
+	data, _ := ociTaskServResponse.Serialize()
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(statusCode)
+	writer.Write([]byte(data))
+}
+
+/**
+ * @brief Write OCI Task Service error response
+ * @param writer Writer for HTTP response
+ * @param statusCode HTTP status
+ * @param message Error message
+ */
+func writeOciTaskFakeError(writer http.ResponseWriter, statusCode int, message string) {
+	writeOciTaskFakeResponse(writer, statusCode, &ocitaskclient.OciTaskServResponse{Err: &ocitaskclient.OciError{ErrorCode: &statusCode, ErrorMessage: &message}})
+}
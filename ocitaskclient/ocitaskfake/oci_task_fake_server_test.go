@@ -0,0 +1,37 @@
+package ocitaskfake
+
+import (
+	"context"
+	"ocitaskclient"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOciTaskFakeServerTaskEndpoints(test *testing.T) {
+	fakeServer := MakeOciTaskFakeServer()
+	defer fakeServer.Close()
+
+	url := fakeServer.GetUrl()
+	ociTaskServClient := ocitaskclient.MakeOciTaskServClient(&url)
+
+	title := "Write docs"
+	ociTask := fakeServer.CreateTask(ocitaskclient.OciTask{Title: &title, Tags: map[string]string{"team": "docs"}})
+
+	apiResp, err := ociTaskServClient.GetTask(context.Background(), ociTask.Id)
+	assert.NoError(test, err, "TestOciTaskFakeServerTaskEndpoints Failed: No error expected")
+	assert.Equal(test, "Write docs", *apiResp.Task.Title, "TestOciTaskFakeServerTaskEndpoints Failed: Wrong task")
+
+	newTitle := "Write user docs"
+	_, err = ociTaskServClient.UpdateTask(context.Background(), ociTask.Id, &ocitaskclient.OciTaskServRequest{Title: &newTitle, Tags: map[string]string{"owner": "jdoe"}})
+	assert.NoError(test, err, "TestOciTaskFakeServerTaskEndpoints Failed: No error expected on update")
+	stored, _ := fakeServer.GetTask(*ociTask.Id)
+	assert.Equal(test, "Write user docs", *stored.Title, "TestOciTaskFakeServerTaskEndpoints Failed: Title should be updated")
+	assert.Equal(test, map[string]string{"owner": "jdoe"}, stored.Tags, "TestOciTaskFakeServerTaskEndpoints Failed: Tags should be replaced")
+
+	_, err = ociTaskServClient.DeleteTask(context.Background(), ociTask.Id)
+	assert.NoError(test, err, "TestOciTaskFakeServerTaskEndpoints Failed: No error expected on delete")
+
+	_, err = ociTaskServClient.GetTask(context.Background(), ociTask.Id)
+	assert.Error(test, err, "TestOciTaskFakeServerTaskEndpoints Failed: Deleted task should not be found")
+}
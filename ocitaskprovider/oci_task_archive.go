@@ -0,0 +1,104 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"ocitaskclient"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/**
+ * @brief Deletion modes of Task resource. delete removes Task for good, archive keeps it with its history so it can be restored.
+ *			Task without deletion mode is deleted, so states written before deletion modes existed plan no change.
+ */
+const (
+	OciTaskDeletionModeDelete  string = "delete"
+	OciTaskDeletionModeArchive string = "archive"
+)
+
+/**
+ * @brief Set archive state of Task into flattened Task of data source
+ * @param ociTask Flattened Task, receives archived and time_archived
+ * @param task Task read from OCI Task Service
+ */
+func setOciTaskArchived(ociTask map[string]interface{}, task *ocitaskclient.OciTask) {
+	ociTask["archived"] = task.IsArchived()
+	ociTask["time_archived"] = task.GetTimeArchived()
+}
+
+/**
+ * @brief Set archive state of Task into Task resource
+ * @param rd Contains Task instance defined in Terraform scripts
+ * @param task Task read from OCI Task Service
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func setOciTaskResourceArchived(rd *schema.ResourceData, task *ocitaskclient.OciTask) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	err := rd.Set("archived", task.IsArchived())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to set task archive state into resource data",
+			Detail:   err.Error(),
+		})
+	}
+
+	return diags
+}
+
+/**
+ * @brief Restore Task if it is archived in state, e.g. after archived Task was imported
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains Task instance defined in Terraform scripts
+ * @param taskId Identifier of the Task
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func restoreOciTask(ctx context.Context, rd *schema.ResourceData, taskId int64, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	archived, _ := rd.GetChange("archived")
+	if !archived.(bool) {
+		return diags
+	}
+
+	ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+	ociResponse, err := ociClient.RestoreTask(ctx, &taskId)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to restore task",
+			Detail:   err.Error(),
+		})
+	} else {
+		if ociResponse.Err != nil {
+			ociErr, _ := ociResponse.Err.Serialize()
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to restore task",
+				Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+			})
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Plan restore of archived Task. Task resource always describes active Task, so archived Task is restored on apply.
+ * @param ctx Context to Terraform Provider
+ * @param rdiff Planned changes of Task resource
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Instance of error if restore can't be planned
+ */
+func customizeOciTaskArchived(ctx context.Context, rdiff *schema.ResourceDiff, m interface{}) error {
+	if archived, _ := rdiff.Get("archived").(bool); archived {
+		return rdiff.SetNew("archived", false)
+	}
+
+	return nil
+}
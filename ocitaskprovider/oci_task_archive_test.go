@@ -0,0 +1,112 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"ocitaskclient"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDeleteTaskOperationArchive(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	taskId := int64(1001)
+	ociTaskServClientMock.On("ArchiveTask", mock.Anything, &taskId).Return(&ocitaskclient.OciTaskServResponse{}, nil).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTask().Schema, map[string]interface{}{
+		"items":         []interface{}{map[string]interface{}{"title": "Test Task 1"}},
+		"deletion_mode": "archive",
+	})
+	rd.SetId("1001")
+
+	diags := ociTaskOperation.OciTaskDelete(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestDeleteTaskOperationArchive Failed: No Diagnostics expected")
+	assert.Equal(test, "", rd.Id(), "TestDeleteTaskOperationArchive Failed: Archived Task expected to leave state")
+}
+
+func TestDeleteTaskOperationFailedProtected(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTask().Schema, map[string]interface{}{
+		"items":               []interface{}{map[string]interface{}{"title": "Test Task 1"}},
+		"deletion_protection": true,
+	})
+	rd.SetId("1001")
+
+	diags := ociTaskOperation.OciTaskDelete(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 1, len(diags), "TestDeleteTaskOperationFailedProtected Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Task is protected from deletion", diags[0].Summary, "TestDeleteTaskOperationFailedProtected Failed: Wrong Diagnostic Summary expected")
+	assert.Contains(test, diags[0].Detail, "deletion_protection = false", "TestDeleteTaskOperationFailedProtected Failed: Diagnostic expected to explain how to unprotect")
+	assert.Equal(test, "1001", rd.Id(), "TestDeleteTaskOperationFailedProtected Failed: Task expected to stay in state")
+}
+
+func TestUpdateTaskOperationRestoresArchived(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	taskId := int64(1001)
+	task := makeTestOciTask(taskId)
+
+	ociTaskServClientMock.On("RestoreTask", mock.Anything, &taskId).Return(&ocitaskclient.OciTaskServResponse{}, nil).Once()
+	ociTaskServClientMock.On("UpdateTask", mock.Anything, &taskId, mock.Anything).Return(&ocitaskclient.OciTaskServResponse{TaskId: &taskId}, nil).Once()
+	ociTaskServClientMock.On("GetTask", mock.Anything, &taskId).Return(&ocitaskclient.OciTaskServResponse{Task: &task}, nil).Once()
+
+	resource := MakeOciTaskResource().ResourceOciTask()
+	state := makeTestOciTaskState("todo", "false")
+	state.Attributes["archived"] = "true"
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"items": []interface{}{map[string]interface{}{"title": "Test Task 1", "status": "todo"}},
+	})
+	diff, err := resource.Diff(context.Background(), state, config, &ociTaskServClientMock)
+
+	assert.NoError(test, err, "TestUpdateTaskOperationRestoresArchived Failed: No error expected")
+	assert.Equal(test, "false", diff.Attributes["archived"].New, "TestUpdateTaskOperationRestoresArchived Failed: Restore expected to be planned")
+
+	rd, _ := schema.InternalMap(resource.Schema).Data(state, diff)
+
+	diags := ociTaskOperation.OciTaskUpdate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestUpdateTaskOperationRestoresArchived Failed: No Diagnostics expected")
+	assert.Equal(test, false, rd.Get("archived"), "TestUpdateTaskOperationRestoresArchived Failed: Task expected to be active")
+}
+
+func TestReadArchivedTasksOperationSuccess(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	archived := true
+	timeArchived := time.Date(2023, 3, 15, 10, 0, 0, 0, time.UTC).UnixMilli()
+	task := makeTestOciTask(1001)
+	task.Archived = &archived
+	task.TimeArchived = &timeArchived
+
+	ociTaskServClientMock.On("ListTasks", mock.Anything, mock.MatchedBy(func(filter *ocitaskclient.OciTaskListFilter) bool {
+		return filter.Archived && *filter.ProjectId == 42
+	})).Return(&ocitaskclient.OciTaskServResponse{Tasks: []ocitaskclient.OciTask{task}}, nil).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskDataSource().DataSourceOciArchivedTasks().Schema, map[string]interface{}{"project_id": 42})
+
+	diags := ociTaskOperation.OciArchivedTasksRead(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestReadArchivedTasksOperationSuccess Failed: No Diagnostics expected")
+	assert.Equal(test, 1001, rd.Get("items.0.id"), "TestReadArchivedTasksOperationSuccess Failed: Archived Task expected")
+	assert.Equal(test, true, rd.Get("items.0.archived"), "TestReadArchivedTasksOperationSuccess Failed: Task expected to be archived")
+	assert.Equal(test, "2023-03-15T10:00:00Z", rd.Get("items.0.time_archived"), "TestReadArchivedTasksOperationSuccess Failed: Wrong archive time")
+}
@@ -0,0 +1,407 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"ocitaskclient"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/**
+ * @brief Upload local file as attachment of Task in OCI Task System
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains attachment defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskAttachmentCreate(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskAttachmentCreate", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	taskId := int64(rd.Get("task_id").(int))
+	source := rd.Get("source").(string)
+	fileName := rd.Get("file_name").(string)
+	if fileName == "" {
+		fileName = filepath.Base(source)
+	}
+
+	file, err := os.Open(source)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to open task attachment source",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+	defer file.Close()
+
+	// Hash what is actually sent, the file may have changed since plan
+	hash := sha256.New()
+	ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+	ociResponse, err := ociClient.UploadTaskAttachment(ctx, &taskId, fileName, io.TeeReader(file, hash))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to upload task attachment",
+			Detail:   err.Error(),
+		})
+	} else {
+		if ociResponse.Err != nil {
+			ociErr, _ := ociResponse.Err.Serialize()
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to upload task attachment",
+				Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+			})
+		} else if ociResponse.Attachment == nil || ociResponse.Attachment.Id == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to upload task attachment",
+				Detail:   "OCI Task Service returned no attachment Id" + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+			})
+		} else {
+			rd.SetId(formatOciTaskAttachmentId(taskId, *ociResponse.Attachment.Id))
+
+			sourceHash := hex.EncodeToString(hash.Sum(nil))
+			err := rd.Set("source_hash", sourceHash)
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to set task attachment into resource data",
+					Detail:   err.Error(),
+				})
+			}
+
+			if ociResponse.Attachment.Sha256 != nil && *ociResponse.Attachment.Sha256 != sourceHash {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to verify task attachment upload",
+					Detail:   fmt.Sprintf("OCI Task Service stored content with SHA-256 %s, uploaded content has SHA-256 %s", *ociResponse.Attachment.Sha256, sourceHash) + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			}
+
+			diags = append(diags, ociTaskOperation.OciTaskAttachmentRead(ctx, rd, m)...)
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Read attachment metadata of Task in OCI Task System. Removes attachment from state if it no longer exists.
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains attachment Identifier in form <task_id>/<attachment_id>
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskAttachmentRead(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskAttachmentRead", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	taskId, attachmentId, err := parseOciTaskAttachmentId(rd.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to get Id from resource data",
+			Detail:   err.Error(),
+		})
+	} else {
+		ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+		ociResponse, err := ociClient.GetTaskAttachment(ctx, &taskId, &attachmentId)
+		if ocitaskclient.IsOciTaskNotFound(err) {
+			rd.SetId("")
+		} else if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read task attachment",
+				Detail:   err.Error(),
+			})
+		} else {
+			if ociResponse.Err != nil {
+				ociErr, _ := ociResponse.Err.Serialize()
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to read task attachment",
+					Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else if ociResponse.Attachment == nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to read attachment",
+					Detail:   "OCI Task Service returned no attachment" + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else {
+				attachment := ociResponse.Attachment.Flatten()
+				attachment["task_id"] = int(taskId)
+
+				for key, value := range attachment {
+					err := rd.Set(key, value)
+					if err != nil {
+						diags = append(diags, diag.Diagnostic{
+							Severity: diag.Error,
+							Summary:  "Failed to set task attachment into resource data",
+							Detail:   err.Error(),
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Delete attachment of Task in OCI Task System
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains attachment Identifier in form <task_id>/<attachment_id>
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskAttachmentDelete(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskAttachmentDelete", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	taskId, attachmentId, err := parseOciTaskAttachmentId(rd.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to get Id from resource data",
+			Detail:   err.Error(),
+		})
+	} else {
+		ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+		ociResponse, err := ociClient.DeleteTaskAttachment(ctx, &taskId, &attachmentId)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to delete task attachment",
+				Detail:   err.Error(),
+			})
+		} else {
+			if ociResponse.Err != nil {
+				ociErr, _ := ociResponse.Err.Serialize()
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to delete task attachment",
+					Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else {
+				rd.SetId("")
+			}
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Read attachment of Task in OCI Task System for attachment data source.
+ *			Content is downloaded to output_path if set, and checked against SHA-256 reported by OCI Task Service.
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains Task and attachment Identifiers defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskAttachmentDataRead(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	taskId := int64(rd.Get("task_id").(int))
+	attachmentId := int64(rd.Get("attachment_id").(int))
+	id := formatOciTaskAttachmentId(taskId, attachmentId)
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskAttachmentDataRead", id)
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+	ociResponse, err := ociClient.GetTaskAttachment(ctx, &taskId, &attachmentId)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read task attachment",
+			Detail:   err.Error(),
+		})
+	} else if ociResponse.Err != nil {
+		ociErr, _ := ociResponse.Err.Serialize()
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read task attachment",
+			Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+		})
+	} else if ociResponse.Attachment == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read task attachment",
+			Detail:   fmt.Sprintf("Attachment %d of Task %d not found", attachmentId, taskId) + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+		})
+	} else {
+		attachment := ociResponse.Attachment.Flatten()
+		for key, value := range attachment {
+			err := rd.Set(key, value)
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to set task attachment into resource data",
+					Detail:   err.Error(),
+				})
+			}
+		}
+
+		outputPath := rd.Get("output_path").(string)
+		if outputPath != "" {
+			err := downloadOciTaskAttachment(ctx, ociClient, taskId, attachmentId, attachment["sha256"].(string), outputPath)
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to download task attachment",
+					Detail:   err.Error(),
+				})
+			}
+		}
+
+		if !diags.HasError() {
+			rd.SetId(id)
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Plan replacement of attachment when content of local source file changes.
+ *			SHA-256 of source is computed at plan time and compared with hash of content uploaded last.
+ * @param ctx Context to Terraform Provider
+ * @param rdiff Planned changes of attachment resource
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Instance of error if source can't be read
+ */
+func customizeOciTaskAttachmentSource(ctx context.Context, rdiff *schema.ResourceDiff, m interface{}) error {
+	// Source produced by another resource in the same plan can't be hashed yet
+	if !rdiff.NewValueKnown("source") {
+		return rdiff.SetNewComputed("source_hash")
+	}
+
+	sourceHash, err := hashOciTaskAttachmentFile(rdiff.Get("source").(string))
+	if err != nil {
+		return err
+	}
+
+	oldHash, _ := rdiff.GetChange("source_hash")
+	if oldHash.(string) == sourceHash {
+		return nil
+	}
+
+	err = rdiff.SetNew("source_hash", sourceHash)
+	if err == nil && rdiff.Id() != "" {
+		err = rdiff.ForceNew("source_hash")
+	}
+
+	return err
+}
+
+/**
+ * @brief Compute SHA-256 of local file without reading it into memory
+ * @param path Path to local file
+ * @return Hex encoded SHA-256 digest
+ * @return Instance of error if file can't be read
+ */
+func hashOciTaskAttachmentFile(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", fmt.Errorf("Failed to read attachment source: %w", err)
+	}
+	defer file.Close()
+
+	digest, _, err := ocitaskclient.HashOciTaskAttachment(file)
+	if err != nil {
+		return "", fmt.Errorf("Failed to read attachment source %q: %w", path, err)
+	}
+
+	return digest, nil
+}
+
+/**
+ * @brief Download attachment content to local file.
+ *			Content is written to temporary file next to destination, which replaces destination only once content is verified.
+ * @param ctx Context to Terraform Provider
+ * @param ociClient Client to OCI Task Service
+ * @param taskId Identifier of Task
+ * @param attachmentId Identifier of attachment
+ * @param expectedHash SHA-256 reported by OCI Task Service, empty to skip verification
+ * @param outputPath Path to destination file
+ * @return Instance of error if failed
+ */
+func downloadOciTaskAttachment(ctx context.Context, ociClient ocitaskclient.OciTaskServClientInterface, taskId int64, attachmentId int64, expectedHash string, outputPath string) error {
+	file, err := os.CreateTemp(filepath.Dir(outputPath), ".ocitask-attachment-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	hash := sha256.New()
+	err = ociClient.DownloadTaskAttachment(ctx, &taskId, &attachmentId, io.MultiWriter(file, hash))
+	closeErr := file.Close()
+	if err != nil {
+		return err
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+
+	actualHash := hex.EncodeToString(hash.Sum(nil))
+	if expectedHash != "" && actualHash != expectedHash {
+		return fmt.Errorf("Downloaded content has SHA-256 %s, OCI Task Service reported %s", actualHash, expectedHash)
+	}
+
+	return os.Rename(file.Name(), outputPath)
+}
+
+/**
+ * @brief Build Identifier of attachment resource
+ * @param taskId Identifier of Task
+ * @param attachmentId Identifier of attachment
+ * @return Identifier in form <task_id>/<attachment_id>
+ */
+func formatOciTaskAttachmentId(taskId int64, attachmentId int64) string {
+	return fmt.Sprintf("%d/%d", taskId, attachmentId)
+}
+
+/**
+ * @brief Split Identifier of attachment resource
+ * @param id Identifier in form <task_id>/<attachment_id>
+ * @return Identifier of Task
+ * @return Identifier of attachment
+ * @return Instance of error if Identifier is malformed
+ */
+func parseOciTaskAttachmentId(id string) (int64, int64, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) == 2 {
+		taskId, taskErr := strconv.ParseInt(parts[0], 10, 64)
+		attachmentId, attachmentErr := strconv.ParseInt(parts[1], 10, 64)
+		if taskErr == nil && attachmentErr == nil {
+			return taskId, attachmentId, nil
+		}
+	}
+
+	return 0, 0, fmt.Errorf("Invalid attachment Id %q - expected <task_id>/<attachment_id>", id)
+}
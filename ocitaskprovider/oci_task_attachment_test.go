@@ -0,0 +1,204 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"ocitaskclient"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// SHA-256 of "Test Content"
+const testOciTaskAttachmentHash = "60c9b75f15144a088fd7800e1049c6c80a92e76de588c2b21b30ff42f6694ce2"
+
+func makeTestOciTaskAttachment(id int64, digest string) ocitaskclient.OciTaskAttachment {
+	fileName := "notes.txt"
+	contentType := "text/plain"
+	size := int64(12)
+
+	return ocitaskclient.OciTaskAttachment{Id: &id, FileName: &fileName, ContentType: &contentType, Size: &size, Sha256: &digest}
+}
+
+func makeTestOciTaskAttachmentSource(test *testing.T, content string) string {
+	source := filepath.Join(test.TempDir(), "notes.txt")
+	err := os.WriteFile(source, []byte(content), 0o600)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	return source
+}
+
+func TestCreateTaskAttachmentOperationSuccess(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	attachment := makeTestOciTaskAttachment(7, testOciTaskAttachmentHash)
+	source := makeTestOciTaskAttachmentSource(test, "Test Content")
+
+	uploaded := ""
+	ociTaskServClientMock.On("UploadTaskAttachment", mock.Anything, mock.MatchedBy(func(taskId *int64) bool { return *taskId == 1001 }), "notes.txt", mock.Anything).Run(func(args mock.Arguments) {
+		data, _ := ioutil.ReadAll(args.Get(3).(io.Reader))
+		uploaded = string(data)
+	}).Return(&ocitaskclient.OciTaskServResponse{Attachment: &attachment}, nil).Once()
+	ociTaskServClientMock.On("GetTaskAttachment", mock.Anything, mock.Anything, attachment.Id).Return(&ocitaskclient.OciTaskServResponse{Attachment: &attachment}, nil).Once()
+
+	testData := map[string]interface{}{"task_id": 1001, "source": source}
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskAttachment().Schema, testData)
+
+	diags := ociTaskOperation.OciTaskAttachmentCreate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestCreateTaskAttachmentOperationSuccess Failed: No Diagnostics expected")
+	assert.Equal(test, "Test Content", uploaded, "TestCreateTaskAttachmentOperationSuccess Failed: Source content should be uploaded")
+	assert.Equal(test, "1001/7", rd.Id(), "TestCreateTaskAttachmentOperationSuccess Failed: Wrong resource Id")
+	assert.Equal(test, testOciTaskAttachmentHash, rd.Get("source_hash"), "TestCreateTaskAttachmentOperationSuccess Failed: Wrong source hash")
+	assert.Equal(test, 12, rd.Get("size"), "TestCreateTaskAttachmentOperationSuccess Failed: Wrong size")
+}
+
+func TestCreateTaskAttachmentOperationFailedVerify(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	attachment := makeTestOciTaskAttachment(7, "0000")
+	source := makeTestOciTaskAttachmentSource(test, "Test Content")
+
+	ociTaskServClientMock.On("UploadTaskAttachment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		_, _ = ioutil.ReadAll(args.Get(3).(io.Reader))
+	}).Return(&ocitaskclient.OciTaskServResponse{Attachment: &attachment}, nil).Once()
+	ociTaskServClientMock.On("GetTaskAttachment", mock.Anything, mock.Anything, mock.Anything).Return(&ocitaskclient.OciTaskServResponse{Attachment: &attachment}, nil).Once()
+
+	testData := map[string]interface{}{"task_id": 1001, "source": source}
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskAttachment().Schema, testData)
+
+	diags := ociTaskOperation.OciTaskAttachmentCreate(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 1, len(diags), "TestCreateTaskAttachmentOperationFailedVerify Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Failed to verify task attachment upload", diags[0].Summary, "TestCreateTaskAttachmentOperationFailedVerify Failed: Wrong Diagnostic Summary expected")
+	assert.Equal(test, "1001/7", rd.Id(), "TestCreateTaskAttachmentOperationFailedVerify Failed: Uploaded attachment should be kept in state")
+}
+
+func TestCreateTaskAttachmentOperationFailedSource(test *testing.T) {
+	ociTaskOperation := OciTaskOperation{}
+
+	testData := map[string]interface{}{"task_id": 1001, "source": filepath.Join(test.TempDir(), "missing.txt")}
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskAttachment().Schema, testData)
+
+	diags := ociTaskOperation.OciTaskAttachmentCreate(context.Background(), rd, &ocitaskclient.OciTaskServClientMock{})
+
+	assert.Equal(test, 1, len(diags), "TestCreateTaskAttachmentOperationFailedSource Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Failed to open task attachment source", diags[0].Summary, "TestCreateTaskAttachmentOperationFailedSource Failed: Wrong Diagnostic Summary expected")
+}
+
+func TestReadTaskAttachmentOperationGone(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	ociTaskServClientMock.On("GetTaskAttachment", mock.Anything, mock.Anything, mock.Anything).Return(nil, &ocitaskclient.OciTaskServError{Operation: "GetTaskAttachment", StatusCode: http.StatusNotFound}).Once()
+
+	rd := MakeOciTaskResource().ResourceOciTaskAttachment().Data(nil)
+	rd.SetId("1001/7")
+
+	diags := ociTaskOperation.OciTaskAttachmentRead(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 0, len(diags), "TestReadTaskAttachmentOperationGone Failed: No Diagnostics expected")
+	assert.Equal(test, "", rd.Id(), "TestReadTaskAttachmentOperationGone Failed: Resource should be removed from state")
+}
+
+func TestCustomizeDiffAttachmentSourceChanged(test *testing.T) {
+	source := makeTestOciTaskAttachmentSource(test, "Test Content")
+
+	resource := MakeOciTaskResource().ResourceOciTaskAttachment()
+	state := &terraform.InstanceState{ID: "1001/7", Attributes: map[string]string{
+		"id": "1001/7", "task_id": "1001", "source": source, "file_name": "notes.txt", "source_hash": "0000",
+	}}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"task_id": 1001, "source": source})
+
+	diff, err := resource.Diff(context.Background(), state, config, nil)
+
+	assert.NoError(test, err, "TestCustomizeDiffAttachmentSourceChanged Failed: No error expected")
+	assert.True(test, diff.RequiresNew(), "TestCustomizeDiffAttachmentSourceChanged Failed: Replacement expected")
+	assert.Equal(test, testOciTaskAttachmentHash, diff.Attributes["source_hash"].New, "TestCustomizeDiffAttachmentSourceChanged Failed: Wrong planned source hash")
+}
+
+func TestCustomizeDiffAttachmentSourceUnchanged(test *testing.T) {
+	source := makeTestOciTaskAttachmentSource(test, "Test Content")
+
+	resource := MakeOciTaskResource().ResourceOciTaskAttachment()
+	state := &terraform.InstanceState{ID: "1001/7", Attributes: map[string]string{
+		"id": "1001/7", "task_id": "1001", "source": source, "file_name": "notes.txt", "source_hash": testOciTaskAttachmentHash,
+	}}
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"task_id": 1001, "source": source})
+
+	diff, err := resource.Diff(context.Background(), state, config, nil)
+
+	assert.NoError(test, err, "TestCustomizeDiffAttachmentSourceUnchanged Failed: No error expected")
+	assert.True(test, diff == nil || diff.Empty(), "TestCustomizeDiffAttachmentSourceUnchanged Failed: No changes expected")
+}
+
+func TestCustomizeDiffAttachmentSourceMissing(test *testing.T) {
+	resource := MakeOciTaskResource().ResourceOciTaskAttachment()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"task_id": 1001, "source": filepath.Join(test.TempDir(), "missing.txt")})
+
+	_, err := resource.Diff(context.Background(), nil, config, nil)
+
+	assert.Error(test, err, "TestCustomizeDiffAttachmentSourceMissing Failed: Error expected")
+}
+
+func TestReadTaskAttachmentDataOperationDownload(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	attachment := makeTestOciTaskAttachment(7, testOciTaskAttachmentHash)
+	outputPath := filepath.Join(test.TempDir(), "downloaded.txt")
+
+	ociTaskServClientMock.On("GetTaskAttachment", mock.Anything, mock.Anything, mock.Anything).Return(&ocitaskclient.OciTaskServResponse{Attachment: &attachment}, nil).Once()
+	ociTaskServClientMock.On("DownloadTaskAttachment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		_, _ = io.WriteString(args.Get(3).(io.Writer), "Test Content")
+	}).Return(nil).Once()
+
+	testData := map[string]interface{}{"task_id": 1001, "attachment_id": 7, "output_path": outputPath}
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskDataSource().DataSourceOciTaskAttachment().Schema, testData)
+
+	diags := ociTaskOperation.OciTaskAttachmentDataRead(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	content, _ := os.ReadFile(outputPath)
+	assert.Equal(test, 0, len(diags), "TestReadTaskAttachmentDataOperationDownload Failed: No Diagnostics expected")
+	assert.Equal(test, "1001/7", rd.Id(), "TestReadTaskAttachmentDataOperationDownload Failed: Wrong data source Id")
+	assert.Equal(test, "notes.txt", rd.Get("file_name"), "TestReadTaskAttachmentDataOperationDownload Failed: Wrong file name")
+	assert.Equal(test, "Test Content", string(content), "TestReadTaskAttachmentDataOperationDownload Failed: Content should be downloaded to output path")
+}
+
+func TestReadTaskAttachmentDataOperationFailedVerify(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	attachment := makeTestOciTaskAttachment(7, testOciTaskAttachmentHash)
+	outputPath := filepath.Join(test.TempDir(), "downloaded.txt")
+
+	ociTaskServClientMock.On("GetTaskAttachment", mock.Anything, mock.Anything, mock.Anything).Return(&ocitaskclient.OciTaskServResponse{Attachment: &attachment}, nil).Once()
+	ociTaskServClientMock.On("DownloadTaskAttachment", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		_, _ = io.WriteString(args.Get(3).(io.Writer), "Truncated")
+	}).Return(nil).Once()
+
+	testData := map[string]interface{}{"task_id": 1001, "attachment_id": 7, "output_path": outputPath}
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskDataSource().DataSourceOciTaskAttachment().Schema, testData)
+
+	diags := ociTaskOperation.OciTaskAttachmentDataRead(context.Background(), rd, &ociTaskServClientMock)
+
+	_, err := os.Stat(outputPath)
+	assert.Equal(test, 1, len(diags), "TestReadTaskAttachmentDataOperationFailedVerify Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Failed to download task attachment", diags[0].Summary, "TestReadTaskAttachmentDataOperationFailedVerify Failed: Wrong Diagnostic Summary expected")
+	assert.True(test, os.IsNotExist(err), "TestReadTaskAttachmentDataOperationFailedVerify Failed: Unverified content shouldn't be written to output path")
+}
@@ -0,0 +1,107 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"fmt"
+	"ocitaskclient"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/**
+ * @brief Convert checklist blocks of Task resource into checklist items.
+ *			Identifiers of items in state are carried over, so reordered or edited items keep their identity in OCI Task System.
+ * @param rd Contains Task instance defined in Terraform scripts
+ * @return Checklist items in configured order, empty if all items are removed
+ */
+func expandOciTaskChecklist(rd *schema.ResourceData) []ocitaskclient.OciTaskChecklistItem {
+	oldValue, newValue := rd.GetChange("checklist")
+
+	previous := make([]ocitaskclient.OciTaskChecklistItem, 0)
+	for _, item := range oldValue.([]interface{}) {
+		if data, ok := item.(map[string]interface{}); ok {
+			id, text := int64(data["id"].(int)), data["text"].(string)
+			if id != 0 {
+				previous = append(previous, ocitaskclient.OciTaskChecklistItem{Id: &id, Text: &text})
+			}
+		}
+	}
+
+	checklist := make([]ocitaskclient.OciTaskChecklistItem, 0)
+	for _, item := range newValue.([]interface{}) {
+		data, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		text, _ := data["text"].(string)
+		done, _ := data["done"].(bool)
+		checklist = append(checklist, ocitaskclient.OciTaskChecklistItem{Text: &text, Done: &done})
+	}
+
+	ocitaskclient.MatchOciTaskChecklist(previous, checklist)
+
+	return checklist
+}
+
+/**
+ * @brief Set checklist of Task and its progress into resource data.
+ *			Checklist is only set if Task resource manages any items, so items added outside Terraform cause no drift.
+ * @param rd Contains Task instance defined in Terraform scripts
+ * @param ociTask Task read from OCI Task Service
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func readOciTaskChecklist(rd *schema.ResourceData, ociTask *ocitaskclient.OciTask) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	if len(rd.Get("checklist").([]interface{})) > 0 {
+		err := rd.Set("checklist", ocitaskclient.FlattenOciTaskChecklist(ociTask.Checklist))
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to set task checklist into resource data",
+				Detail:   err.Error(),
+			})
+		}
+	}
+
+	err := rd.Set("checklist_progress", ocitaskclient.OciTaskChecklistProgress(ociTask.Checklist))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to set task checklist progress into resource data",
+			Detail:   err.Error(),
+		})
+	}
+
+	return diags
+}
+
+/**
+ * @brief Plan progress of Task checklist, so changes to items show up in plan with their effect on progress
+ * @param ctx Context to Terraform Provider
+ * @param rdiff Planned changes of Task resource
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Instance of error if progress can't be planned
+ */
+func customizeOciTaskChecklist(ctx context.Context, rdiff *schema.ResourceDiff, m interface{}) error {
+	if !rdiff.HasChange("checklist") {
+		return nil
+	}
+
+	items, _ := rdiff.Get("checklist").([]interface{})
+	checklist := make([]ocitaskclient.OciTaskChecklistItem, 0, len(items))
+	for i := range items {
+		key := fmt.Sprintf("checklist.%d.done", i)
+		if !rdiff.NewValueKnown(key) {
+			return rdiff.SetNewComputed("checklist_progress")
+		}
+
+		done, _ := rdiff.Get(key).(bool)
+		checklist = append(checklist, ocitaskclient.OciTaskChecklistItem{Done: &done})
+	}
+
+	return rdiff.SetNew("checklist_progress", ocitaskclient.OciTaskChecklistProgress(checklist))
+}
@@ -0,0 +1,129 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"ocitaskclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func makeTestOciTaskChecklist(ids []int64, texts []string, done []bool) []ocitaskclient.OciTaskChecklistItem {
+	checklist := make([]ocitaskclient.OciTaskChecklistItem, 0, len(ids))
+	for i := range ids {
+		checklist = append(checklist, ocitaskclient.OciTaskChecklistItem{Id: &ids[i], Text: &texts[i], Done: &done[i]})
+	}
+
+	return checklist
+}
+
+func TestCreateTaskOperationWithChecklist(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	taskId := int64(1001)
+	task := makeTestOciTask(taskId)
+	task.Checklist = makeTestOciTaskChecklist([]int64{11, 12}, []string{"Write", "Review"}, []bool{true, false})
+
+	ociTaskServClientMock.On("CreateTask", mock.Anything, mock.MatchedBy(func(ociRequest *ocitaskclient.OciTaskServRequest) bool {
+		checklist := *ociRequest.Checklist
+		return len(checklist) == 2 && checklist[0].Id == nil && *checklist[0].Text == "Write" && *checklist[0].Done && !*checklist[1].Done
+	})).Return(&ocitaskclient.OciTaskServResponse{TaskId: &taskId}, nil).Once()
+	ociTaskServClientMock.On("GetTask", mock.Anything, &taskId).Return(&ocitaskclient.OciTaskServResponse{Task: &task}, nil).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTask().Schema, map[string]interface{}{
+		"items": []interface{}{map[string]interface{}{"title": "Test Task 1"}},
+		"checklist": []interface{}{
+			map[string]interface{}{"text": "Write", "done": true},
+			map[string]interface{}{"text": "Review"},
+		},
+	})
+
+	diags := ociTaskOperation.OciTaskCreate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestCreateTaskOperationWithChecklist Failed: No Diagnostics expected")
+	assert.Equal(test, 11, rd.Get("checklist.0.id"), "TestCreateTaskOperationWithChecklist Failed: Checklist item Id expected")
+	assert.Equal(test, 12, rd.Get("checklist.1.id"), "TestCreateTaskOperationWithChecklist Failed: Checklist item Id expected")
+	assert.Equal(test, 50, rd.Get("checklist_progress"), "TestCreateTaskOperationWithChecklist Failed: Wrong checklist progress")
+}
+
+func TestUpdateTaskOperationReordersChecklist(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	taskId := int64(1001)
+	task := makeTestOciTask(taskId)
+	task.Checklist = makeTestOciTaskChecklist([]int64{12, 11, 13}, []string{"Review", "Write", "Merge"}, []bool{true, true, false})
+
+	ociTaskServClientMock.On("UpdateTask", mock.Anything, &taskId, mock.MatchedBy(func(ociRequest *ocitaskclient.OciTaskServRequest) bool {
+		checklist := *ociRequest.Checklist
+		return len(checklist) == 3 && *checklist[0].Id == 12 && *checklist[1].Id == 11 && checklist[2].Id == nil
+	})).Return(&ocitaskclient.OciTaskServResponse{TaskId: &taskId}, nil).Once()
+	ociTaskServClientMock.On("GetTask", mock.Anything, &taskId).Return(&ocitaskclient.OciTaskServResponse{Task: &task}, nil).Once()
+
+	resource := MakeOciTaskResource().ResourceOciTask()
+	state := makeTestOciTaskState("todo", "false")
+	state.Attributes["checklist.#"] = "2"
+	state.Attributes["checklist.0.id"] = "11"
+	state.Attributes["checklist.0.text"] = "Write"
+	state.Attributes["checklist.0.done"] = "true"
+	state.Attributes["checklist.1.id"] = "12"
+	state.Attributes["checklist.1.text"] = "Review"
+	state.Attributes["checklist.1.done"] = "false"
+	state.Attributes["checklist_progress"] = "50"
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"items": []interface{}{map[string]interface{}{"title": "Test Task 1", "status": "todo"}},
+		"checklist": []interface{}{
+			map[string]interface{}{"text": "Review", "done": true},
+			map[string]interface{}{"text": "Write", "done": true},
+			map[string]interface{}{"text": "Merge"},
+		},
+	})
+	diff, err := resource.Diff(context.Background(), state, config, &ociTaskServClientMock)
+
+	assert.NoError(test, err, "TestUpdateTaskOperationReordersChecklist Failed: No error expected")
+	assert.Equal(test, "66", diff.Attributes["checklist_progress"].New, "TestUpdateTaskOperationReordersChecklist Failed: Progress expected to be planned")
+
+	rd, _ := schema.InternalMap(resource.Schema).Data(state, diff)
+
+	diags := ociTaskOperation.OciTaskUpdate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestUpdateTaskOperationReordersChecklist Failed: No Diagnostics expected")
+	assert.Equal(test, 12, rd.Get("checklist.0.id"), "TestUpdateTaskOperationReordersChecklist Failed: Moved item expected to keep Id")
+	assert.Equal(test, 13, rd.Get("checklist.2.id"), "TestUpdateTaskOperationReordersChecklist Failed: Added item expected to get Id")
+	assert.Equal(test, 66, rd.Get("checklist_progress"), "TestUpdateTaskOperationReordersChecklist Failed: Wrong checklist progress")
+}
+
+func TestUpdateTaskOperationKeepsUnmanagedChecklist(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	taskId := int64(1001)
+	task := makeTestOciTask(taskId)
+	task.Checklist = makeTestOciTaskChecklist([]int64{11}, []string{"Added in UI"}, []bool{true})
+
+	ociTaskServClientMock.On("UpdateTask", mock.Anything, &taskId, mock.MatchedBy(func(ociRequest *ocitaskclient.OciTaskServRequest) bool {
+		return ociRequest.Checklist == nil
+	})).Return(&ocitaskclient.OciTaskServResponse{TaskId: &taskId}, nil).Once()
+	ociTaskServClientMock.On("GetTask", mock.Anything, &taskId).Return(&ocitaskclient.OciTaskServResponse{Task: &task}, nil).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTask().Schema, map[string]interface{}{
+		"items": []interface{}{map[string]interface{}{"title": "Test Task 1"}},
+	})
+	rd.SetId("1001")
+
+	diags := ociTaskOperation.OciTaskUpdate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestUpdateTaskOperationKeepsUnmanagedChecklist Failed: No Diagnostics expected")
+	assert.Equal(test, 0, len(rd.Get("checklist").([]interface{})), "TestUpdateTaskOperationKeepsUnmanagedChecklist Failed: Unmanaged checklist expected to stay out of state")
+	assert.Equal(test, 100, rd.Get("checklist_progress"), "TestUpdateTaskOperationKeepsUnmanagedChecklist Failed: Progress expected from all items")
+}
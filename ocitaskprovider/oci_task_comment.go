@@ -0,0 +1,306 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"fmt"
+	"ocitaskclient"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/**
+ * @brief Add comment to Task in OCI Task System
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains comment defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskCommentCreate(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskCommentCreate", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	taskId := int64(rd.Get("task_id").(int))
+	body := rd.Get("body").(string)
+
+	ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+	ociResponse, err := ociClient.CreateTaskComment(ctx, &taskId, &ocitaskclient.OciTaskComment{Body: &body})
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create task comment",
+			Detail:   err.Error(),
+		})
+	} else {
+		if ociResponse.Err != nil {
+			ociErr, _ := ociResponse.Err.Serialize()
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to create task comment",
+				Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+			})
+		} else if ociResponse.Comment == nil || ociResponse.Comment.Id == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to create task comment",
+				Detail:   "OCI Task Service returned no comment Id" + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+			})
+		} else {
+			rd.SetId(formatOciTaskCommentId(taskId, *ociResponse.Comment.Id))
+			diags = append(diags, ociTaskOperation.OciTaskCommentRead(ctx, rd, m)...)
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Read comment on Task in OCI Task System. Removes comment from state if it no longer exists.
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains comment Identifier in form <task_id>/<comment_id>
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskCommentRead(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskCommentRead", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	taskId, commentId, err := parseOciTaskCommentId(rd.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to get Id from resource data",
+			Detail:   err.Error(),
+		})
+	} else {
+		ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+		ociResponse, err := ociClient.GetTaskComment(ctx, &taskId, &commentId)
+		if ocitaskclient.IsOciTaskNotFound(err) {
+			rd.SetId("")
+		} else if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read task comment",
+				Detail:   err.Error(),
+			})
+		} else {
+			if ociResponse.Err != nil {
+				ociErr, _ := ociResponse.Err.Serialize()
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to read task comment",
+					Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else if ociResponse.Comment == nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to read comment",
+					Detail:   "OCI Task Service returned no comment" + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else {
+				comment := ociResponse.Comment.Flatten()
+				comment["task_id"] = int(taskId)
+				delete(comment, "id")
+
+				for key, value := range comment {
+					err := rd.Set(key, value)
+					if err != nil {
+						diags = append(diags, diag.Diagnostic{
+							Severity: diag.Error,
+							Summary:  "Failed to set task comment into resource data",
+							Detail:   err.Error(),
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Edit comment on Task in OCI Task System
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains comment defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskCommentUpdate(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskCommentUpdate", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	taskId, commentId, err := parseOciTaskCommentId(rd.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to get Id from resource data",
+			Detail:   err.Error(),
+		})
+	} else {
+		body := rd.Get("body").(string)
+
+		ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+		ociResponse, err := ociClient.UpdateTaskComment(ctx, &taskId, &commentId, &ocitaskclient.OciTaskComment{Body: &body})
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to update task comment",
+				Detail:   err.Error(),
+			})
+		} else {
+			if ociResponse.Err != nil {
+				ociErr, _ := ociResponse.Err.Serialize()
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to update task comment",
+					Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else {
+				diags = append(diags, ociTaskOperation.OciTaskCommentRead(ctx, rd, m)...)
+			}
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Delete comment on Task in OCI Task System
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains comment Identifier in form <task_id>/<comment_id>
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskCommentDelete(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskCommentDelete", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	taskId, commentId, err := parseOciTaskCommentId(rd.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to get Id from resource data",
+			Detail:   err.Error(),
+		})
+	} else {
+		ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+		ociResponse, err := ociClient.DeleteTaskComment(ctx, &taskId, &commentId)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to delete task comment",
+				Detail:   err.Error(),
+			})
+		} else {
+			if ociResponse.Err != nil {
+				ociErr, _ := ociResponse.Err.Serialize()
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to delete task comment",
+					Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else {
+				rd.SetId("")
+			}
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Read comment thread of Task in OCI Task System for comments data source
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains Task Identifier defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskCommentsRead(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	taskId := int64(rd.Get("task_id").(int))
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskCommentsRead", strconv.FormatInt(taskId, 10))
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+	ociResponse, err := ociClient.ListTaskComments(ctx, &taskId)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to list task comments",
+			Detail:   err.Error(),
+		})
+	} else if ociResponse.Err != nil {
+		ociErr, _ := ociResponse.Err.Serialize()
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to list task comments",
+			Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+		})
+	} else {
+		comments := make([]interface{}, 0, len(ociResponse.Comments))
+		for i := range ociResponse.Comments {
+			comments = append(comments, ociResponse.Comments[i].Flatten())
+		}
+
+		err := rd.Set("comments", comments)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to set task comments into resource data",
+				Detail:   err.Error(),
+			})
+		} else {
+			rd.SetId(strconv.FormatInt(taskId, 10))
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Build Identifier of comment resource
+ * @param taskId Identifier of Task
+ * @param commentId Identifier of comment
+ * @return Identifier in form <task_id>/<comment_id>
+ */
+func formatOciTaskCommentId(taskId int64, commentId int64) string {
+	return fmt.Sprintf("%d/%d", taskId, commentId)
+}
+
+/**
+ * @brief Split Identifier of comment resource
+ * @param id Identifier in form <task_id>/<comment_id>
+ * @return Identifier of Task
+ * @return Identifier of comment
+ * @return Instance of error if Identifier is malformed
+ */
+func parseOciTaskCommentId(id string) (int64, int64, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) == 2 {
+		taskId, taskErr := strconv.ParseInt(parts[0], 10, 64)
+		commentId, commentErr := strconv.ParseInt(parts[1], 10, 64)
+		if taskErr == nil && commentErr == nil {
+			return taskId, commentId, nil
+		}
+	}
+
+	return 0, 0, fmt.Errorf("Invalid comment Id %q - expected <task_id>/<comment_id>", id)
+}
@@ -0,0 +1,145 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"ocitaskclient"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func makeTestOciTaskComment(id int64, body string) ocitaskclient.OciTaskComment {
+	author := "jdoe@example.com"
+	timeCreated := time.Date(2023, 2, 11, 10, 30, 0, 0, time.UTC).UnixMilli() + id
+
+	return ocitaskclient.OciTaskComment{Id: &id, Author: &author, Body: &body, TimeCreated: &timeCreated}
+}
+
+func TestCreateTaskCommentOperationSuccess(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	comment := makeTestOciTaskComment(5, "Rolled back, see incident")
+
+	ociTaskServClientMock.On("CreateTaskComment", mock.Anything, mock.MatchedBy(func(taskId *int64) bool { return *taskId == 1001 }), mock.MatchedBy(func(request *ocitaskclient.OciTaskComment) bool {
+		return *request.Body == "Rolled back, see incident"
+	})).Return(&ocitaskclient.OciTaskServResponse{Comment: &comment}, nil).Once()
+	ociTaskServClientMock.On("GetTaskComment", mock.Anything, mock.Anything, comment.Id).Return(&ocitaskclient.OciTaskServResponse{Comment: &comment}, nil).Once()
+
+	testData := map[string]interface{}{"task_id": 1001, "body": "Rolled back, see incident"}
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskComment().Schema, testData)
+
+	diags := ociTaskOperation.OciTaskCommentCreate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestCreateTaskCommentOperationSuccess Failed: No Diagnostics expected")
+	assert.Equal(test, "1001/5", rd.Id(), "TestCreateTaskCommentOperationSuccess Failed: Wrong resource Id")
+	assert.Equal(test, "jdoe@example.com", rd.Get("author"), "TestCreateTaskCommentOperationSuccess Failed: Wrong author")
+	assert.Equal(test, "2023-02-11T10:30:00Z", rd.Get("time_created"), "TestCreateTaskCommentOperationSuccess Failed: Wrong time created")
+}
+
+func TestReadTaskCommentOperationImport(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	comment := makeTestOciTaskComment(5, "Imported")
+	ociTaskServClientMock.On("GetTaskComment", mock.Anything, mock.Anything, mock.Anything).Return(&ocitaskclient.OciTaskServResponse{Comment: &comment}, nil).Once()
+
+	rd := MakeOciTaskResource().ResourceOciTaskComment().Data(nil)
+	rd.SetId("1001/5")
+
+	diags := ociTaskOperation.OciTaskCommentRead(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 0, len(diags), "TestReadTaskCommentOperationImport Failed: No Diagnostics expected")
+	assert.Equal(test, 1001, rd.Get("task_id"), "TestReadTaskCommentOperationImport Failed: Task Id expected from resource Id")
+	assert.Equal(test, "Imported", rd.Get("body"), "TestReadTaskCommentOperationImport Failed: Wrong body")
+}
+
+func TestReadTaskCommentOperationGone(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	ociTaskServClientMock.On("GetTaskComment", mock.Anything, mock.Anything, mock.Anything).Return(nil, &ocitaskclient.OciTaskServError{Operation: "GetTaskComment", StatusCode: http.StatusNotFound}).Once()
+
+	rd := MakeOciTaskResource().ResourceOciTaskComment().Data(nil)
+	rd.SetId("1001/5")
+
+	diags := ociTaskOperation.OciTaskCommentRead(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 0, len(diags), "TestReadTaskCommentOperationGone Failed: No Diagnostics expected")
+	assert.Equal(test, "", rd.Id(), "TestReadTaskCommentOperationGone Failed: Comment expected to be removed from state")
+}
+
+func TestReadTaskCommentOperationFailedInvalidId(test *testing.T) {
+	ociTaskOperation := OciTaskOperation{}
+
+	rd := MakeOciTaskResource().ResourceOciTaskComment().Data(nil)
+	rd.SetId("5")
+
+	diags := ociTaskOperation.OciTaskCommentRead(context.Background(), rd, &ocitaskclient.OciTaskServClientMock{})
+
+	assert.Equal(test, 1, len(diags), "TestReadTaskCommentOperationFailedInvalidId Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Failed to get Id from resource data", diags[0].Summary, "TestReadTaskCommentOperationFailedInvalidId Failed: Wrong Diagnostic Summary expected")
+}
+
+func TestUpdateTaskCommentOperationSuccess(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	comment := makeTestOciTaskComment(5, "Edited")
+	ociTaskServClientMock.On("UpdateTaskComment", mock.Anything, mock.Anything, mock.Anything, mock.MatchedBy(func(request *ocitaskclient.OciTaskComment) bool {
+		return *request.Body == "Edited"
+	})).Return(&ocitaskclient.OciTaskServResponse{Comment: &comment}, nil).Once()
+	ociTaskServClientMock.On("GetTaskComment", mock.Anything, mock.Anything, mock.Anything).Return(&ocitaskclient.OciTaskServResponse{Comment: &comment}, nil).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskComment().Schema, map[string]interface{}{"task_id": 1001, "body": "Edited"})
+	rd.SetId("1001/5")
+
+	diags := ociTaskOperation.OciTaskCommentUpdate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestUpdateTaskCommentOperationSuccess Failed: No Diagnostics expected")
+}
+
+func TestDeleteTaskCommentOperationFailed(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	ociTaskServClientMock.On("DeleteTaskComment", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("Delete Failed")).Once()
+
+	rd := MakeOciTaskResource().ResourceOciTaskComment().Data(nil)
+	rd.SetId("1001/5")
+
+	diags := ociTaskOperation.OciTaskCommentDelete(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 1, len(diags), "TestDeleteTaskCommentOperationFailed Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Failed to delete task comment", diags[0].Summary, "TestDeleteTaskCommentOperationFailed Failed: Wrong Diagnostic Summary expected")
+	assert.Equal(test, "1001/5", rd.Id(), "TestDeleteTaskCommentOperationFailed Failed: Resource Id expected to be kept")
+}
+
+func TestReadTaskCommentsOperationSuccess(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	comments := []ocitaskclient.OciTaskComment{makeTestOciTaskComment(1, "First"), makeTestOciTaskComment(2, "Second")}
+	ociTaskServClientMock.On("ListTaskComments", mock.Anything, mock.MatchedBy(func(taskId *int64) bool { return *taskId == 1001 })).Return(&ocitaskclient.OciTaskServResponse{Comments: comments}, nil).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskDataSource().DataSourceOciTaskComments().Schema, map[string]interface{}{"task_id": 1001})
+
+	diags := ociTaskOperation.OciTaskCommentsRead(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestReadTaskCommentsOperationSuccess Failed: No Diagnostics expected")
+	assert.Equal(test, "1001", rd.Id(), "TestReadTaskCommentsOperationSuccess Failed: Wrong data source Id")
+	assert.Equal(test, 2, rd.Get("comments.#"), "TestReadTaskCommentsOperationSuccess Failed: Two comments expected")
+	assert.Equal(test, "Second", rd.Get("comments.1.body"), "TestReadTaskCommentsOperationSuccess Failed: Wrong body of second comment")
+	assert.Equal(test, "jdoe@example.com", rd.Get("comments.0.author"), "TestReadTaskCommentsOperationSuccess Failed: Wrong author")
+}
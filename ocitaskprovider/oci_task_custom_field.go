@@ -0,0 +1,376 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ocitaskclient"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/**
+ * @brief Define custom field on Project in OCI Task System
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains custom field defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskCustomFieldCreate(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskCustomFieldCreate", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	projectId := int64(rd.Get("project_id").(int))
+
+	ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+	ociResponse, err := ociClient.CreateCustomField(ctx, &projectId, expandOciTaskCustomField(rd))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create custom field",
+			Detail:   err.Error(),
+		})
+	} else {
+		if ociResponse.Err != nil {
+			ociErr, _ := ociResponse.Err.Serialize()
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to create custom field",
+				Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+			})
+		} else if ociResponse.CustomField == nil || ociResponse.CustomField.Id == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to create custom field",
+				Detail:   "OCI Task Service returned no custom field Id" + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+			})
+		} else {
+			rd.SetId(formatOciTaskCustomFieldId(projectId, *ociResponse.CustomField.Id))
+			diags = append(diags, ociTaskOperation.OciTaskCustomFieldRead(ctx, rd, m)...)
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Read custom field of Project in OCI Task System. Removes custom field from state if it no longer exists.
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains custom field Identifier in form <project_id>/<custom_field_id>
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskCustomFieldRead(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskCustomFieldRead", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	projectId, customFieldId, err := parseOciTaskCustomFieldId(rd.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to get Id from resource data",
+			Detail:   err.Error(),
+		})
+	} else {
+		ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+		ociResponse, err := ociClient.GetCustomField(ctx, &projectId, &customFieldId)
+		if ocitaskclient.IsOciTaskNotFound(err) {
+			rd.SetId("")
+		} else if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read custom field",
+				Detail:   err.Error(),
+			})
+		} else {
+			if ociResponse.Err != nil {
+				ociErr, _ := ociResponse.Err.Serialize()
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to read custom field",
+					Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else if ociResponse.CustomField == nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to read custom field",
+					Detail:   "OCI Task Service returned no custom field" + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else {
+				customField := ociResponse.CustomField.Flatten()
+				customField["project_id"] = int(projectId)
+
+				for key, value := range customField {
+					err := rd.Set(key, value)
+					if err != nil {
+						diags = append(diags, diag.Diagnostic{
+							Severity: diag.Error,
+							Summary:  "Failed to set custom field into resource data",
+							Detail:   err.Error(),
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Update custom field of Project in OCI Task System
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains custom field defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskCustomFieldUpdate(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskCustomFieldUpdate", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	projectId, customFieldId, err := parseOciTaskCustomFieldId(rd.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to get Id from resource data",
+			Detail:   err.Error(),
+		})
+	} else {
+		ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+		ociResponse, err := ociClient.UpdateCustomField(ctx, &projectId, &customFieldId, expandOciTaskCustomField(rd))
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to update custom field",
+				Detail:   err.Error(),
+			})
+		} else {
+			if ociResponse.Err != nil {
+				ociErr, _ := ociResponse.Err.Serialize()
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to update custom field",
+					Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else {
+				diags = append(diags, ociTaskOperation.OciTaskCustomFieldRead(ctx, rd, m)...)
+			}
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Delete custom field of Project in OCI Task System
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains custom field Identifier in form <project_id>/<custom_field_id>
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskCustomFieldDelete(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskCustomFieldDelete", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	projectId, customFieldId, err := parseOciTaskCustomFieldId(rd.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to get Id from resource data",
+			Detail:   err.Error(),
+		})
+	} else {
+		ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+		ociResponse, err := ociClient.DeleteCustomField(ctx, &projectId, &customFieldId)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to delete custom field",
+				Detail:   err.Error(),
+			})
+		} else {
+			if ociResponse.Err != nil {
+				ociErr, _ := ociResponse.Err.Serialize()
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to delete custom field",
+					Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else {
+				rd.SetId("")
+			}
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Convert custom field resource data into OciTaskCustomField instance
+ * @param rd Contains custom field defined in Terraform scripts
+ * @return Instance of OciTaskCustomField
+ */
+func expandOciTaskCustomField(rd *schema.ResourceData) *ocitaskclient.OciTaskCustomField {
+	name := rd.Get("name").(string)
+	fieldType := rd.Get("type").(string)
+	required := rd.Get("required").(bool)
+	description := rd.Get("description").(string)
+
+	return &ocitaskclient.OciTaskCustomField{
+		Name:        &name,
+		Type:        &fieldType,
+		Options:     ocitaskclient.ExpandOciTaskStringList(rd.Get("options")),
+		Required:    &required,
+		Description: &description,
+	}
+}
+
+/**
+ * @brief Check custom field at plan time: enum fields need options, other types take none
+ * @param ctx Context to Terraform Provider
+ * @param rdiff Planned changes of custom field resource
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Instance of error if options don't match type
+ */
+func customizeOciTaskCustomField(ctx context.Context, rdiff *schema.ResourceDiff, m interface{}) error {
+	if !rdiff.NewValueKnown("type") || !rdiff.NewValueKnown("options") {
+		return nil
+	}
+
+	fieldType, _ := rdiff.Get("type").(string)
+	options := ocitaskclient.ExpandOciTaskStringList(rdiff.Get("options"))
+	if fieldType == ocitaskclient.OciTaskCustomFieldEnum && len(options) == 0 {
+		return errors.New("options are required for custom field of type enum")
+	}
+	if fieldType != ocitaskclient.OciTaskCustomFieldEnum && len(options) > 0 {
+		return fmt.Errorf("options are only allowed for custom field of type enum, not %s", fieldType)
+	}
+
+	return nil
+}
+
+/**
+ * @brief Check custom field values of Task at plan time against custom fields defined on its Project.
+ *			Only checked if values or Project change and both are known. Custom fields may be defined in the same plan,
+ *			so only values of custom fields already defined are checked here, the rest is checked at apply by checkOciTaskCustomFields.
+ * @param ctx Context to Terraform Provider
+ * @param rdiff Planned changes of Task resource
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Instance of error if values don't match definitions, or definitions can't be read
+ */
+func customizeOciTaskCustomFields(ctx context.Context, rdiff *schema.ResourceDiff, m interface{}) error {
+	ociClient, ok := m.(ocitaskclient.OciTaskServClientInterface)
+	if !ok {
+		return nil
+	}
+
+	if !rdiff.HasChange("items.0.custom_fields") && !rdiff.HasChange("items.0.project_id") {
+		return nil
+	}
+	if !rdiff.NewValueKnown("items.0.custom_fields") || !rdiff.NewValueKnown("items.0.project_id") {
+		return nil
+	}
+
+	values := ocitaskclient.ExpandOciTaskStringMap(rdiff.Get("items.0.custom_fields"))
+	projectId, _ := rdiff.Get("items.0.project_id").(int)
+
+	return validateOciTaskCustomFieldValues(ctx, ociClient, int64(projectId), values, false)
+}
+
+/**
+ * @brief Check custom field values of Task at apply time, once custom fields planned together with Task exist.
+ *			Only checked if values or Project change.
+ * @param ctx Context to Terraform Provider
+ * @param rd Resource data of Task resource
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Instance of error if values don't match definitions, or definitions can't be read
+ */
+func checkOciTaskCustomFields(ctx context.Context, rd *schema.ResourceData, m interface{}) error {
+	if !rd.HasChange("items.0.custom_fields") && !rd.HasChange("items.0.project_id") {
+		return nil
+	}
+
+	values := ocitaskclient.ExpandOciTaskStringMap(rd.Get("items.0.custom_fields"))
+	projectId, _ := rd.Get("items.0.project_id").(int)
+	ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+
+	return validateOciTaskCustomFieldValues(ctx, ociClient, int64(projectId), values, true)
+}
+
+/**
+ * @brief Check custom field values of Task against custom fields defined on Project
+ * @param ctx Context to Terraform Provider
+ * @param ociClient Client to OCI Task Service
+ * @param projectId Identifier of Project of Task, 0 if Task belongs to no Project
+ * @param values Values of custom fields by name
+ * @param complete False to accept values without definition and missing required values, as their definitions may still be pending
+ * @return Instance of error if values don't match definitions, or definitions can't be read
+ */
+func validateOciTaskCustomFieldValues(ctx context.Context, ociClient ocitaskclient.OciTaskServClientInterface, projectId int64, values map[string]string, complete bool) error {
+	if projectId == 0 {
+		if len(values) > 0 {
+			return errors.New("custom_fields require project_id - custom fields are defined per project")
+		}
+		return nil
+	}
+
+	ociResponse, err := ociClient.ListCustomFields(ctx, &projectId)
+	if err == nil && ociResponse.Err != nil {
+		ociErr, _ := ociResponse.Err.Serialize()
+		err = fmt.Errorf("%s%s", ociErr, ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId))
+	}
+	if err != nil {
+		return fmt.Errorf("Failed to read custom fields of project %d: %s", projectId, err.Error())
+	}
+
+	if complete {
+		return ocitaskclient.ValidateOciTaskCustomFields(ociResponse.CustomFields, values)
+	}
+
+	return ocitaskclient.ValidateOciTaskDefinedCustomFields(ociResponse.CustomFields, values)
+}
+
+/**
+ * @brief Build Identifier of custom field resource
+ * @param projectId Identifier of Project
+ * @param customFieldId Identifier of custom field
+ * @return Identifier in form <project_id>/<custom_field_id>
+ */
+func formatOciTaskCustomFieldId(projectId int64, customFieldId int64) string {
+	return fmt.Sprintf("%d/%d", projectId, customFieldId)
+}
+
+/**
+ * @brief Split Identifier of custom field resource
+ * @param id Identifier in form <project_id>/<custom_field_id>
+ * @return Identifier of Project
+ * @return Identifier of custom field
+ * @return Instance of error if Identifier is malformed
+ */
+func parseOciTaskCustomFieldId(id string) (int64, int64, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) == 2 {
+		projectId, projectErr := strconv.ParseInt(parts[0], 10, 64)
+		customFieldId, customFieldErr := strconv.ParseInt(parts[1], 10, 64)
+		if projectErr == nil && customFieldErr == nil {
+			return projectId, customFieldId, nil
+		}
+	}
+
+	return 0, 0, fmt.Errorf("Invalid custom field Id %q - expected <project_id>/<custom_field_id>", id)
+}
@@ -0,0 +1,228 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"net/http"
+	"ocitaskclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func makeTestOciTaskCustomFieldsResponse() *ocitaskclient.OciTaskServResponse {
+	costCenter, environment := "cost_center", "environment"
+	stringType, enumType := ocitaskclient.OciTaskCustomFieldString, ocitaskclient.OciTaskCustomFieldEnum
+	required := true
+
+	return &ocitaskclient.OciTaskServResponse{CustomFields: []ocitaskclient.OciTaskCustomField{
+		{Name: &costCenter, Type: &stringType, Required: &required},
+		{Name: &environment, Type: &enumType, Options: []string{"dev", "prod"}},
+	}}
+}
+
+func TestCreateCustomFieldOperationSuccess(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	projectId, customFieldId := int64(42), int64(7)
+	name, fieldType := "environment", ocitaskclient.OciTaskCustomFieldEnum
+	customField := ocitaskclient.OciTaskCustomField{Id: &customFieldId, ProjectId: &projectId, Name: &name, Type: &fieldType, Options: []string{"dev", "prod"}}
+
+	ociTaskServClientMock.On("CreateCustomField", mock.Anything, &projectId, mock.MatchedBy(func(customField *ocitaskclient.OciTaskCustomField) bool {
+		return *customField.Name == "environment" && *customField.Type == "enum" && len(customField.Options) == 2
+	})).Return(&ocitaskclient.OciTaskServResponse{CustomField: &customField}, nil).Once()
+	ociTaskServClientMock.On("GetCustomField", mock.Anything, &projectId, &customFieldId).Return(&ocitaskclient.OciTaskServResponse{CustomField: &customField}, nil).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskCustomField().Schema, map[string]interface{}{
+		"project_id": 42,
+		"name":       "environment",
+		"type":       "enum",
+		"options":    []interface{}{"dev", "prod"},
+	})
+
+	diags := ociTaskOperation.OciTaskCustomFieldCreate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestCreateCustomFieldOperationSuccess Failed: No Diagnostics expected")
+	assert.Equal(test, "42/7", rd.Id(), "TestCreateCustomFieldOperationSuccess Failed: Wrong Custom Field Id")
+	assert.Equal(test, "prod", rd.Get("options.1"), "TestCreateCustomFieldOperationSuccess Failed: Wrong Custom Field Options")
+}
+
+func TestReadCustomFieldOperationFailedBadId(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	rd := MakeOciTaskResource().ResourceOciTaskCustomField().Data(nil)
+	rd.SetId("environment")
+
+	diags := ociTaskOperation.OciTaskCustomFieldRead(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 1, len(diags), "TestReadCustomFieldOperationFailedBadId Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Failed to get Id from resource data", diags[0].Summary, "TestReadCustomFieldOperationFailedBadId Failed: Wrong Diagnostic Summary expected")
+}
+
+func TestReadCustomFieldOperationGone(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	ociTaskServClientMock.On("GetCustomField", mock.Anything, mock.Anything, mock.Anything).Return(nil, &ocitaskclient.OciTaskServError{Operation: "GetCustomField", StatusCode: http.StatusNotFound}).Once()
+
+	rd := MakeOciTaskResource().ResourceOciTaskCustomField().Data(nil)
+	rd.SetId("42/7")
+
+	diags := ociTaskOperation.OciTaskCustomFieldRead(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 0, len(diags), "TestReadCustomFieldOperationGone Failed: No Diagnostics expected")
+	assert.Equal(test, "", rd.Id(), "TestReadCustomFieldOperationGone Failed: Custom Field expected to be removed from state")
+}
+
+func TestCustomizeDiffCustomFieldOptions(test *testing.T) {
+	resource := MakeOciTaskResource().ResourceOciTaskCustomField()
+
+	_, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"project_id": 42,
+		"name":       "environment",
+		"type":       "enum",
+	}), nil)
+
+	assert.Error(test, err, "TestCustomizeDiffCustomFieldOptions Failed: Error expected for enum without options")
+
+	_, err = resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{
+		"project_id": 42,
+		"name":       "cost_center",
+		"type":       "string",
+		"options":    []interface{}{"CC-42"},
+	}), nil)
+
+	assert.Error(test, err, "TestCustomizeDiffCustomFieldOptions Failed: Error expected for options on string field")
+}
+
+func TestCustomizeDiffTaskCustomFields(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+
+	projectId := int64(42)
+	ociTaskServClientMock.On("ListCustomFields", mock.Anything, &projectId).Return(makeTestOciTaskCustomFieldsResponse(), nil).Twice()
+
+	resource := MakeOciTaskResource().ResourceOciTask()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"items": []interface{}{map[string]interface{}{
+			"title":         "Test Task 1",
+			"status":        "todo",
+			"project_id":    42,
+			"custom_fields": map[string]interface{}{"cost_center": "CC-42", "environment": "prod"},
+		}},
+	})
+
+	_, err := resource.Diff(context.Background(), makeTestOciTaskState("todo", "false"), config, &ociTaskServClientMock)
+
+	assert.NoError(test, err, "TestCustomizeDiffTaskCustomFields Failed: No error expected for valid values")
+
+	config = terraform.NewResourceConfigRaw(map[string]interface{}{
+		"items": []interface{}{map[string]interface{}{
+			"title":         "Test Task 1",
+			"status":        "todo",
+			"project_id":    42,
+			"custom_fields": map[string]interface{}{"environment": "staging"},
+		}},
+	})
+
+	_, err = resource.Diff(context.Background(), makeTestOciTaskState("todo", "false"), config, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Error(test, err, "TestCustomizeDiffTaskCustomFields Failed: Error expected for invalid values")
+	assert.Contains(test, err.Error(), `"staging"`, "TestCustomizeDiffTaskCustomFields Failed: Invalid value expected in error")
+	assert.NotContains(test, err.Error(), `"cost_center" is required`, "TestCustomizeDiffTaskCustomFields Failed: Missing required field only expected to be reported at apply")
+}
+
+func TestCustomizeDiffTaskCustomFieldsDefinedInSamePlan(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+
+	// ocitask_custom_field "environment" is planned together with the Task, so it isn't defined on the Project yet
+	projectId := int64(42)
+	ociTaskServClientMock.On("ListCustomFields", mock.Anything, &projectId).Return(&ocitaskclient.OciTaskServResponse{}, nil)
+
+	resource := MakeOciTaskResource().ResourceOciTask()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"items": []interface{}{map[string]interface{}{
+			"title":         "Test Task 1",
+			"status":        "todo",
+			"project_id":    42,
+			"custom_fields": map[string]interface{}{"environment": "prod"},
+		}},
+	})
+
+	_, err := resource.Diff(context.Background(), nil, config, &ociTaskServClientMock)
+
+	assert.NoError(test, err, "TestCustomizeDiffTaskCustomFieldsDefinedInSamePlan Failed: Values of pending custom fields expected to pass plan")
+}
+
+func TestCreateTaskOperationFailedUndefinedCustomField(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	projectId := int64(42)
+	ociTaskServClientMock.On("ListCustomFields", mock.Anything, &projectId).Return(makeTestOciTaskCustomFieldsResponse(), nil).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTask().Schema, map[string]interface{}{
+		"items": []interface{}{map[string]interface{}{
+			"title":         "Test Task 1",
+			"status":        "todo",
+			"project_id":    42,
+			"custom_fields": map[string]interface{}{"cost_center": "CC-42", "ticket_url": "https://example.com/1"},
+		}},
+	})
+
+	diags := ociTaskOperation.OciTaskCreate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertNotCalled(test, "CreateTask", mock.Anything, mock.Anything)
+
+	assert.Equal(test, 1, len(diags), "TestCreateTaskOperationFailedUndefinedCustomField Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Invalid custom fields", diags[0].Summary, "TestCreateTaskOperationFailedUndefinedCustomField Failed: Wrong Diagnostic Summary expected")
+	assert.Contains(test, diags[0].Detail, `"ticket_url" is not defined`, "TestCreateTaskOperationFailedUndefinedCustomField Failed: Undefined field expected in error")
+}
+
+func TestCustomizeDiffTaskCustomFieldsWithoutProject(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+
+	resource := MakeOciTaskResource().ResourceOciTask()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"items": []interface{}{map[string]interface{}{
+			"title":         "Test Task 1",
+			"status":        "todo",
+			"custom_fields": map[string]interface{}{"cost_center": "CC-42"},
+		}},
+	})
+
+	_, err := resource.Diff(context.Background(), makeTestOciTaskState("todo", "false"), config, &ociTaskServClientMock)
+
+	assert.Error(test, err, "TestCustomizeDiffTaskCustomFieldsWithoutProject Failed: Error expected without project")
+	assert.Contains(test, err.Error(), "project_id", "TestCustomizeDiffTaskCustomFieldsWithoutProject Failed: Wrong error")
+}
+
+func TestReadTasksOperationByCustomFields(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	task := makeTestOciTask(1001)
+	task.CustomFields = map[string]string{"environment": "prod"}
+
+	ociTaskServClientMock.On("ListTasks", mock.Anything, mock.MatchedBy(func(filter *ocitaskclient.OciTaskListFilter) bool {
+		return filter.CustomFields["environment"] == "prod"
+	})).Return(&ocitaskclient.OciTaskServResponse{Tasks: []ocitaskclient.OciTask{task}}, nil).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskDataSource().DataSourceOciTasks().Schema, map[string]interface{}{
+		"custom_fields": map[string]interface{}{"environment": "prod"},
+	})
+
+	diags := ociTaskOperation.OciTasksRead(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestReadTasksOperationByCustomFields Failed: No Diagnostics expected")
+	assert.Equal(test, "prod", rd.Get("items.0.custom_fields.environment"), "TestReadTasksOperationByCustomFields Failed: Custom field value expected")
+}
@@ -1,6 +1,11 @@
 package ocitaskprovider
 
-import "github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+import (
+	"ocitaskclient"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
 
 /**
  * @brief Define schema of Task resource in OCI Task System
@@ -25,59 +30,674 @@ func MakeOciTaskDataSource() *OciTaskDataSource {
  */
 func (ociTaskDataSource *OciTaskDataSource) DataSourceOciTasks() *schema.Resource {
 	return &schema.Resource{
-		ReadContext: ociTaskDataSource.ociTaskOperation.OciTaskRead,
+		ReadContext: ociTaskDataSource.ociTaskOperation.OciTasksRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "Identifier of Task to read. Tasks matching filters are listed if not set.",
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List only Tasks carrying all of these tags.",
+			},
+			"project_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "List only Tasks of this Project.",
+			},
+			"assignee": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateOciTaskUserEmail,
+				Description:      "List only Tasks assigned to user with this e-mail address.",
+			},
+			"custom_fields": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List only Tasks with all of these custom field values.",
+			},
+			"items": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     ociTaskDataSource.dataSourceOciTaskItem(),
+			},
+		},
+	}
+}
+
+/**
+ * @brief Build schema for archived Tasks data source in OCI Task System
+ * @return Instance of schema.Resource contains schema for archived Tasks data source in OCI Task System
+ */
+func (ociTaskDataSource *OciTaskDataSource) DataSourceOciArchivedTasks() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ociTaskDataSource.ociTaskOperation.OciArchivedTasksRead,
+		Schema: map[string]*schema.Schema{
+			"tags": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List only archived Tasks carrying all of these tags.",
+			},
+			"project_id": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "List only archived Tasks of this Project.",
+			},
+			"items": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        ociTaskDataSource.dataSourceOciTaskItem(),
+				Description: "Archived Tasks. Importing one into ocitask_task restores it on next apply.",
+			},
+		},
+	}
+}
+
+/**
+ * @brief Build schema for Task item of Task data sources
+ * @return Instance of schema.Resource contains schema for single Task
+ */
+func (ociTaskDataSource *OciTaskDataSource) dataSourceOciTaskItem() *schema.Resource {
+	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
 				Required: true,
 			},
-			"items": {
+			"description": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"priority": {
+				Type:     schema.TypeInt,
+				Optional: true,
+				Computed: true,
+			},
+			"priority_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"completed": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"status_changed_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"start_date": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"due_date": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"time_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"time_created": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"tags": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"parent_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"project_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"assignee": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"estimate_minutes": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"logged_minutes": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Minutes logged on Task and all its subtasks. Only rolled up for Tasks with estimate_minutes, 0 otherwise. Only set if Task is read by id.",
+			},
+			"remaining_minutes": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Estimates of Task and all its subtasks less logged minutes, never below 0. Only rolled up for Tasks with estimate_minutes, 0 otherwise. Only set if Task is read by id.",
+			},
+			"watchers": {
 				Type:     schema.TypeList,
 				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"custom_fields": {
+				Type:     schema.TypeMap,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"archived": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if Task is archived.",
+			},
+			"time_archived": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time Task was archived in RFC3339 format, empty if Task is not archived.",
+			},
+		},
+	}
+}
+
+/**
+ * @brief Build schema for Task tree data source in OCI Task System
+ * @return Instance of schema.Resource contains schema for Task tree data source in OCI Task System
+ */
+func (ociTaskDataSource *OciTaskDataSource) DataSourceOciTaskTree() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ociTaskDataSource.ociTaskOperation.OciTaskTreeRead,
+		Schema: map[string]*schema.Schema{
+			"root_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Identifier of Task at root of tree.",
+			},
+			"max_depth": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				Description:      "Deepest level to read, root is level 0. Whole tree is read if not set or 0.",
+			},
+			"nodes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Tasks of tree in depth-first order, each parent before its children.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
 							Type:     schema.TypeInt,
-							Optional: true,
+							Computed: true,
+						},
+						"parent_id": {
+							Type:     schema.TypeInt,
 							Computed: true,
 						},
 						"title": {
 							Type:     schema.TypeString,
-							Required: true,
+							Computed: true,
 						},
-						"description": {
+						"status": {
 							Type:     schema.TypeString,
-							Optional: true,
 							Computed: true,
 						},
 						"priority": {
 							Type:     schema.TypeInt,
-							Optional: true,
 							Computed: true,
 						},
-						"completed": {
-							Type:     schema.TypeBool,
-							Optional: true,
+						"depth": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Level of Task in tree, root is level 0.",
+						},
+						"path": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+							Description: "Identifiers of Tasks from root down to this Task.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+/**
+ * @brief Build schema for Task dependency graph data source in OCI Task System
+ * @return Instance of schema.Resource contains schema for Task dependency graph data source in OCI Task System
+ */
+func (ociTaskDataSource *OciTaskDataSource) DataSourceOciTaskDependencyGraph() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ociTaskDataSource.ociTaskOperation.OciTaskDependencyGraphRead,
+		Schema: map[string]*schema.Schema{
+			"task_ids": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Identifiers of Tasks in graph. Dependencies to Tasks outside the set are ignored.",
+			},
+			"planned_dependency": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Dependencies configured but not necessarily created yet, e.g. every ocitask_task_dependency of the configuration built from a shared local. They are checked together with stored dependencies and fail the plan if they close a cycle. Their Tasks are added to the graph.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"blocker_id": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "Identifier of Task which must be finished first.",
+						},
+						"blocked_id": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "Identifier of Task which waits for blocker Task.",
+						},
+						"type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Default:          ocitaskclient.OciTaskDependencyBlocks,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(ocitaskclient.OciTaskDependencyTypes, false)),
+							Description:      "Type of dependency: blocks or relates_to.",
+						},
+					},
+				},
+			},
+			"order": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Identifiers of Tasks ordered so every blocker Task comes before Tasks it blocks. Ties are ordered by Identifier.",
+			},
+			"critical_path": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "Identifiers of Tasks on longest chain of blocks dependencies, weighted by time between start date and due date of each Task.",
+			},
+			"critical_path_duration_hours": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Total duration of Tasks on critical path in hours.",
+			},
+			"dependencies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Dependencies stored in OCI Task Service between Tasks of the set.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
 							Computed: true,
 						},
-						"start_date": {
+						"blocker_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"blocked_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"type": {
 							Type:     schema.TypeString,
-							Optional: true,
 							Computed: true,
 						},
-						"due_date": {
+					},
+				},
+			},
+		},
+	}
+}
+
+/**
+ * @brief Build schema for user data source in OCI Task System
+ * @return Instance of schema.Resource contains schema for user data source in OCI Task System
+ */
+func (ociTaskDataSource *OciTaskDataSource) DataSourceOciTaskUser() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ociTaskDataSource.ociTaskOperation.OciTaskUserRead,
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOciTaskUserEmail,
+				Description:      "E-mail address of user, case-insensitive.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Display name of user.",
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "False if user is deactivated.",
+			},
+		},
+	}
+}
+
+/**
+ * @brief Build schema for Task comments data source in OCI Task System
+ * @return Instance of schema.Resource contains schema for Task comments data source in OCI Task System
+ */
+func (ociTaskDataSource *OciTaskDataSource) DataSourceOciTaskComments() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ociTaskDataSource.ociTaskOperation.OciTaskCommentsRead,
+		Schema: map[string]*schema.Schema{
+			"task_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Identifier of Task to read comments of.",
+			},
+			"comments": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Comment thread of Task, oldest comment first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"author": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "E-mail address of user who wrote the comment.",
+						},
+						"body": {
 							Type:     schema.TypeString,
-							Optional: true,
 							Computed: true,
 						},
+						"time_created": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time comment was added in RFC3339 format.",
+						},
 						"time_updated": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time comment was last edited in RFC3339 format.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+/**
+ * @brief Build schema for Task change history data source in OCI Task System
+ * @return Instance of schema.Resource contains schema for Task change history data source in OCI Task System
+ */
+func (ociTaskDataSource *OciTaskDataSource) DataSourceOciTaskHistory() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ociTaskDataSource.ociTaskOperation.OciTaskHistoryRead,
+		Schema: map[string]*schema.Schema{
+			"task_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Identifier of Task to read change history of.",
+			},
+			"revisions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Revisions of Task, oldest first. Each revision lists the fields it changed compared to revision before it.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"revision": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"actor": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "E-mail address of user who made the change.",
+						},
+						"time_changed": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time change was made in RFC3339 format.",
+						},
+						"changed_fields": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Names of fields changed, sorted.",
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"changes": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Old and new value of each changed field. Values other than text are JSON encoded.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"field": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"old_value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"new_value": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+/**
+ * @brief Build schema for Task attachment data source in OCI Task System
+ * @return Instance of schema.Resource contains schema for Task attachment data source in OCI Task System
+ */
+func (ociTaskDataSource *OciTaskDataSource) DataSourceOciTaskAttachment() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ociTaskDataSource.ociTaskOperation.OciTaskAttachmentDataRead,
+		Schema: map[string]*schema.Schema{
+			"task_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Identifier of Task the file is attached to.",
+			},
+			"attachment_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				Description: "Identifier of attachment to read.",
+			},
+			"output_path": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Path to local file the content is downloaded to. Content is not downloaded if not set.",
+			},
+			"file_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of attached file.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Media type of attached file.",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Size of attached file in bytes.",
+			},
+			"sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 of attached file. Downloaded content is checked against it.",
+			},
+			"time_created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time file was attached in RFC3339 format.",
+			},
+		},
+	}
+}
+
+/**
+ * @brief Build schema for recurrence occurrences data source in OCI Task System
+ * @return Instance of schema.Resource contains schema for recurrence occurrences data source in OCI Task System
+ */
+func (ociTaskDataSource *OciTaskDataSource) DataSourceOciTaskOccurrences() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ociTaskDataSource.ociTaskOperation.OciTaskOccurrencesRead,
+		Schema: map[string]*schema.Schema{
+			"rrule": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOciTaskRecurrenceRule,
+				Description:      "Recurrence rule in RFC 5545 RRULE format.",
+			},
+			"dtstart": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOciTaskRecurrenceTime,
+				Description:      "Start of recurrence. RFC3339, or local time (2006-01-02T15:04:05) or date (2006-01-02) in timezone.",
+			},
+			"timezone": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "UTC",
+				ValidateDiagFunc: validateOciTaskTimeZone,
+				Description:      "IANA name of time zone occurrences are computed in.",
+			},
+			"exdates": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Excluded occurrences (EXDATE). Date without time excludes any occurrence on that day.",
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateOciTaskRecurrenceTime,
+				},
+			},
+			"from": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: validateOciTaskRecurrenceTime,
+				Description:      "Occurrences before this time are skipped. Defaults to current time.",
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validation.IntBetween(1, 1000),
+				Description:  "Maximum number of occurrences listed.",
+			},
+			"occurrences": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Upcoming occurrences in RFC3339 format, in order.",
+			},
+		},
+	}
+}
+
+/**
+ * @brief Build schema for effective permissions data source in OCI Task System
+ * @return Instance of schema.Resource contains schema for effective permissions data source in OCI Task System
+ */
+func (ociTaskDataSource *OciTaskDataSource) DataSourceOciTaskEffectivePermissions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: ociTaskDataSource.ociTaskOperation.OciTaskEffectivePermissionsRead,
+		Schema: map[string]*schema.Schema{
+			"task_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"task_id", "project_id"},
+				Description:  "Identifier of Task to read permissions on.",
+			},
+			"project_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"task_id", "project_id"},
+				Description:  "Identifier of Project to read permissions on.",
+			},
+			"principal_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(ocitaskclient.OciTaskPrincipalTypes, false),
+				Description:  "Kind of principal: user or group.",
+			},
+			"principal": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "E-mail address of user or name of group.",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Most privileged role principal holds, empty if it has no access.",
+			},
+			"actions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Actions principal may perform, sorted.",
+			},
+			"sources": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Grants role comes from: to principal, to groups of user, or on Project of Task. Most privileged first.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"target_type": {
 							Type:     schema.TypeString,
-							Optional: true,
 							Computed: true,
 						},
-						"time_created": {
+						"target_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"principal_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"principal": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"role": {
 							Type:     schema.TypeString,
-							Optional: true,
 							Computed: true,
 						},
 					},
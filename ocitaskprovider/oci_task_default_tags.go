@@ -0,0 +1,107 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"ocitaskclient"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/**
+ * @brief Read default tags configured on provider
+ * @param m Contains ociTaskProviderMeta pluged into Terraform Provider
+ * @return Default tags, nil if none configured
+ */
+func ociTaskDefaultTags(m interface{}) map[string]string {
+	if meta, ok := m.(*ociTaskProviderMeta); ok {
+		return meta.defaultTags
+	}
+
+	return nil
+}
+
+/**
+ * @brief Expand default_tags block of provider configuration
+ * @param src Value of default_tags block from provider resource data
+ * @return Default tags, nil if block not configured
+ */
+func expandOciTaskDefaultTags(src interface{}) map[string]string {
+	blocks, ok := src.([]interface{})
+	if !ok || len(blocks) == 0 || blocks[0] == nil {
+		return nil
+	}
+
+	return ocitaskclient.ExpandOciTaskStringMap(blocks[0].(map[string]interface{})["tags"])
+}
+
+/**
+ * @brief Merge default tags into Task item before it is sent to OCI Task Service
+ * @param item Task item from resource data
+ * @param defaultTags Default tags configured on provider
+ * @return Task item carrying effective tags
+ */
+func withOciTaskDefaultTags(item interface{}, defaultTags map[string]string) interface{} {
+	ociTask, ok := item.(map[string]interface{})
+	if !ok || len(defaultTags) == 0 {
+		return item
+	}
+
+	tags := ocitaskclient.MergeOciTaskTags(defaultTags, ocitaskclient.ExpandOciTaskStringMap(ociTask["tags"]))
+	ociTask["tags"] = ocitaskclient.FlattenOciTaskStringMap(tags)
+
+	return ociTask
+}
+
+/**
+ * @brief Split effective tags returned by OCI Task Service into tags_all and tags owned by Task.
+ *			Keeps tag key casing used in configuration so that normalised keys don't cause diffs.
+ * @param rd Resource data of Task resource
+ * @param ociTask Flattened Task to receive tags owned by Task
+ * @param tags Effective tags returned by OCI Task Service
+ * @param defaultTags Default tags configured on provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func setOciTaskTags(rd *schema.ResourceData, ociTask map[string]interface{}, tags map[string]string, defaultTags map[string]string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	configuredTags := ocitaskclient.ExpandOciTaskStringMap(rd.Get("items.0.tags"))
+	tagsAll := ocitaskclient.ReconcileOciTaskTags(tags, ocitaskclient.MergeOciTaskTags(defaultTags, configuredTags))
+
+	ociTask["tags"] = ocitaskclient.FlattenOciTaskStringMap(ocitaskclient.StripOciTaskDefaultTags(tagsAll, defaultTags, configuredTags))
+
+	err := rd.Set("tags_all", ocitaskclient.FlattenOciTaskStringMap(tagsAll))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to set tags_all into resource data",
+			Detail:   err.Error(),
+		})
+	}
+
+	return diags
+}
+
+/**
+ * @brief Compute tags_all at plan time from default tags and tags configured on Task.
+ *			No diff is planned if effective tags only differ by key casing.
+ * @param ctx Context to Terraform Provider
+ * @param rdiff Planned changes of Task resource
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Instance of error if failed
+ */
+func customizeOciTaskTagsAll(ctx context.Context, rdiff *schema.ResourceDiff, m interface{}) error {
+	if !rdiff.NewValueKnown("items.0.tags") {
+		return rdiff.SetNewComputed("tags_all")
+	}
+
+	configuredTags := ocitaskclient.ExpandOciTaskStringMap(rdiff.Get("items.0.tags"))
+	tagsAll := ocitaskclient.MergeOciTaskTags(ociTaskDefaultTags(m), configuredTags)
+
+	stateTags := ocitaskclient.ExpandOciTaskStringMap(rdiff.Get("tags_all"))
+	if ocitaskclient.EqualOciTaskTags(tagsAll, stateTags) {
+		return nil
+	}
+
+	return rdiff.SetNew("tags_all", ocitaskclient.FlattenOciTaskStringMap(tagsAll))
+}
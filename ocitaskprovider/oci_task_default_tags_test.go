@@ -0,0 +1,140 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"ocitaskclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreateTaskOperationMergesDefaultTags(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	providerMeta := ociTaskProviderMeta{OciTaskServClientInterface: &ociTaskServClientMock, defaultTags: map[string]string{"owner": "platform", "env": "dev"}}
+	ociTaskOperation := OciTaskOperation{}
+
+	task := makeTestOciTask(1001)
+	task.Tags = map[string]string{"owner": "platform", "env": "prod"}
+
+	createResponse := ocitaskclient.OciTaskServResponse{}
+	createResponse.TaskId = task.Id
+
+	readResponse := ocitaskclient.OciTaskServResponse{}
+	readResponse.Task = &task
+
+	srcTask := make(map[string]interface{})
+	srcTask["title"] = *task.Title
+	srcTask["tags"] = map[string]interface{}{"Env": "prod"}
+
+	testData := make(map[string]interface{})
+	testData["items"] = []interface{}{srcTask}
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTask().Schema, testData)
+
+	ociTaskServClientMock.On("CreateTask", mock.Anything, mock.MatchedBy(func(request *ocitaskclient.OciTaskServRequest) bool {
+		return len(request.Tags) == 2 && request.Tags["owner"] == "platform" && request.Tags["env"] == "prod"
+	})).Return(&createResponse, nil).Once()
+	ociTaskServClientMock.On("GetTask", mock.Anything, task.Id).Return(&readResponse, nil).Once()
+
+	diags := ociTaskOperation.OciTaskCreate(context.Background(), rd, &providerMeta)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestCreateTaskOperationMergesDefaultTags Failed: No Diagnostics expected")
+	assert.Equal(test, map[string]interface{}{"Env": "prod"}, rd.Get("items.0.tags"), "TestCreateTaskOperationMergesDefaultTags Failed: Only tags owned by Task expected in items")
+	assert.Equal(test, map[string]interface{}{"owner": "platform", "Env": "prod"}, rd.Get("tags_all"), "TestCreateTaskOperationMergesDefaultTags Failed: Effective tags expected in tags_all")
+}
+
+func TestReadTaskOperationKeepsChangedDefaultTag(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	providerMeta := ociTaskProviderMeta{OciTaskServClientInterface: &ociTaskServClientMock, defaultTags: map[string]string{"owner": "platform"}}
+	ociTaskOperation := OciTaskOperation{}
+
+	task := makeTestOciTask(1001)
+	task.Tags = map[string]string{"owner": "storage"}
+
+	readResponse := ocitaskclient.OciTaskServResponse{}
+	readResponse.Task = &task
+
+	srcTask := make(map[string]interface{})
+	srcTask["title"] = *task.Title
+
+	testData := make(map[string]interface{})
+	testData["items"] = []interface{}{srcTask}
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTask().Schema, testData)
+	rd.SetId("1001")
+
+	ociTaskServClientMock.On("GetTask", mock.Anything, task.Id).Return(&readResponse, nil).Once()
+
+	diags := ociTaskOperation.OciTaskRead(context.Background(), rd, &providerMeta)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestReadTaskOperationKeepsChangedDefaultTag Failed: No Diagnostics expected")
+	assert.Equal(test, map[string]interface{}{"owner": "storage"}, rd.Get("items.0.tags"), "TestReadTaskOperationKeepsChangedDefaultTag Failed: Tag changed outside Terraform expected in items")
+}
+
+func TestCustomizeDiffTagsAll(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	providerMeta := ociTaskProviderMeta{OciTaskServClientInterface: &ociTaskServClientMock, defaultTags: map[string]string{"owner": "platform"}}
+
+	resource := MakeOciTaskResource().ResourceOciTask()
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{
+				"title": "Test Task 1",
+				"tags":  map[string]interface{}{"env": "prod"},
+			},
+		},
+	})
+
+	instanceDiff, err := resource.Diff(context.Background(), nil, config, &providerMeta)
+
+	assert.NoError(test, err, "TestCustomizeDiffTagsAll Failed: No error expected")
+	assert.Equal(test, "platform", instanceDiff.Attributes["tags_all.owner"].New, "TestCustomizeDiffTagsAll Failed: Default tag expected in planned tags_all")
+	assert.Equal(test, "prod", instanceDiff.Attributes["tags_all.env"].New, "TestCustomizeDiffTagsAll Failed: Task tag expected in planned tags_all")
+}
+
+func TestCustomizeDiffTagsAllNoChange(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	providerMeta := ociTaskProviderMeta{OciTaskServClientInterface: &ociTaskServClientMock, defaultTags: map[string]string{"owner": "platform"}}
+
+	resource := MakeOciTaskResource().ResourceOciTask()
+
+	state := &terraform.InstanceState{
+		ID: "1001",
+		Attributes: map[string]string{
+			"id":                 "1001",
+			"items.#":            "1",
+			"items.0.title":      "Test Task 1",
+			"items.0.tags.%":     "1",
+			"items.0.tags.Env":   "prod",
+			"tags_all.%":         "2",
+			"tags_all.owner":     "platform",
+			"tags_all.env":       "prod",
+			"items.0.id":         "1001",
+			"items.0.priority":   "5",
+			"items.0.completed":  "false",
+			"items.0.start_date": "2022-01-01",
+		},
+	}
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{
+				"title": "Test Task 1",
+				"tags":  map[string]interface{}{"Env": "prod"},
+			},
+		},
+	})
+
+	instanceDiff, err := resource.Diff(context.Background(), state, config, &providerMeta)
+
+	assert.NoError(test, err, "TestCustomizeDiffTagsAllNoChange Failed: No error expected")
+	assert.True(test, instanceDiff == nil || instanceDiff.Empty(), "TestCustomizeDiffTagsAllNoChange Failed: No diff expected")
+}
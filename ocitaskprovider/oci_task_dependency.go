@@ -0,0 +1,581 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ocitaskclient"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/**
+ * @brief Create new dependency between Tasks in OCI Task System. Rejects blocks dependency which would close a cycle.
+ *			Cycle is checked again once dependency is stored: dependencies created in parallel, e.g. in the same apply,
+ *			can't see each other before creation. Of the dependencies closing the cycle only the one with the lowest
+ *			Identifier is kept, so every parallel Create comes to the same decision.
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains dependency defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskDependencyCreate(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskDependencyCreate", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	dependency := expandOciTaskDependency(rd)
+	ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+
+	err := checkOciTaskDependencyCycle(ctx, ociClient, dependency)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid dependency",
+			Detail:   err.Error(),
+		})
+	} else {
+		ociResponse, err := ociClient.CreateTaskDependency(ctx, dependency)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to create task dependency",
+				Detail:   err.Error(),
+			})
+		} else {
+			if ociResponse.Err != nil {
+				ociErr, _ := ociResponse.Err.Serialize()
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to create task dependency",
+					Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else if ociResponse.Dependency == nil || ociResponse.Dependency.Id == nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to create task dependency",
+					Detail:   "OCI Task Service returned no dependency Id" + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else {
+				dependencyId := *ociResponse.Dependency.Id
+				rd.SetId(strconv.FormatInt(dependencyId, 10))
+
+				var cycleErr *ocitaskclient.OciTaskDependencyCycleError
+				err := checkOciTaskDependencyCycle(ctx, ociClient, dependency)
+				if errors.As(err, &cycleErr) {
+					otherId, errCycle := maxOciTaskCycleDependencyId(ctx, ociClient, cycleErr.Cycle, dependencyId)
+					if errCycle == nil && otherId > dependencyId {
+						// Dependency with higher Identifier on the cycle removes itself, so this one is kept
+						diags = append(diags, ociTaskOperation.OciTaskDependencyRead(ctx, rd, m)...)
+					} else {
+						deleteDiags := ociTaskOperation.OciTaskDependencyDelete(ctx, rd, m)
+						diags = append(diags, deleteDiags...)
+
+						detail := cycleErr.Error() + " - dependency created in parallel closed the cycle"
+						if errCycle != nil {
+							detail += " and dependencies on the cycle couldn't be read (" + errCycle.Error() + ")"
+						}
+						if deleteDiags.HasError() {
+							detail += ", removing this one again failed and it has to be removed manually"
+						} else {
+							detail += ", so this one was removed again"
+						}
+
+						diags = append(diags, diag.Diagnostic{
+							Severity: diag.Error,
+							Summary:  "Invalid dependency",
+							Detail:   detail,
+						})
+					}
+				} else {
+					if err != nil {
+						diags = append(diags, diag.Diagnostic{
+							Severity: diag.Warning,
+							Summary:  "Failed to verify task dependency",
+							Detail:   err.Error(),
+						})
+					}
+					diags = append(diags, ociTaskOperation.OciTaskDependencyRead(ctx, rd, m)...)
+				}
+			}
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Read dependency between Tasks in OCI Task System. Removes dependency from state if it no longer exists.
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains dependency Identifier defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskDependencyRead(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskDependencyRead", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	dependencyId, err := strconv.ParseInt(rd.Id(), 10, 64)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to get Id from resource data",
+			Detail:   err.Error(),
+		})
+	} else {
+		ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+		ociResponse, err := ociClient.GetTaskDependency(ctx, &dependencyId)
+		if ocitaskclient.IsOciTaskNotFound(err) {
+			rd.SetId("")
+		} else if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read task dependency",
+				Detail:   err.Error(),
+			})
+		} else {
+			if ociResponse.Err != nil {
+				ociErr, _ := ociResponse.Err.Serialize()
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to read task dependency",
+					Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else if ociResponse.Dependency == nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to read task dependency",
+					Detail:   "OCI Task Service returned no dependency" + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else {
+				for key, value := range flattenOciTaskDependency(*ociResponse.Dependency) {
+					if key == "id" {
+						continue
+					}
+
+					err := rd.Set(key, value)
+					if err != nil {
+						diags = append(diags, diag.Diagnostic{
+							Severity: diag.Error,
+							Summary:  "Failed to set task dependency into resource data",
+							Detail:   err.Error(),
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Delete dependency between Tasks in OCI Task System
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains dependency Identifier defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskDependencyDelete(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskDependencyDelete", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	dependencyId, err := strconv.ParseInt(rd.Id(), 10, 64)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to get Id from resource data",
+			Detail:   err.Error(),
+		})
+	} else {
+		ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+		ociResponse, err := ociClient.DeleteTaskDependency(ctx, &dependencyId)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to delete task dependency",
+				Detail:   err.Error(),
+			})
+		} else {
+			if ociResponse.Err != nil {
+				ociErr, _ := ociResponse.Err.Serialize()
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to delete task dependency",
+					Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else {
+				rd.SetId("")
+			}
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Read dependencies among set of Tasks in OCI Task System for dependency graph data source.
+ *			Only dependencies between Tasks of the set are considered. Planned dependencies are checked for cycles
+ *			with stored dependencies, then added to the graph together with their Tasks.
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains Task Identifiers defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskDependencyGraphRead(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	planned := expandOciTaskPlannedDependencies(rd.Get("planned_dependency").([]interface{}))
+	taskIdValues := rd.Get("task_ids").([]interface{})
+	for _, dependency := range planned {
+		taskIdValues = append(taskIdValues, int(*dependency.BlockerId), int(*dependency.BlockedId))
+	}
+	taskIds := expandOciTaskIds(taskIdValues)
+	graphId := formatOciTaskIds(taskIds)
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskDependencyGraphRead", graphId)
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+
+	if len(planned) > 0 {
+		var cycleErr *ocitaskclient.OciTaskDependencyCycleError
+		err := ocitaskclient.ValidateOciTaskDependencies(ctx, ociClient, planned)
+		if errors.As(err, &cycleErr) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Invalid planned task dependency",
+				Detail:   cycleErr.Error(),
+			})
+			return diags
+		} else if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to check planned task dependencies",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	dependencies, graph, durations, err := readOciTaskDependencyGraph(ctx, ociClient, taskIds)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read task dependency graph",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	for _, dependency := range planned {
+		graph.AddDependency(dependency)
+	}
+
+	order, err := graph.TopologicalOrder()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid task dependency graph",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	criticalPath, duration, _ := graph.CriticalPath(durations)
+
+	values := map[string]interface{}{
+		"order":                        flattenOciTaskIds(order),
+		"critical_path":                flattenOciTaskIds(criticalPath),
+		"critical_path_duration_hours": float64(duration) / float64(time.Hour/time.Millisecond),
+		"dependencies":                 dependencies,
+	}
+	for key, value := range values {
+		err := rd.Set(key, value)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to set task dependency graph into resource data",
+				Detail:   err.Error(),
+			})
+		}
+	}
+
+	if len(diags) == 0 {
+		rd.SetId(graphId)
+	}
+
+	return diags
+}
+
+/**
+ * @brief Read Tasks of set and dependencies between them
+ * @param ctx Context to Terraform Provider
+ * @param ociClient Client to OCI Task Service
+ * @param taskIds Identifiers of Tasks
+ * @return Flattened dependencies between Tasks of set
+ * @return Graph of blocks dependencies containing every Task of set
+ * @return Duration of every Task in milliseconds
+ * @return Instance of error if failed
+ */
+func readOciTaskDependencyGraph(ctx context.Context, ociClient ocitaskclient.OciTaskServClientInterface, taskIds []int64) ([]interface{}, *ocitaskclient.OciTaskDependencyGraph, map[int64]int64, error) {
+	graph := ocitaskclient.MakeOciTaskDependencyGraph()
+	durations := make(map[int64]int64)
+	inSet := make(map[int64]bool)
+
+	for _, taskId := range taskIds {
+		taskId := taskId
+		ociResponse, err := ociClient.GetTask(ctx, &taskId)
+		if err == nil && ociResponse.Err != nil {
+			ociErr, _ := ociResponse.Err.Serialize()
+			err = fmt.Errorf("%s%s", ociErr, ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId))
+		}
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("Failed to read task %d: %s", taskId, err.Error())
+		}
+
+		graph.AddTask(taskId)
+		durations[taskId] = ocitaskclient.OciTaskDuration(ociResponse.Task)
+		inSet[taskId] = true
+	}
+
+	ociResponse, err := ociClient.ListTaskDependencies(ctx, taskIds)
+	if err == nil && ociResponse.Err != nil {
+		ociErr, _ := ociResponse.Err.Serialize()
+		err = fmt.Errorf("%s%s", ociErr, ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId))
+	}
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("Failed to list task dependencies: %s", err.Error())
+	}
+
+	dependencies := make([]interface{}, 0, len(ociResponse.Dependencies))
+	for _, dependency := range ociResponse.Dependencies {
+		if dependency.BlockerId == nil || dependency.BlockedId == nil || !inSet[*dependency.BlockerId] || !inSet[*dependency.BlockedId] {
+			continue
+		}
+
+		graph.AddDependency(dependency)
+		dependencies = append(dependencies, flattenOciTaskDependency(dependency))
+	}
+
+	return dependencies, graph, durations, nil
+}
+
+/**
+ * @brief Reject blocks dependency which would close a cycle with dependencies already stored in OCI Task Service.
+ *			Each dependency resource only sees stored dependencies: cycle formed by dependencies created in the same apply
+ *			is caught at plan by planned_dependency of dependency graph data source, or by OciTaskDependencyCreate after creation.
+ * @param ctx Context to Terraform Provider
+ * @param rdiff Planned changes of dependency resource
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Instance of error if dependency would close a cycle
+ */
+func customizeOciTaskDependency(ctx context.Context, rdiff *schema.ResourceDiff, m interface{}) error {
+	if rdiff.Id() != "" {
+		return nil
+	}
+
+	// Tasks created in the same plan have no Identifier yet, they can't be part of a stored cycle yet
+	if !rdiff.NewValueKnown("blocker_id") || !rdiff.NewValueKnown("blocked_id") {
+		return nil
+	}
+
+	dependency := &ocitaskclient.OciTaskDependency{}
+	blockerId := int64(rdiff.Get("blocker_id").(int))
+	blockedId := int64(rdiff.Get("blocked_id").(int))
+	dependencyType := rdiff.Get("type").(string)
+	dependency.BlockerId = &blockerId
+	dependency.BlockedId = &blockedId
+	dependency.Type = &dependencyType
+
+	ociClient, _ := m.(ocitaskclient.OciTaskServClientInterface)
+
+	return checkOciTaskDependencyCycle(ctx, ociClient, dependency)
+}
+
+/**
+ * @brief Find highest Identifier among other blocks dependencies forming cycle
+ * @param ctx Context to Terraform Provider
+ * @param ociClient Client to OCI Task Service
+ * @param cycle Identifiers of Tasks on cycle, first Task repeated at the end
+ * @param dependencyId Identifier of dependency to leave out
+ * @return Highest Identifier of other dependencies on cycle, 0 if none found
+ * @return Instance of error if dependencies can't be read
+ */
+func maxOciTaskCycleDependencyId(ctx context.Context, ociClient ocitaskclient.OciTaskServClientInterface, cycle []int64, dependencyId int64) (int64, error) {
+	if len(cycle) < 2 {
+		return 0, nil
+	}
+
+	next := make(map[int64]int64)
+	for index := 0; index < len(cycle)-1; index++ {
+		next[cycle[index]] = cycle[index+1]
+	}
+
+	ociResponse, err := ociClient.ListTaskDependencies(ctx, cycle[:len(cycle)-1])
+	if err == nil && ociResponse.Err != nil {
+		ociErr, _ := ociResponse.Err.Serialize()
+		err = fmt.Errorf("%s%s", ociErr, ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId))
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	maxId := int64(0)
+	for _, dependency := range ociResponse.Dependencies {
+		if dependency.Id == nil || dependency.BlockerId == nil || dependency.BlockedId == nil || dependency.GetType() != ocitaskclient.OciTaskDependencyBlocks {
+			continue
+		}
+
+		blockedId, onCycle := next[*dependency.BlockerId]
+		if onCycle && blockedId == *dependency.BlockedId && *dependency.Id != dependencyId && *dependency.Id > maxId {
+			maxId = *dependency.Id
+		}
+	}
+
+	return maxId, nil
+}
+
+/**
+ * @brief Check that dependency doesn't link Task to itself or close a cycle.
+ *			Only blocks dependencies order Tasks, so other types are never part of a cycle.
+ * @param ctx Context to Terraform Provider
+ * @param ociClient Client to OCI Task Service, nil to skip lookup of stored dependencies
+ * @param dependency Instance of OciTaskDependency
+ * @return Instance of error if dependency is invalid
+ */
+func checkOciTaskDependencyCycle(ctx context.Context, ociClient ocitaskclient.OciTaskServClientInterface, dependency *ocitaskclient.OciTaskDependency) error {
+	if *dependency.BlockerId == *dependency.BlockedId {
+		return fmt.Errorf("Task %d can't depend on itself", *dependency.BlockerId)
+	}
+
+	if ociClient == nil || dependency.GetType() != ocitaskclient.OciTaskDependencyBlocks {
+		return nil
+	}
+
+	return ocitaskclient.ValidateOciTaskDependency(ctx, ociClient, *dependency.BlockerId, *dependency.BlockedId)
+}
+
+/**
+ * @brief Build OciTaskDependency from resource data of dependency resource
+ * @param rd Resource data of dependency resource
+ * @return Instance of OciTaskDependency
+ */
+func expandOciTaskDependency(rd *schema.ResourceData) *ocitaskclient.OciTaskDependency {
+	blockerId := int64(rd.Get("blocker_id").(int))
+	blockedId := int64(rd.Get("blocked_id").(int))
+	dependencyType := rd.Get("type").(string)
+
+	return &ocitaskclient.OciTaskDependency{
+		BlockerId: &blockerId,
+		BlockedId: &blockedId,
+		Type:      &dependencyType,
+	}
+}
+
+/**
+ * @brief Build OciTaskDependency instances from planned_dependency blocks of dependency graph data source
+ * @param values Generic list of planned_dependency blocks
+ * @return Planned dependencies
+ */
+func expandOciTaskPlannedDependencies(values []interface{}) []ocitaskclient.OciTaskDependency {
+	dependencies := make([]ocitaskclient.OciTaskDependency, 0, len(values))
+	for _, value := range values {
+		item := value.(map[string]interface{})
+		blockerId := int64(item["blocker_id"].(int))
+		blockedId := int64(item["blocked_id"].(int))
+		dependencyType := item["type"].(string)
+		dependencies = append(dependencies, ocitaskclient.OciTaskDependency{BlockerId: &blockerId, BlockedId: &blockedId, Type: &dependencyType})
+	}
+
+	return dependencies
+}
+
+/**
+ * @brief Convert dependency into generic map for Terraform resource data
+ * @param dependency Instance of OciTaskDependency
+ * @return Generic map equivalent to dependency
+ */
+func flattenOciTaskDependency(dependency ocitaskclient.OciTaskDependency) map[string]interface{} {
+	result := make(map[string]interface{})
+	result["id"] = 0
+	if dependency.Id != nil {
+		result["id"] = int(*dependency.Id)
+	}
+
+	result["blocker_id"] = 0
+	if dependency.BlockerId != nil {
+		result["blocker_id"] = int(*dependency.BlockerId)
+	}
+
+	result["blocked_id"] = 0
+	if dependency.BlockedId != nil {
+		result["blocked_id"] = int(*dependency.BlockedId)
+	}
+
+	result["type"] = dependency.GetType()
+
+	return result
+}
+
+/**
+ * @brief Convert list of Task Identifiers from resource data, dropping duplicates
+ * @param values Task Identifiers as generic list
+ * @return Task Identifiers in ascending order
+ */
+func expandOciTaskIds(values []interface{}) []int64 {
+	seen := make(map[int64]bool)
+	taskIds := make([]int64, 0, len(values))
+	for _, value := range values {
+		taskId := int64(value.(int))
+		if !seen[taskId] {
+			seen[taskId] = true
+			taskIds = append(taskIds, taskId)
+		}
+	}
+	sort.Slice(taskIds, func(i, j int) bool { return taskIds[i] < taskIds[j] })
+
+	return taskIds
+}
+
+/**
+ * @brief Convert Task Identifiers into generic list for Terraform resource data
+ * @param taskIds Task Identifiers
+ * @return Generic list of Task Identifiers
+ */
+func flattenOciTaskIds(taskIds []int64) []interface{} {
+	result := make([]interface{}, 0, len(taskIds))
+	for _, taskId := range taskIds {
+		result = append(result, int(taskId))
+	}
+
+	return result
+}
+
+/**
+ * @brief Format Task Identifiers as comma separated list
+ * @param taskIds Task Identifiers
+ * @return Comma separated Task Identifiers
+ */
+func formatOciTaskIds(taskIds []int64) string {
+	parts := make([]string, 0, len(taskIds))
+	for _, taskId := range taskIds {
+		parts = append(parts, strconv.FormatInt(taskId, 10))
+	}
+
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,364 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"ocitaskclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func makeTestOciTaskDependency(id int64, blockerId int64, blockedId int64) ocitaskclient.OciTaskDependency {
+	dependencyType := ocitaskclient.OciTaskDependencyBlocks
+
+	return ocitaskclient.OciTaskDependency{Id: &id, BlockerId: &blockerId, BlockedId: &blockedId, Type: &dependencyType}
+}
+
+func TestCreateTaskDependencyOperationSuccess(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	dependency := makeTestOciTaskDependency(1, 1001, 1002)
+
+	ociTaskServClientMock.On("ListTaskDependencies", mock.Anything, []int64{1002}).Return(&ocitaskclient.OciTaskServResponse{}, nil).Twice()
+	ociTaskServClientMock.On("CreateTaskDependency", mock.Anything, mock.MatchedBy(func(request *ocitaskclient.OciTaskDependency) bool {
+		return *request.BlockerId == 1001 && *request.BlockedId == 1002 && request.GetType() == ocitaskclient.OciTaskDependencyBlocks
+	})).Return(&ocitaskclient.OciTaskServResponse{Dependency: &dependency}, nil).Once()
+	ociTaskServClientMock.On("GetTaskDependency", mock.Anything, dependency.Id).Return(&ocitaskclient.OciTaskServResponse{Dependency: &dependency}, nil).Once()
+
+	testData := map[string]interface{}{"blocker_id": 1001, "blocked_id": 1002}
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskDependency().Schema, testData)
+
+	diags := ociTaskOperation.OciTaskDependencyCreate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestCreateTaskDependencyOperationSuccess Failed: No Diagnostics expected")
+	assert.Equal(test, "1", rd.Id(), "TestCreateTaskDependencyOperationSuccess Failed: Wrong resource Id")
+	assert.Equal(test, ocitaskclient.OciTaskDependencyBlocks, rd.Get("type"), "TestCreateTaskDependencyOperationSuccess Failed: Wrong type")
+}
+
+func TestCreateTaskDependencyOperationFailedCycle(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	ociTaskServClientMock.On("ListTaskDependencies", mock.Anything, []int64{1002}).Return(&ocitaskclient.OciTaskServResponse{Dependencies: []ocitaskclient.OciTaskDependency{
+		makeTestOciTaskDependency(2, 1002, 1001),
+	}}, nil).Once()
+
+	testData := map[string]interface{}{"blocker_id": 1001, "blocked_id": 1002}
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskDependency().Schema, testData)
+
+	diags := ociTaskOperation.OciTaskDependencyCreate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertNotCalled(test, "CreateTaskDependency", mock.Anything, mock.Anything)
+
+	assert.Equal(test, 1, len(diags), "TestCreateTaskDependencyOperationFailedCycle Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Invalid dependency", diags[0].Summary, "TestCreateTaskDependencyOperationFailedCycle Failed: Wrong Diagnostic Summary expected")
+	assert.Equal(test, "", rd.Id(), "TestCreateTaskDependencyOperationFailedCycle Failed: No resource Id expected")
+}
+
+func TestCreateTaskDependencyOperationCycleClosedInSameApply(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	dependency := makeTestOciTaskDependency(2, 1002, 1001)
+
+	// 1001 blocks 1002 is created in parallel: not stored yet when checked before creation, stored when checked after
+	ociTaskServClientMock.On("ListTaskDependencies", mock.Anything, []int64{1001}).Return(&ocitaskclient.OciTaskServResponse{}, nil).Once()
+	ociTaskServClientMock.On("CreateTaskDependency", mock.Anything, mock.Anything).Return(&ocitaskclient.OciTaskServResponse{Dependency: &dependency}, nil).Once()
+	ociTaskServClientMock.On("ListTaskDependencies", mock.Anything, []int64{1001}).Return(&ocitaskclient.OciTaskServResponse{Dependencies: []ocitaskclient.OciTaskDependency{
+		makeTestOciTaskDependency(1, 1001, 1002),
+	}}, nil).Once()
+	ociTaskServClientMock.On("ListTaskDependencies", mock.Anything, []int64{1002, 1001}).Return(&ocitaskclient.OciTaskServResponse{Dependencies: []ocitaskclient.OciTaskDependency{
+		makeTestOciTaskDependency(1, 1001, 1002),
+		dependency,
+	}}, nil).Once()
+	ociTaskServClientMock.On("DeleteTaskDependency", mock.Anything, dependency.Id).Return(&ocitaskclient.OciTaskServResponse{}, nil).Once()
+
+	testData := map[string]interface{}{"blocker_id": 1002, "blocked_id": 1001}
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskDependency().Schema, testData)
+
+	diags := ociTaskOperation.OciTaskDependencyCreate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 1, len(diags), "TestCreateTaskDependencyOperationCycleClosedInSameApply Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Invalid dependency", diags[0].Summary, "TestCreateTaskDependencyOperationCycleClosedInSameApply Failed: Wrong Diagnostic Summary expected")
+	assert.Contains(test, diags[0].Detail, "[1002 1001 1002]", "TestCreateTaskDependencyOperationCycleClosedInSameApply Failed: Wrong cycle")
+	assert.Contains(test, diags[0].Detail, "this one was removed again", "TestCreateTaskDependencyOperationCycleClosedInSameApply Failed: Removal expected in Detail")
+	assert.Equal(test, "", rd.Id(), "TestCreateTaskDependencyOperationCycleClosedInSameApply Failed: Dependency with higher Id should not be kept")
+}
+
+func TestCreateTaskDependencyOperationCycleClosedInSameApplyKeepsLowerId(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	dependency := makeTestOciTaskDependency(1, 1002, 1001)
+
+	// 1001 blocks 1002 was created in parallel with higher Id, so it is the one removing itself
+	ociTaskServClientMock.On("ListTaskDependencies", mock.Anything, []int64{1001}).Return(&ocitaskclient.OciTaskServResponse{}, nil).Once()
+	ociTaskServClientMock.On("CreateTaskDependency", mock.Anything, mock.Anything).Return(&ocitaskclient.OciTaskServResponse{Dependency: &dependency}, nil).Once()
+	ociTaskServClientMock.On("ListTaskDependencies", mock.Anything, []int64{1001}).Return(&ocitaskclient.OciTaskServResponse{Dependencies: []ocitaskclient.OciTaskDependency{
+		makeTestOciTaskDependency(2, 1001, 1002),
+	}}, nil).Once()
+	ociTaskServClientMock.On("ListTaskDependencies", mock.Anything, []int64{1002, 1001}).Return(&ocitaskclient.OciTaskServResponse{Dependencies: []ocitaskclient.OciTaskDependency{
+		makeTestOciTaskDependency(2, 1001, 1002),
+		dependency,
+	}}, nil).Once()
+	ociTaskServClientMock.On("GetTaskDependency", mock.Anything, dependency.Id).Return(&ocitaskclient.OciTaskServResponse{Dependency: &dependency}, nil).Once()
+
+	testData := map[string]interface{}{"blocker_id": 1002, "blocked_id": 1001}
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskDependency().Schema, testData)
+
+	diags := ociTaskOperation.OciTaskDependencyCreate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+	ociTaskServClientMock.AssertNotCalled(test, "DeleteTaskDependency", mock.Anything, mock.Anything)
+
+	assert.Equal(test, 0, len(diags), "TestCreateTaskDependencyOperationCycleClosedInSameApplyKeepsLowerId Failed: No Diagnostics expected")
+	assert.Equal(test, "1", rd.Id(), "TestCreateTaskDependencyOperationCycleClosedInSameApplyKeepsLowerId Failed: Dependency with lower Id should be kept")
+}
+
+func TestCreateTaskDependencyOperationCycleClosedInSameApplyFailedDelete(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	dependency := makeTestOciTaskDependency(2, 1002, 1001)
+
+	ociTaskServClientMock.On("ListTaskDependencies", mock.Anything, []int64{1001}).Return(&ocitaskclient.OciTaskServResponse{}, nil).Once()
+	ociTaskServClientMock.On("CreateTaskDependency", mock.Anything, mock.Anything).Return(&ocitaskclient.OciTaskServResponse{Dependency: &dependency}, nil).Once()
+	ociTaskServClientMock.On("ListTaskDependencies", mock.Anything, []int64{1001}).Return(&ocitaskclient.OciTaskServResponse{Dependencies: []ocitaskclient.OciTaskDependency{
+		makeTestOciTaskDependency(1, 1001, 1002),
+	}}, nil).Once()
+	ociTaskServClientMock.On("ListTaskDependencies", mock.Anything, []int64{1002, 1001}).Return(nil, errors.New("List Failed")).Once()
+	ociTaskServClientMock.On("DeleteTaskDependency", mock.Anything, dependency.Id).Return(nil, errors.New("Delete Failed")).Once()
+
+	testData := map[string]interface{}{"blocker_id": 1002, "blocked_id": 1001}
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskDependency().Schema, testData)
+
+	diags := ociTaskOperation.OciTaskDependencyCreate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 2, len(diags), "TestCreateTaskDependencyOperationCycleClosedInSameApplyFailedDelete Failed: Two Diagnostic instances expected")
+	assert.Equal(test, "Failed to delete task dependency", diags[0].Summary, "TestCreateTaskDependencyOperationCycleClosedInSameApplyFailedDelete Failed: Wrong Diagnostic Summary expected")
+	assert.Contains(test, diags[1].Detail, "List Failed", "TestCreateTaskDependencyOperationCycleClosedInSameApplyFailedDelete Failed: Lookup error expected in Detail")
+	assert.Contains(test, diags[1].Detail, "removed manually", "TestCreateTaskDependencyOperationCycleClosedInSameApplyFailedDelete Failed: Failed removal expected in Detail")
+	assert.NotContains(test, diags[1].Detail, "was removed again", "TestCreateTaskDependencyOperationCycleClosedInSameApplyFailedDelete Failed: Removal not expected in Detail")
+	assert.Equal(test, "2", rd.Id(), "TestCreateTaskDependencyOperationCycleClosedInSameApplyFailedDelete Failed: Dependency not removed should stay in state")
+}
+
+func TestCreateTaskDependencyOperationRelatesToSkipsCycleCheck(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	dependency := makeTestOciTaskDependency(1, 1001, 1002)
+	relatesTo := ocitaskclient.OciTaskDependencyRelatesTo
+	dependency.Type = &relatesTo
+
+	ociTaskServClientMock.On("CreateTaskDependency", mock.Anything, mock.Anything).Return(&ocitaskclient.OciTaskServResponse{Dependency: &dependency}, nil).Once()
+	ociTaskServClientMock.On("GetTaskDependency", mock.Anything, dependency.Id).Return(&ocitaskclient.OciTaskServResponse{Dependency: &dependency}, nil).Once()
+
+	testData := map[string]interface{}{"blocker_id": 1001, "blocked_id": 1002, "type": relatesTo}
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskDependency().Schema, testData)
+
+	diags := ociTaskOperation.OciTaskDependencyCreate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestCreateTaskDependencyOperationRelatesToSkipsCycleCheck Failed: No Diagnostics expected")
+	assert.Equal(test, relatesTo, rd.Get("type"), "TestCreateTaskDependencyOperationRelatesToSkipsCycleCheck Failed: Wrong type")
+}
+
+func TestReadTaskDependencyOperationGone(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	ociTaskServClientMock.On("GetTaskDependency", mock.Anything, mock.Anything).Return(nil, &ocitaskclient.OciTaskServError{Operation: "GetTaskDependency", StatusCode: http.StatusNotFound}).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskDependency().Schema, map[string]interface{}{})
+	rd.SetId("1")
+
+	diags := ociTaskOperation.OciTaskDependencyRead(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 0, len(diags), "TestReadTaskDependencyOperationGone Failed: No Diagnostics expected")
+	assert.Equal(test, "", rd.Id(), "TestReadTaskDependencyOperationGone Failed: Dependency expected to be removed from state")
+}
+
+func TestDeleteTaskDependencyOperationFailed(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	ociTaskServClientMock.On("DeleteTaskDependency", mock.Anything, mock.Anything).Return(nil, errors.New("Delete Failed")).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskDependency().Schema, map[string]interface{}{})
+	rd.SetId("1")
+
+	diags := ociTaskOperation.OciTaskDependencyDelete(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 1, len(diags), "TestDeleteTaskDependencyOperationFailed Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Failed to delete task dependency", diags[0].Summary, "TestDeleteTaskDependencyOperationFailed Failed: Wrong Diagnostic Summary expected")
+	assert.Equal(test, "1", rd.Id(), "TestDeleteTaskDependencyOperationFailed Failed: Resource Id expected to be kept")
+}
+
+func TestCustomizeDiffDependencyCycle(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+
+	ociTaskServClientMock.On("ListTaskDependencies", mock.Anything, []int64{1002}).Return(&ocitaskclient.OciTaskServResponse{Dependencies: []ocitaskclient.OciTaskDependency{
+		makeTestOciTaskDependency(2, 1002, 1003),
+	}}, nil).Once()
+	ociTaskServClientMock.On("ListTaskDependencies", mock.Anything, []int64{1003}).Return(&ocitaskclient.OciTaskServResponse{Dependencies: []ocitaskclient.OciTaskDependency{
+		makeTestOciTaskDependency(3, 1003, 1001),
+	}}, nil).Once()
+
+	resource := MakeOciTaskResource().ResourceOciTaskDependency()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"blocker_id": 1001, "blocked_id": 1002})
+
+	_, err := resource.Diff(context.Background(), nil, config, &ociTaskServClientMock)
+
+	assert.Error(test, err, "TestCustomizeDiffDependencyCycle Failed: Error expected")
+	assert.Contains(test, err.Error(), "[1001 1002 1003 1001]", "TestCustomizeDiffDependencyCycle Failed: Wrong cycle")
+}
+
+func TestCustomizeDiffDependencyNewEdgesPassPlan(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+
+	// Neither 1001 blocks 1002 nor 1002 blocks 1001 is stored yet, so plan can't see the cycle they form
+	ociTaskServClientMock.On("ListTaskDependencies", mock.Anything, mock.Anything).Return(&ocitaskclient.OciTaskServResponse{}, nil)
+
+	resource := MakeOciTaskResource().ResourceOciTaskDependency()
+	_, errForward := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{"blocker_id": 1001, "blocked_id": 1002}), &ociTaskServClientMock)
+	_, errBackward := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(map[string]interface{}{"blocker_id": 1002, "blocked_id": 1001}), &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.NoError(test, errForward, "TestCustomizeDiffDependencyNewEdgesPassPlan Failed: No error expected, cycle is only caught at apply")
+	assert.NoError(test, errBackward, "TestCustomizeDiffDependencyNewEdgesPassPlan Failed: No error expected, cycle is only caught at apply")
+}
+
+func TestCustomizeDiffDependencySelf(test *testing.T) {
+	resource := MakeOciTaskResource().ResourceOciTaskDependency()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"blocker_id": 1001, "blocked_id": 1001, "type": "relates_to"})
+
+	_, err := resource.Diff(context.Background(), nil, config, nil)
+
+	assert.Error(test, err, "TestCustomizeDiffDependencySelf Failed: Error expected")
+	assert.Contains(test, err.Error(), "can't depend on itself", "TestCustomizeDiffDependencySelf Failed: Wrong error")
+}
+
+func TestReadTaskDependencyGraphOperationSuccess(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	hour := int64(3600 * 1000)
+	tasks := make(map[int64]ocitaskclient.OciTask)
+	for id, hours := range map[int64]int64{1001: 2, 1002: 1, 1003: 4} {
+		task := makeTestOciTask(id)
+		dueDate := *task.StartDate + hours*hour
+		task.DueDate = &dueDate
+		tasks[id] = task
+	}
+	for id := range tasks {
+		id := id
+		task := tasks[id]
+		ociTaskServClientMock.On("GetTask", mock.Anything, mock.MatchedBy(func(taskId *int64) bool { return *taskId == id })).Return(&ocitaskclient.OciTaskServResponse{Task: &task}, nil).Once()
+	}
+	ociTaskServClientMock.On("ListTaskDependencies", mock.Anything, []int64{1001, 1002, 1003}).Return(&ocitaskclient.OciTaskServResponse{Dependencies: []ocitaskclient.OciTaskDependency{
+		makeTestOciTaskDependency(1, 1001, 1002),
+		makeTestOciTaskDependency(2, 1001, 1003),
+		makeTestOciTaskDependency(3, 1003, 2001),
+	}}, nil).Once()
+
+	testData := map[string]interface{}{"task_ids": []interface{}{1003, 1001, 1002, 1001}}
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskDataSource().DataSourceOciTaskDependencyGraph().Schema, testData)
+
+	diags := ociTaskOperation.OciTaskDependencyGraphRead(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestReadTaskDependencyGraphOperationSuccess Failed: No Diagnostics expected")
+	assert.Equal(test, "1001,1002,1003", rd.Id(), "TestReadTaskDependencyGraphOperationSuccess Failed: Wrong data source Id")
+	assert.Equal(test, []interface{}{1001, 1002, 1003}, rd.Get("order"), "TestReadTaskDependencyGraphOperationSuccess Failed: Wrong order")
+	assert.Equal(test, []interface{}{1001, 1003}, rd.Get("critical_path"), "TestReadTaskDependencyGraphOperationSuccess Failed: Wrong critical path")
+	assert.Equal(test, 6.0, rd.Get("critical_path_duration_hours"), "TestReadTaskDependencyGraphOperationSuccess Failed: Wrong critical path duration")
+	assert.Equal(test, 2, rd.Get("dependencies.#"), "TestReadTaskDependencyGraphOperationSuccess Failed: Dependencies outside the set not expected")
+}
+
+func TestReadTaskDependencyGraphOperationFailedCycle(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	task := makeTestOciTask(1001)
+	ociTaskServClientMock.On("GetTask", mock.Anything, mock.Anything).Return(&ocitaskclient.OciTaskServResponse{Task: &task}, nil).Twice()
+	ociTaskServClientMock.On("ListTaskDependencies", mock.Anything, mock.Anything).Return(&ocitaskclient.OciTaskServResponse{Dependencies: []ocitaskclient.OciTaskDependency{
+		makeTestOciTaskDependency(1, 1001, 1002),
+		makeTestOciTaskDependency(2, 1002, 1001),
+	}}, nil).Once()
+
+	testData := map[string]interface{}{"task_ids": []interface{}{1001, 1002}}
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskDataSource().DataSourceOciTaskDependencyGraph().Schema, testData)
+
+	diags := ociTaskOperation.OciTaskDependencyGraphRead(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 1, len(diags), "TestReadTaskDependencyGraphOperationFailedCycle Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Invalid task dependency graph", diags[0].Summary, "TestReadTaskDependencyGraphOperationFailedCycle Failed: Wrong Diagnostic Summary expected")
+}
+
+func TestReadTaskDependencyGraphOperationFailedPlannedCycle(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	// Both dependencies are only configured, none is stored yet
+	testData := map[string]interface{}{
+		"task_ids": []interface{}{1001},
+		"planned_dependency": []interface{}{
+			map[string]interface{}{"blocker_id": 1001, "blocked_id": 1002},
+			map[string]interface{}{"blocker_id": 1002, "blocked_id": 1001},
+		},
+	}
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskDataSource().DataSourceOciTaskDependencyGraph().Schema, testData)
+
+	diags := ociTaskOperation.OciTaskDependencyGraphRead(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertNotCalled(test, "GetTask", mock.Anything, mock.Anything)
+
+	assert.Equal(test, 1, len(diags), "TestReadTaskDependencyGraphOperationFailedPlannedCycle Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Invalid planned task dependency", diags[0].Summary, "TestReadTaskDependencyGraphOperationFailedPlannedCycle Failed: Wrong Diagnostic Summary expected")
+	assert.Contains(test, diags[0].Detail, "[1001 1002 1001]", "TestReadTaskDependencyGraphOperationFailedPlannedCycle Failed: Cycle expected in Detail")
+}
+
+func TestReadTaskDependencyGraphOperationPlannedOrder(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	for _, id := range []int64{1001, 1002} {
+		id := id
+		task := makeTestOciTask(id)
+		ociTaskServClientMock.On("GetTask", mock.Anything, mock.MatchedBy(func(taskId *int64) bool { return *taskId == id })).Return(&ocitaskclient.OciTaskServResponse{Task: &task}, nil).Once()
+	}
+	ociTaskServClientMock.On("ListTaskDependencies", mock.Anything, []int64{1001}).Return(&ocitaskclient.OciTaskServResponse{}, nil).Once()
+	ociTaskServClientMock.On("ListTaskDependencies", mock.Anything, []int64{1001, 1002}).Return(&ocitaskclient.OciTaskServResponse{}, nil).Once()
+
+	testData := map[string]interface{}{
+		"task_ids": []interface{}{1001},
+		"planned_dependency": []interface{}{
+			map[string]interface{}{"blocker_id": 1002, "blocked_id": 1001},
+		},
+	}
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskDataSource().DataSourceOciTaskDependencyGraph().Schema, testData)
+
+	diags := ociTaskOperation.OciTaskDependencyGraphRead(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestReadTaskDependencyGraphOperationPlannedOrder Failed: No Diagnostics expected")
+	assert.Equal(test, "1001,1002", rd.Id(), "TestReadTaskDependencyGraphOperationPlannedOrder Failed: Tasks of planned dependency expected in graph")
+	assert.Equal(test, []interface{}{1002, 1001}, rd.Get("order"), "TestReadTaskDependencyGraphOperationPlannedOrder Failed: Planned dependency expected to order Tasks")
+	assert.Equal(test, 0, rd.Get("dependencies.#"), "TestReadTaskDependencyGraphOperationPlannedOrder Failed: Only stored dependencies expected")
+}
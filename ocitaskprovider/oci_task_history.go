@@ -0,0 +1,63 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"ocitaskclient"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/**
+ * @brief Read change history of Task in OCI Task System for history data source
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains Task Identifier defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskHistoryRead(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	taskId := int64(rd.Get("task_id").(int))
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskHistoryRead", strconv.FormatInt(taskId, 10))
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+	ociResponse, err := ociClient.GetTaskHistory(ctx, &taskId)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read task history",
+			Detail:   err.Error(),
+		})
+	} else if ociResponse.Err != nil {
+		ociErr, _ := ociResponse.Err.Serialize()
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read task history",
+			Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+		})
+	} else {
+		revisions, err := ocitaskclient.FlattenOciTaskHistory(ociResponse.Revisions)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to compute task history changes",
+				Detail:   err.Error(),
+			})
+		} else if err := rd.Set("revisions", revisions); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to set task history into resource data",
+				Detail:   err.Error(),
+			})
+		} else {
+			rd.SetId(strconv.FormatInt(taskId, 10))
+		}
+	}
+
+	return diags
+}
@@ -0,0 +1,56 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"ocitaskclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func makeTestOciTaskRevision(revision int64, status string) ocitaskclient.OciTaskRevision {
+	actor, title := "jdoe@example.com", "Test Task 1"
+	timeChanged := int64(1678899600000) + revision
+	return ocitaskclient.OciTaskRevision{Revision: &revision, Actor: &actor, TimeChanged: &timeChanged, Task: &ocitaskclient.OciTask{Title: &title, Status: &status}}
+}
+
+func TestReadTaskHistoryOperationSuccess(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	taskId := int64(1001)
+	revisions := []ocitaskclient.OciTaskRevision{makeTestOciTaskRevision(1, "todo"), makeTestOciTaskRevision(2, "done")}
+	ociTaskServClientMock.On("GetTaskHistory", mock.Anything, &taskId).Return(&ocitaskclient.OciTaskServResponse{Revisions: revisions}, nil).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskDataSource().DataSourceOciTaskHistory().Schema, map[string]interface{}{"task_id": 1001})
+
+	diags := ociTaskOperation.OciTaskHistoryRead(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestReadTaskHistoryOperationSuccess Failed: No Diagnostics expected")
+	assert.Equal(test, "1001", rd.Id(), "TestReadTaskHistoryOperationSuccess Failed: Wrong data source Id")
+	assert.Equal(test, 2, rd.Get("revisions.#"), "TestReadTaskHistoryOperationSuccess Failed: Two revisions expected")
+	assert.Equal(test, "jdoe@example.com", rd.Get("revisions.1.actor"), "TestReadTaskHistoryOperationSuccess Failed: Wrong actor")
+	assert.Equal(test, []interface{}{"status"}, rd.Get("revisions.1.changed_fields"), "TestReadTaskHistoryOperationSuccess Failed: Wrong changed fields")
+	assert.Equal(test, "todo", rd.Get("revisions.1.changes.0.old_value"), "TestReadTaskHistoryOperationSuccess Failed: Wrong old value")
+	assert.Equal(test, "done", rd.Get("revisions.1.changes.0.new_value"), "TestReadTaskHistoryOperationSuccess Failed: Wrong new value")
+}
+
+func TestReadTaskHistoryOperationFailure(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	code, message := 404, "Task not found"
+	ociTaskServClientMock.On("GetTaskHistory", mock.Anything, mock.Anything).Return(&ocitaskclient.OciTaskServResponse{Err: &ocitaskclient.OciError{ErrorCode: &code, ErrorMessage: &message}}, nil).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskDataSource().DataSourceOciTaskHistory().Schema, map[string]interface{}{"task_id": 1001})
+
+	diags := ociTaskOperation.OciTaskHistoryRead(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 1, len(diags), "TestReadTaskHistoryOperationFailure Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Failed to read task history", diags[0].Summary, "TestReadTaskHistoryOperationFailure Failed: Wrong Diagnostic Summary expected")
+	assert.Equal(test, "", rd.Id(), "TestReadTaskHistoryOperationFailure Failed: Id should not be set")
+}
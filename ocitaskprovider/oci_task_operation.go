@@ -2,6 +2,7 @@ package ocitaskprovider
 
 import (
 	"context"
+	"fmt"
 	"ocitaskclient"
 	"strconv"
 
@@ -35,18 +36,27 @@ func (ociTaskOperation *OciTaskOperation) OciTaskCreate(ctx context.Context, rd
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
+	ctx, span := startOciTaskSpan(ctx, "OciTaskCreate", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
 	items := rd.Get("items").([]interface{})
 	if len(items) > 0 {
-		ociRequest, err := ocitaskclient.MakeOciTaskServRequest(&items[0])
+		ociRequest, err := ociTaskOperation.makeOciTaskServRequest(rd, items[0], m)
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
 				Summary:  "Failed to make OCI Task Service Request",
 				Detail:   err.Error(),
 			})
+		} else if err := checkOciTaskCustomFields(ctx, rd, m); err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Invalid custom fields",
+				Detail:   err.Error(),
+			})
 		} else {
 			ociClient := m.(ocitaskclient.OciTaskServClientInterface)
-			ociResponse, err := ociClient.CreateTask(ociRequest)
+			ociResponse, err := ociClient.CreateTask(ctx, ociRequest)
 			if err != nil {
 				diags = append(diags, diag.Diagnostic{
 					Severity: diag.Error,
@@ -59,10 +69,11 @@ func (ociTaskOperation *OciTaskOperation) OciTaskCreate(ctx context.Context, rd
 					diags = append(diags, diag.Diagnostic{
 						Severity: diag.Error,
 						Summary:  "Failed to create task",
-						Detail:   ociErr,
+						Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
 					})
 				} else {
 					rd.SetId(strconv.FormatInt(*ociResponse.TaskId, 10))
+					diags = append(diags, syncOciTaskReminders(ctx, rd, *ociResponse.TaskId, m)...)
 					ociTaskOperation.OciTaskRead(ctx, rd, m)
 				}
 			}
@@ -78,6 +89,37 @@ func (ociTaskOperation *OciTaskOperation) OciTaskCreate(ctx context.Context, rd
 	return diags
 }
 
+/**
+ * @brief Build request to OCI Task Service from Task item. Merges default tags, resolves status and priority.
+ *			Checklist is only sent if it changed, so items added outside Terraform are kept otherwise.
+ * @param rd Resource data of Task resource
+ * @param item Task item from resource data
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Instance of OciTaskServRequest if succeeded
+ * @return Instance of error if failed
+ */
+func (ociTaskOperation *OciTaskOperation) makeOciTaskServRequest(rd *schema.ResourceData, item interface{}, m interface{}) (*ocitaskclient.OciTaskServRequest, error) {
+	item = withOciTaskDefaultTags(item, ociTaskDefaultTags(m))
+
+	item, err := withOciTaskStatus(rd, item)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err = withOciTaskPriority(item, ociTaskPriorityLevels(m))
+	if err != nil {
+		return nil, err
+	}
+
+	ociRequest, err := ocitaskclient.MakeOciTaskServRequest(&item)
+	if err == nil && rd.HasChange("checklist") {
+		checklist := expandOciTaskChecklist(rd)
+		ociRequest.Checklist = &checklist
+	}
+
+	return ociRequest, err
+}
+
 /**
  * @brief Update existing Task in OCI Task System
  * @param ctx Context to Terraform Provider
@@ -89,6 +131,9 @@ func (ociTaskOperation *OciTaskOperation) OciTaskUpdate(ctx context.Context, rd
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
+	ctx, span := startOciTaskSpan(ctx, "OciTaskUpdate", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
 	taskId, err := strconv.ParseInt(rd.Id(), 10, 64)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
@@ -96,19 +141,33 @@ func (ociTaskOperation *OciTaskOperation) OciTaskUpdate(ctx context.Context, rd
 			Summary:  "Failed to get Id from resource data",
 			Detail:   err.Error(),
 		})
+	} else if restoreDiags := restoreOciTask(ctx, rd, taskId, m); restoreDiags.HasError() {
+		diags = append(diags, restoreDiags...)
 	} else {
 		items := rd.Get("items").([]interface{})
 		if len(items) > 0 {
-			ociRequest, err := ocitaskclient.MakeOciTaskServRequest(&items[0])
+			ociRequest, err := ociTaskOperation.makeOciTaskServRequest(rd, items[0], m)
 			if err != nil {
 				diags = append(diags, diag.Diagnostic{
 					Severity: diag.Error,
 					Summary:  "Failed to make OCI Task Service Request",
 					Detail:   err.Error(),
 				})
+			} else if err := checkOciTaskReparent(ctx, rd, taskId, m); err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Invalid parent",
+					Detail:   err.Error(),
+				})
+			} else if err := checkOciTaskCustomFields(ctx, rd, m); err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Invalid custom fields",
+					Detail:   err.Error(),
+				})
 			} else {
 				ociClient := m.(ocitaskclient.OciTaskServClientInterface)
-				ociResponse, err := ociClient.UpdateTask(&taskId, ociRequest)
+				ociResponse, err := ociClient.UpdateTask(ctx, &taskId, ociRequest)
 				if err != nil {
 					diags = append(diags, diag.Diagnostic{
 						Severity: diag.Error,
@@ -121,10 +180,13 @@ func (ociTaskOperation *OciTaskOperation) OciTaskUpdate(ctx context.Context, rd
 						diags = append(diags, diag.Diagnostic{
 							Severity: diag.Error,
 							Summary:  "Failed to update task",
-							Detail:   ociErr,
+							Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
 						})
 					} else {
 						rd.SetId(strconv.FormatInt(*ociResponse.TaskId, 10))
+						if rd.HasChange("reminder") {
+							diags = append(diags, syncOciTaskReminders(ctx, rd, *ociResponse.TaskId, m)...)
+						}
 						ociTaskOperation.OciTaskRead(ctx, rd, m)
 					}
 				}
@@ -149,9 +211,26 @@ func (ociTaskOperation *OciTaskOperation) OciTaskUpdate(ctx context.Context, rd
  * @return Collection of diag.Diagnostics instances if failed, otherwise empty
  */
 func (ociTaskOperation *OciTaskOperation) OciTaskRead(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	return ociTaskOperation.readTask(ctx, rd, m, true)
+}
+
+/**
+ * @brief Read Task in OCI Task System and set it into resource data
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains Task Identifier defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @param forResource True to set Task into Task resource: effective tags go to tags_all, items keep only tags owned
+ *			by Task and priority in configured form, reminders and checklist are read along, Task gone in OCI Task System is removed from state.
+ *			False to set Task into data source as returned by OCI Task Service.
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) readTask(ctx context.Context, rd *schema.ResourceData, m interface{}, forResource bool) diag.Diagnostics {
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
+	ctx, span := startOciTaskSpan(ctx, "OciTaskRead", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
 	taskId, err := strconv.ParseInt(rd.Id(), 10, 64)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
@@ -161,8 +240,10 @@ func (ociTaskOperation *OciTaskOperation) OciTaskRead(ctx context.Context, rd *s
 		})
 	} else {
 		ociClient := m.(ocitaskclient.OciTaskServClientInterface)
-		ociResponse, err := ociClient.GetTask(&taskId)
-		if err != nil {
+		ociResponse, err := ociClient.GetTask(ctx, &taskId)
+		if forResource && ocitaskclient.IsOciTaskNotFound(err) {
+			rd.SetId("")
+		} else if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
 				Summary:  "Failed to read task",
@@ -174,11 +255,28 @@ func (ociTaskOperation *OciTaskOperation) OciTaskRead(ctx context.Context, rd *s
 				diags = append(diags, diag.Diagnostic{
 					Severity: diag.Error,
 					Summary:  "Failed to read task",
-					Detail:   ociErr,
+					Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
 				})
 			} else {
 				ociTasks, flatDiag := ocitaskclient.FlattenOciTask(ociResponse.Task)
 				if len(flatDiag) == 0 {
+					ociTask := ociTasks[0].(map[string]interface{})
+					priorities := ociTaskPriorityLevels(m)
+					ociTask["priority_name"] = ociTaskPriorityName(ociResponse.Task.Priority, priorities)
+					diags = append(diags, setOciTaskEffort(ctx, rd, ociTask, ociResponse.Task, m)...)
+
+					if forResource {
+						diags = append(diags, setOciTaskTags(rd, ociTask, ociResponse.Task.Tags, ociTaskDefaultTags(m))...)
+						ociTask["priority"] = reconcileOciTaskPriority(rd.Get("items.0.priority").(string), ociResponse.Task.Priority, priorities)
+						ociTask["assignee"] = reconcileOciTaskAssignee(rd.Get("items.0.assignee").(string), ociTask["assignee"].(string))
+						ociTask["watchers"] = reconcileOciTaskWatchers(ocitaskclient.ExpandOciTaskStringList(rd.Get("items.0.watchers")), ociResponse.Task.Watchers)
+						diags = append(diags, readOciTaskReminders(ctx, rd, taskId, ociResponse.Task.DueDate, m)...)
+						diags = append(diags, readOciTaskChecklist(rd, ociResponse.Task)...)
+						diags = append(diags, setOciTaskResourceArchived(rd, ociResponse.Task)...)
+					} else {
+						setOciTaskArchived(ociTask, ociResponse.Task)
+					}
+
 					err := rd.Set("items", ociTasks)
 					if err != nil {
 						diags = append(diags, diag.Diagnostic{
@@ -198,7 +296,7 @@ func (ociTaskOperation *OciTaskOperation) OciTaskRead(ctx context.Context, rd *s
 }
 
 /**
- * @brief Delete Task in OCI Task System
+ * @brief Delete or archive Task in OCI Task System following its deletion mode. Refuses if deletion protection is enabled.
  * @param ctx Context to Terraform Provider
  * @param rd Contains Task Identifier defined in Terraform scripts
  * @param m Contains OCI Task Service Client pluged into Terraform Provider
@@ -208,6 +306,9 @@ func (ociTaskOperation *OciTaskOperation) OciTaskDelete(ctx context.Context, rd
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
+	ctx, span := startOciTaskSpan(ctx, "OciTaskDelete", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
 	taskId, err := strconv.ParseInt(rd.Id(), 10, 64)
 	if err != nil {
 		diags = append(diags, diag.Diagnostic{
@@ -215,13 +316,27 @@ func (ociTaskOperation *OciTaskOperation) OciTaskDelete(ctx context.Context, rd
 			Summary:  "Failed to get Id from resource data",
 			Detail:   err.Error(),
 		})
+	} else if rd.Get("deletion_protection").(bool) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Task is protected from deletion",
+			Detail:   fmt.Sprintf("Task %d has deletion_protection enabled. Set deletion_protection = false and apply before destroying it.", taskId),
+		})
 	} else {
 		ociClient := m.(ocitaskclient.OciTaskServClientInterface)
-		ociResponse, err := ociClient.DeleteTask(&taskId)
+		summary := "Failed to delete task"
+		var ociResponse *ocitaskclient.OciTaskServResponse
+		if rd.Get("deletion_mode").(string) == OciTaskDeletionModeArchive {
+			summary = "Failed to archive task"
+			ociResponse, err = ociClient.ArchiveTask(ctx, &taskId)
+		} else {
+			ociResponse, err = ociClient.DeleteTask(ctx, &taskId)
+		}
+
 		if err != nil {
 			diags = append(diags, diag.Diagnostic{
 				Severity: diag.Error,
-				Summary:  "Failed to delete task",
+				Summary:  summary,
 				Detail:   err.Error(),
 			})
 		} else {
@@ -229,8 +344,8 @@ func (ociTaskOperation *OciTaskOperation) OciTaskDelete(ctx context.Context, rd
 				ociErr, _ := ociResponse.Err.Serialize()
 				diags = append(diags, diag.Diagnostic{
 					Severity: diag.Error,
-					Summary:  "Failed to delete task",
-					Detail:   ociErr,
+					Summary:  summary,
+					Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
 				})
 			} else {
 				rd.SetId("")
@@ -240,3 +355,121 @@ func (ociTaskOperation *OciTaskOperation) OciTaskDelete(ctx context.Context, rd
 
 	return diags
 }
+
+/**
+ * @brief Read Tasks in OCI Task System for data source.
+ *			Reads single Task if Identifier is set, lists Tasks matching filters otherwise.
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains Task Identifier or filters defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTasksRead(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	if taskId, ok := rd.GetOk("id"); ok {
+		rd.SetId(strconv.Itoa(taskId.(int)))
+		return ociTaskOperation.readTask(ctx, rd, m, false)
+	}
+
+	ctx, span := startOciTaskSpan(ctx, "OciTasksRead", "")
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	filter := ocitaskclient.MakeOciTaskListFilter()
+	filter.Tags = ocitaskclient.ExpandOciTaskStringMap(rd.Get("tags"))
+	if projectId, ok := rd.GetOk("project_id"); ok {
+		projectId64 := int64(projectId.(int))
+		filter.ProjectId = &projectId64
+	}
+	filter.Assignee = rd.Get("assignee").(string)
+	filter.CustomFields = ocitaskclient.ExpandOciTaskStringMap(rd.Get("custom_fields"))
+
+	diags = append(diags, listOciTasks(ctx, rd, filter, m)...)
+
+	return diags
+}
+
+/**
+ * @brief Read archived Tasks in OCI Task System for archived Tasks data source
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains filters defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciArchivedTasksRead(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciArchivedTasksRead", "")
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	filter := ocitaskclient.MakeOciTaskListFilter()
+	filter.Archived = true
+	filter.Tags = ocitaskclient.ExpandOciTaskStringMap(rd.Get("tags"))
+	if projectId, ok := rd.GetOk("project_id"); ok {
+		projectId64 := int64(projectId.(int))
+		filter.ProjectId = &projectId64
+	}
+
+	diags = append(diags, listOciTasks(ctx, rd, filter, m)...)
+
+	return diags
+}
+
+/**
+ * @brief List Tasks matching filter and set them into items of data source
+ * @param ctx Context to Terraform Provider
+ * @param rd Resource data of data source
+ * @param filter Instance of OciTaskListFilter
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func listOciTasks(ctx context.Context, rd *schema.ResourceData, filter *ocitaskclient.OciTaskListFilter, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+	ociResponse, err := ociClient.ListTasks(ctx, filter)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to list tasks",
+			Detail:   err.Error(),
+		})
+	} else if ociResponse.Err != nil {
+		ociErr, _ := ociResponse.Err.Serialize()
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to list tasks",
+			Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+		})
+	} else {
+		items := make([]interface{}, 0, len(ociResponse.Tasks))
+		priorities := ociTaskPriorityLevels(m)
+		for i := range ociResponse.Tasks {
+			ociTasks, flatDiag := ocitaskclient.FlattenOciTask(&ociResponse.Tasks[i])
+			diags = append(diags, flatDiag...)
+			for _, ociTask := range ociTasks {
+				ociTask.(map[string]interface{})["priority_name"] = ociTaskPriorityName(ociResponse.Tasks[i].Priority, priorities)
+				setOciTaskArchived(ociTask.(map[string]interface{}), &ociResponse.Tasks[i])
+			}
+			items = append(items, ociTasks...)
+		}
+
+		if !diags.HasError() {
+			err := rd.Set("items", items)
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to set tasks into resource data",
+					Detail:   err.Error(),
+				})
+			} else {
+				rd.SetId(strconv.Itoa(schema.HashString(filter.QueryString())))
+			}
+		}
+	}
+
+	return diags
+}
@@ -1,8 +1,11 @@
 package ocitaskprovider
 
 import (
+	"context"
 	"errors"
+	"net/http"
 	"ocitaskclient"
+	"strconv"
 	"testing"
 	"time"
 
@@ -64,10 +67,10 @@ func TestCreateTaskOperationSuccess(test *testing.T) {
 
 	rd := schema.TestResourceDataRaw(test, testSchema.Schema, testData)
 
-	ociTaskServClientMock.On("CreateTask", mock.Anything).Return(&createResponse, nil).Once()
-	ociTaskServClientMock.On("GetTask", createResponse.TaskId).Return(&readResponse, nil).Once()
+	ociTaskServClientMock.On("CreateTask", mock.Anything, mock.Anything).Return(&createResponse, nil).Once()
+	ociTaskServClientMock.On("GetTask", mock.Anything, createResponse.TaskId).Return(&readResponse, nil).Once()
 
-	diags := ociTaskOperation.OciTaskCreate(nil, rd, &ociTaskServClientMock)
+	diags := ociTaskOperation.OciTaskCreate(context.Background(), rd, &ociTaskServClientMock)
 
 	ociTaskServClientMock.AssertExpectations(test)
 
@@ -85,7 +88,7 @@ func TestCreateTaskOperationSuccess(test *testing.T) {
 	assert.Equal(test, 1001, destTask["id"].(int), "TestCreateTaskOperationSuccess Failed: Task Id doesn't match with Task Id after Read")
 	assert.Equal(test, title, destTask["title"].(string), "TestCreateTaskOperationSuccess Failed: Task Title doesn't match with Task Title after Read")
 	assert.Equal(test, desc, destTask["description"].(string), "TestCreateTaskOperationSuccess Failed: Task Description doesn't match with Task Description after Read")
-	assert.Equal(test, priority, destTask["priority"].(int), "TestCreateTaskOperationSuccess Failed: Task Priority doesn't match with Task Priority after Read")
+	assert.Equal(test, strconv.Itoa(priority), destTask["priority"].(string), "TestCreateTaskOperationSuccess Failed: Task Priority doesn't match with Task Priority after Read")
 	assert.Equal(test, completed, destTask["completed"].(bool), "TestCreateTaskOperationSuccess Failed: Task Completed doesn't match with Task Completed after Read")
 	assert.Equal(test, srcTask["start_date"], destTask["start_date"].(string), "TestCreateTaskOperationSuccess Failed: Task StartDate doesn't match with Task StartDate after Read")
 	assert.Equal(test, srcTask["due_date"], destTask["due_date"].(string), "TestCreateTaskOperationSuccess Failed: Task DueDate doesn't match with Task DueDate after Read")
@@ -107,7 +110,7 @@ func TestCreateTaskOperationFailedEmptyItems(test *testing.T) {
 
 	rd := schema.TestResourceDataRaw(test, testSchema.Schema, testData)
 
-	diags := ociTaskOperation.OciTaskCreate(nil, rd, &ociTaskServClientMock)
+	diags := ociTaskOperation.OciTaskCreate(context.Background(), rd, &ociTaskServClientMock)
 
 	assert.Equal(test, 1, len(diags), "TestCreateTaskOperationFailedEmptyItems Failed: One Diagnostic instance expected")
 	assert.Equal(test, diag.Error, diags[0].Severity, "TestCreateTaskOperationFailedEmptyItems Failed: Wrong Diagnostic Severity expected")
@@ -149,9 +152,9 @@ func TestCreateTaskOperationFailedCreateTask(test *testing.T) {
 
 	rd := schema.TestResourceDataRaw(test, testSchema.Schema, testData)
 
-	ociTaskServClientMock.On("CreateTask", mock.Anything).Return(nil, errors.New("Create Task Failed")).Once()
+	ociTaskServClientMock.On("CreateTask", mock.Anything, mock.Anything).Return(nil, errors.New("Create Task Failed")).Once()
 
-	diags := ociTaskOperation.OciTaskCreate(nil, rd, &ociTaskServClientMock)
+	diags := ociTaskOperation.OciTaskCreate(context.Background(), rd, &ociTaskServClientMock)
 
 	ociTaskServClientMock.AssertExpectations(test)
 
@@ -204,9 +207,9 @@ func TestCreateTaskOperationFailedBadResponse(test *testing.T) {
 
 	rd := schema.TestResourceDataRaw(test, testSchema.Schema, testData)
 
-	ociTaskServClientMock.On("CreateTask", mock.Anything).Return(&createResponse, nil).Once()
+	ociTaskServClientMock.On("CreateTask", mock.Anything, mock.Anything).Return(&createResponse, nil).Once()
 
-	diags := ociTaskOperation.OciTaskCreate(nil, rd, &ociTaskServClientMock)
+	diags := ociTaskOperation.OciTaskCreate(context.Background(), rd, &ociTaskServClientMock)
 
 	ociTaskServClientMock.AssertExpectations(test)
 
@@ -273,10 +276,10 @@ func TestUpdateTaskOperationSuccess(test *testing.T) {
 	rd := schema.TestResourceDataRaw(test, testSchema.Schema, testData)
 	rd.SetId("1001")
 
-	ociTaskServClientMock.On("UpdateTask", updateResponse.TaskId, mock.Anything).Return(&updateResponse, nil).Once()
-	ociTaskServClientMock.On("GetTask", updateResponse.TaskId).Return(&readResponse, nil).Once()
+	ociTaskServClientMock.On("UpdateTask", mock.Anything, updateResponse.TaskId, mock.Anything).Return(&updateResponse, nil).Once()
+	ociTaskServClientMock.On("GetTask", mock.Anything, updateResponse.TaskId).Return(&readResponse, nil).Once()
 
-	diags := ociTaskOperation.OciTaskUpdate(nil, rd, &ociTaskServClientMock)
+	diags := ociTaskOperation.OciTaskUpdate(context.Background(), rd, &ociTaskServClientMock)
 
 	ociTaskServClientMock.AssertExpectations(test)
 
@@ -294,7 +297,7 @@ func TestUpdateTaskOperationSuccess(test *testing.T) {
 	assert.Equal(test, 1001, destTask["id"].(int), "TestUpdateTaskOperationSuccess Failed: Task Id doesn't match with Task Id after Read")
 	assert.Equal(test, title, destTask["title"].(string), "TestUpdateTaskOperationSuccess Failed: Task Title doesn't match with Task Title after Read")
 	assert.Equal(test, desc, destTask["description"].(string), "TestUpdateTaskOperationSuccess Failed: Task Description doesn't match with Task Description after Read")
-	assert.Equal(test, priority, destTask["priority"].(int), "TestUpdateTaskOperationSuccess Failed: Task Priority doesn't match with Task Priority after Read")
+	assert.Equal(test, strconv.Itoa(priority), destTask["priority"].(string), "TestUpdateTaskOperationSuccess Failed: Task Priority doesn't match with Task Priority after Read")
 	assert.Equal(test, completed, destTask["completed"].(bool), "TestUpdateTaskOperationSuccess Failed: Task Completed doesn't match with Task Completed after Read")
 	assert.Equal(test, srcTask["start_date"], destTask["start_date"].(string), "TestUpdateTaskOperationSuccess Failed: Task StartDate doesn't match with Task StartDate after Read")
 	assert.Equal(test, srcTask["due_date"], destTask["due_date"].(string), "TestUpdateTaskOperationSuccess Failed: Task DueDate doesn't match with Task DueDate after Read")
@@ -317,7 +320,7 @@ func TestUpdateTaskOperationFailedEmptyItems(test *testing.T) {
 	rd := schema.TestResourceDataRaw(test, testSchema.Schema, testData)
 	rd.SetId("1001")
 
-	diags := ociTaskOperation.OciTaskUpdate(nil, rd, &ociTaskServClientMock)
+	diags := ociTaskOperation.OciTaskUpdate(context.Background(), rd, &ociTaskServClientMock)
 
 	assert.Equal(test, 1, len(diags), "TestUpdateTaskOperationFailedEmptyItems Failed: One Diagnostic instance expected")
 	assert.Equal(test, diag.Error, diags[0].Severity, "TestUpdateTaskOperationFailedEmptyItems Failed: Wrong Diagnostic Severity expected")
@@ -339,7 +342,7 @@ func TestUpdateTaskOperationFailedNoId(test *testing.T) {
 
 	rd := schema.TestResourceDataRaw(test, testSchema.Schema, testData)
 
-	diags := ociTaskOperation.OciTaskUpdate(nil, rd, &ociTaskServClientMock)
+	diags := ociTaskOperation.OciTaskUpdate(context.Background(), rd, &ociTaskServClientMock)
 
 	assert.Equal(test, 1, len(diags), "TestUpdateTaskOperationFailedNoId Failed: One Diagnostic instance expected")
 	assert.Equal(test, diag.Error, diags[0].Severity, "TestUpdateTaskOperationFailedNoId Failed: Wrong Diagnostic Severity expected")
@@ -383,9 +386,9 @@ func TestCreateTaskOperationFailedUpdateTask(test *testing.T) {
 	rd := schema.TestResourceDataRaw(test, testSchema.Schema, testData)
 	rd.SetId("1001")
 
-	ociTaskServClientMock.On("UpdateTask", &taskId, mock.Anything).Return(nil, errors.New("Update Task Failed")).Once()
+	ociTaskServClientMock.On("UpdateTask", mock.Anything, &taskId, mock.Anything).Return(nil, errors.New("Update Task Failed")).Once()
 
-	diags := ociTaskOperation.OciTaskUpdate(nil, rd, &ociTaskServClientMock)
+	diags := ociTaskOperation.OciTaskUpdate(context.Background(), rd, &ociTaskServClientMock)
 
 	ociTaskServClientMock.AssertExpectations(test)
 
@@ -441,9 +444,9 @@ func TestUpdateTaskOperationFailedBadResponse(test *testing.T) {
 	rd := schema.TestResourceDataRaw(test, testSchema.Schema, testData)
 	rd.SetId("1001")
 
-	ociTaskServClientMock.On("UpdateTask", &taskId, mock.Anything).Return(&createResponse, nil).Once()
+	ociTaskServClientMock.On("UpdateTask", mock.Anything, &taskId, mock.Anything).Return(&createResponse, nil).Once()
 
-	diags := ociTaskOperation.OciTaskUpdate(nil, rd, &ociTaskServClientMock)
+	diags := ociTaskOperation.OciTaskUpdate(context.Background(), rd, &ociTaskServClientMock)
 
 	ociTaskServClientMock.AssertExpectations(test)
 
@@ -492,9 +495,9 @@ func TestReadTaskOperationSuccess(test *testing.T) {
 	rd := schema.TestResourceDataRaw(test, testSchema.Schema, testData)
 	rd.SetId("1001")
 
-	ociTaskServClientMock.On("GetTask", &taskId).Return(&readResponse, nil).Once()
+	ociTaskServClientMock.On("GetTask", mock.Anything, &taskId).Return(&readResponse, nil).Once()
 
-	diags := ociTaskOperation.OciTaskRead(nil, rd, &ociTaskServClientMock)
+	diags := ociTaskOperation.OciTaskRead(context.Background(), rd, &ociTaskServClientMock)
 
 	ociTaskServClientMock.AssertExpectations(test)
 
@@ -512,7 +515,7 @@ func TestReadTaskOperationSuccess(test *testing.T) {
 	assert.Equal(test, 1001, destTask["id"].(int), "TestReadTaskOperationSuccess Failed: Task Id doesn't match with Task Id after Read")
 	assert.Equal(test, title, destTask["title"].(string), "TestReadTaskOperationSuccess Failed: Task Title doesn't match with Task Title after Read")
 	assert.Equal(test, desc, destTask["description"].(string), "TestReadTaskOperationSuccess Failed: Task Description doesn't match with Task Description after Read")
-	assert.Equal(test, priority, destTask["priority"].(int), "TestReadTaskOperationSuccess Failed: Task Priority doesn't match with Task Priority after Read")
+	assert.Equal(test, strconv.Itoa(priority), destTask["priority"].(string), "TestReadTaskOperationSuccess Failed: Task Priority doesn't match with Task Priority after Read")
 	assert.Equal(test, completed, destTask["completed"].(bool), "TestReadTaskOperationSuccess Failed: Task Completed doesn't match with Task Completed after Read")
 	assert.Equal(test, time.UnixMilli(startDate).Format("yyyy-MM-dd"), destTask["start_date"].(string), "TestReadTaskOperationSuccess Failed: Task StartDate doesn't match with Task StartDate after Read")
 	assert.Equal(test, time.UnixMilli(dueDate).Format("yyyy-MM-dd"), destTask["due_date"].(string), "TestReadTaskOperationSuccess Failed: Task DueDate doesn't match with Task DueDate after Read")
@@ -531,7 +534,7 @@ func TestReadTaskOperationFailedBadId(test *testing.T) {
 
 	rd := schema.TestResourceDataRaw(test, testSchema.Schema, testData)
 
-	diags := ociTaskOperation.OciTaskRead(nil, rd, &ociTaskServClientMock)
+	diags := ociTaskOperation.OciTaskRead(context.Background(), rd, &ociTaskServClientMock)
 
 	assert.Equal(test, 1, len(diags), "TestReadTaskOperationFailedBadId Failed: One Diagnostic instance expected")
 	assert.Equal(test, diag.Error, diags[0].Severity, "TestReadTaskOperationFailedBadId Failed: Wrong Diagnostic Severity expected")
@@ -552,9 +555,9 @@ func TestReadTaskOperationFailedGetTask(test *testing.T) {
 	rd := schema.TestResourceDataRaw(test, testSchema.Schema, testData)
 	rd.SetId("1001")
 
-	ociTaskServClientMock.On("GetTask", &taskId).Return(nil, errors.New("Get Task Failed")).Once()
+	ociTaskServClientMock.On("GetTask", mock.Anything, &taskId).Return(nil, errors.New("Get Task Failed")).Once()
 
-	diags := ociTaskOperation.OciTaskRead(nil, rd, &ociTaskServClientMock)
+	diags := ociTaskOperation.OciTaskRead(context.Background(), rd, &ociTaskServClientMock)
 
 	ociTaskServClientMock.AssertExpectations(test)
 
@@ -564,6 +567,30 @@ func TestReadTaskOperationFailedGetTask(test *testing.T) {
 	assert.Equal(test, "Get Task Failed", diags[0].Detail, "TestReadTaskOperationFailedGetTask Failed: Wrong Diagnostic Detail expected")
 }
 
+func TestReadTaskOperationTaskGone(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	taskId := int64(1001)
+
+	ociTaskResource := MakeOciTaskResource()
+	testSchema := ociTaskResource.ResourceOciTask()
+
+	testData := make(map[string]interface{})
+
+	rd := schema.TestResourceDataRaw(test, testSchema.Schema, testData)
+	rd.SetId("1001")
+
+	ociTaskServClientMock.On("GetTask", mock.Anything, &taskId).Return(nil, &ocitaskclient.OciTaskServError{Operation: "GetTask", StatusCode: http.StatusNotFound}).Once()
+
+	diags := ociTaskOperation.OciTaskRead(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestReadTaskOperationTaskGone Failed: No Diagnostics expected")
+	assert.Equal(test, "", rd.Id(), "TestReadTaskOperationTaskGone Failed: Resource should be removed from state")
+}
+
 func TestReadTaskOperationFailedBadResponse(test *testing.T) {
 	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
 	ociTaskOperation := OciTaskOperation{}
@@ -586,9 +613,9 @@ func TestReadTaskOperationFailedBadResponse(test *testing.T) {
 	rd := schema.TestResourceDataRaw(test, testSchema.Schema, testData)
 	rd.SetId("1001")
 
-	ociTaskServClientMock.On("GetTask", &taskId).Return(&readResponse, nil).Once()
+	ociTaskServClientMock.On("GetTask", mock.Anything, &taskId).Return(&readResponse, nil).Once()
 
-	diags := ociTaskOperation.OciTaskRead(nil, rd, &ociTaskServClientMock)
+	diags := ociTaskOperation.OciTaskRead(context.Background(), rd, &ociTaskServClientMock)
 
 	ociTaskServClientMock.AssertExpectations(test)
 
@@ -601,6 +628,74 @@ func TestReadTaskOperationFailedBadResponse(test *testing.T) {
 	assert.Equal(test, apiErr, diags[0].Detail, "TestReadTaskOperationFailedBadResponse Failed: Wrong Diagnostic Detail expected")
 }
 
+func TestReadTaskOperationFailedBadResponseWithRequestIds(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	taskId := int64(1001)
+	errCode := 501
+	errMsg := "Internal Error"
+	ociErr := ocitaskclient.OciError{}
+	ociErr.ErrorCode = &errCode
+	ociErr.ErrorMessage = &errMsg
+
+	readResponse := ocitaskclient.OciTaskServResponse{}
+	readResponse.Err = &ociErr
+	readResponse.ClientRequestId = "client-1001"
+	readResponse.ServerRequestId = "server-1001"
+
+	ociTaskResource := MakeOciTaskResource()
+	testSchema := ociTaskResource.ResourceOciTask()
+
+	testData := make(map[string]interface{})
+
+	rd := schema.TestResourceDataRaw(test, testSchema.Schema, testData)
+	rd.SetId("1001")
+
+	ociTaskServClientMock.On("GetTask", mock.Anything, &taskId).Return(&readResponse, nil).Once()
+
+	diags := ociTaskOperation.OciTaskRead(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 1, len(diags), "TestReadTaskOperationFailedBadResponseWithRequestIds Failed: One Diagnostic instance expected")
+
+	apiErr, _ := ociErr.Serialize()
+
+	assert.Equal(test, apiErr+", client request id: client-1001, server request id: server-1001", diags[0].Detail, "TestReadTaskOperationFailedBadResponseWithRequestIds Failed: Wrong Diagnostic Detail expected")
+}
+
+func TestReadTaskOperationFailedGetTaskWithRequestIds(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	taskId := int64(1001)
+	servErr := ocitaskclient.OciTaskServError{
+		Operation:       "GetTask",
+		StatusCode:      500,
+		Body:            "Internal Error",
+		ClientRequestId: "client-1001",
+		ServerRequestId: "server-1001",
+	}
+
+	ociTaskResource := MakeOciTaskResource()
+	testSchema := ociTaskResource.ResourceOciTask()
+
+	testData := make(map[string]interface{})
+
+	rd := schema.TestResourceDataRaw(test, testSchema.Schema, testData)
+	rd.SetId("1001")
+
+	ociTaskServClientMock.On("GetTask", mock.Anything, &taskId).Return(nil, &servErr).Once()
+
+	diags := ociTaskOperation.OciTaskRead(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 1, len(diags), "TestReadTaskOperationFailedGetTaskWithRequestIds Failed: One Diagnostic instance expected")
+	assert.Equal(test, "GetTask failed - status: 500, body: Internal Error, client request id: client-1001, server request id: server-1001", diags[0].Detail, "TestReadTaskOperationFailedGetTaskWithRequestIds Failed: Wrong Diagnostic Detail expected")
+}
+
 func TestReadTaskOperationFailedFlatten(test *testing.T) {
 	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
 	ociTaskOperation := OciTaskOperation{}
@@ -617,9 +712,9 @@ func TestReadTaskOperationFailedFlatten(test *testing.T) {
 	rd := schema.TestResourceDataRaw(test, testSchema.Schema, testData)
 	rd.SetId("1001")
 
-	ociTaskServClientMock.On("GetTask", &taskId).Return(&readResponse, nil).Once()
+	ociTaskServClientMock.On("GetTask", mock.Anything, &taskId).Return(&readResponse, nil).Once()
 
-	diags := ociTaskOperation.OciTaskRead(nil, rd, &ociTaskServClientMock)
+	diags := ociTaskOperation.OciTaskRead(context.Background(), rd, &ociTaskServClientMock)
 
 	ociTaskServClientMock.AssertExpectations(test)
 
@@ -645,9 +740,9 @@ func TestDeleteTaskOperationSuccess(test *testing.T) {
 	rd := schema.TestResourceDataRaw(test, testSchema.Schema, testData)
 	rd.SetId("1001")
 
-	ociTaskServClientMock.On("DeleteTask", &taskId).Return(&deleteResponse, nil).Once()
+	ociTaskServClientMock.On("DeleteTask", mock.Anything, &taskId).Return(&deleteResponse, nil).Once()
 
-	diags := ociTaskOperation.OciTaskDelete(nil, rd, &ociTaskServClientMock)
+	diags := ociTaskOperation.OciTaskDelete(context.Background(), rd, &ociTaskServClientMock)
 
 	ociTaskServClientMock.AssertExpectations(test)
 
@@ -665,7 +760,7 @@ func TestDeleteTaskOperationFailedBadId(test *testing.T) {
 
 	rd := schema.TestResourceDataRaw(test, testSchema.Schema, testData)
 
-	diags := ociTaskOperation.OciTaskDelete(nil, rd, &ociTaskServClientMock)
+	diags := ociTaskOperation.OciTaskDelete(context.Background(), rd, &ociTaskServClientMock)
 
 	assert.Equal(test, 1, len(diags), "TestDeleteTaskOperationFailedBadId Failed: One Diagnostic instance expected")
 	assert.Equal(test, diag.Error, diags[0].Severity, "TestDeleteTaskOperationFailedBadId Failed: Wrong Diagnostic Severity expected")
@@ -686,9 +781,9 @@ func TestDeleteTaskOperationFailedDeleteTask(test *testing.T) {
 	rd := schema.TestResourceDataRaw(test, testSchema.Schema, testData)
 	rd.SetId("1001")
 
-	ociTaskServClientMock.On("DeleteTask", &taskId).Return(nil, errors.New("Failed to delete task")).Once()
+	ociTaskServClientMock.On("DeleteTask", mock.Anything, &taskId).Return(nil, errors.New("Failed to delete task")).Once()
 
-	diags := ociTaskOperation.OciTaskDelete(nil, rd, &ociTaskServClientMock)
+	diags := ociTaskOperation.OciTaskDelete(context.Background(), rd, &ociTaskServClientMock)
 
 	ociTaskServClientMock.AssertExpectations(test)
 
@@ -720,9 +815,9 @@ func TestDeleteTaskOperationFailedBadResponse(test *testing.T) {
 	rd := schema.TestResourceDataRaw(test, testSchema.Schema, testData)
 	rd.SetId("1001")
 
-	ociTaskServClientMock.On("DeleteTask", &taskId).Return(&deleteResponse, nil).Once()
+	ociTaskServClientMock.On("DeleteTask", mock.Anything, &taskId).Return(&deleteResponse, nil).Once()
 
-	diags := ociTaskOperation.OciTaskDelete(nil, rd, &ociTaskServClientMock)
+	diags := ociTaskOperation.OciTaskDelete(context.Background(), rd, &ociTaskServClientMock)
 
 	ociTaskServClientMock.AssertExpectations(test)
 
@@ -734,3 +829,148 @@ func TestDeleteTaskOperationFailedBadResponse(test *testing.T) {
 
 	assert.Equal(test, apiErr, diags[0].Detail, "TestDeleteTaskOperationFailedBadResponse Failed: Wrong Diagnostic Detail expected")
 }
+
+func TestReadTaskOperationKeepsConfiguredTagCase(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	task := makeTestOciTask(1001)
+	task.Tags = map[string]string{"team": "Platform", "sprint": "42"}
+
+	readResponse := ocitaskclient.OciTaskServResponse{}
+	readResponse.Task = &task
+
+	srcTask := make(map[string]interface{})
+	srcTask["title"] = *task.Title
+	srcTask["tags"] = map[string]interface{}{"Team": "Platform"}
+
+	ociTaskResource := MakeOciTaskResource()
+	testSchema := ociTaskResource.ResourceOciTask()
+
+	testData := make(map[string]interface{})
+	testData["items"] = []interface{}{srcTask}
+
+	rd := schema.TestResourceDataRaw(test, testSchema.Schema, testData)
+	rd.SetId("1001")
+
+	ociTaskServClientMock.On("GetTask", mock.Anything, task.Id).Return(&readResponse, nil).Once()
+
+	diags := ociTaskOperation.OciTaskRead(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestReadTaskOperationKeepsConfiguredTagCase Failed: No Diagnostics expected")
+
+	tags := rd.Get("items.0.tags").(map[string]interface{})
+
+	assert.Equal(test, map[string]interface{}{"Team": "Platform", "sprint": "42"}, tags, "TestReadTaskOperationKeepsConfiguredTagCase Failed: Configured tag key casing expected")
+}
+
+func TestReadTasksOperationById(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	task := makeTestOciTask(1001)
+
+	readResponse := ocitaskclient.OciTaskServResponse{}
+	readResponse.Task = &task
+
+	ociTaskDataSource := MakeOciTaskDataSource()
+	testSchema := ociTaskDataSource.DataSourceOciTasks()
+
+	testData := make(map[string]interface{})
+	testData["id"] = 1001
+
+	rd := schema.TestResourceDataRaw(test, testSchema.Schema, testData)
+
+	ociTaskServClientMock.On("GetTask", mock.Anything, task.Id).Return(&readResponse, nil).Once()
+
+	diags := ociTaskOperation.OciTasksRead(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestReadTasksOperationById Failed: No Diagnostics expected")
+	assert.Equal(test, "1001", rd.Id(), "TestReadTasksOperationById Failed: Task Id doesn't match with Task Id in Resource Data")
+	assert.Equal(test, 1, len(rd.Get("items").([]interface{})), "TestReadTasksOperationById Failed: One Task expected")
+}
+
+func TestReadTasksOperationByTags(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	firstTask := makeTestOciTask(1001)
+	firstTask.Tags = map[string]string{"team": "platform"}
+	secondTask := makeTestOciTask(1002)
+	secondTask.Tags = map[string]string{"team": "platform"}
+
+	listResponse := ocitaskclient.OciTaskServResponse{}
+	listResponse.Tasks = []ocitaskclient.OciTask{firstTask, secondTask}
+
+	ociTaskDataSource := MakeOciTaskDataSource()
+	testSchema := ociTaskDataSource.DataSourceOciTasks()
+
+	testData := make(map[string]interface{})
+	testData["tags"] = map[string]interface{}{"team": "platform"}
+
+	rd := schema.TestResourceDataRaw(test, testSchema.Schema, testData)
+
+	ociTaskServClientMock.On("ListTasks", mock.Anything, mock.MatchedBy(func(filter *ocitaskclient.OciTaskListFilter) bool {
+		return filter.Tags["team"] == "platform"
+	})).Return(&listResponse, nil).Once()
+
+	diags := ociTaskOperation.OciTasksRead(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestReadTasksOperationByTags Failed: No Diagnostics expected")
+	assert.NotEqual(test, "", rd.Id(), "TestReadTasksOperationByTags Failed: Data source Id expected")
+
+	items := rd.Get("items").([]interface{})
+
+	assert.Equal(test, 2, len(items), "TestReadTasksOperationByTags Failed: Two Tasks expected")
+	assert.Equal(test, 1002, items[1].(map[string]interface{})["id"].(int), "TestReadTasksOperationByTags Failed: Task Id doesn't match with expected value")
+}
+
+func TestReadTasksOperationFailedListTasks(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	ociTaskDataSource := MakeOciTaskDataSource()
+	testSchema := ociTaskDataSource.DataSourceOciTasks()
+
+	rd := schema.TestResourceDataRaw(test, testSchema.Schema, make(map[string]interface{}))
+
+	ociTaskServClientMock.On("ListTasks", mock.Anything, mock.Anything).Return(nil, errors.New("List Tasks Failed")).Once()
+
+	diags := ociTaskOperation.OciTasksRead(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 1, len(diags), "TestReadTasksOperationFailedListTasks Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Failed to list tasks", diags[0].Summary, "TestReadTasksOperationFailedListTasks Failed: Wrong Diagnostic Summary expected")
+	assert.Equal(test, "List Tasks Failed", diags[0].Detail, "TestReadTasksOperationFailedListTasks Failed: Wrong Diagnostic Detail expected")
+}
+
+func makeTestOciTask(id int64) ocitaskclient.OciTask {
+	taskId := id
+	title := "Test Task 1"
+	desc := "Test Task 1 Desc"
+	priority := 5
+	completed := false
+	startDate := time.Now().Unix() * 1000
+	dueDate := startDate
+	timeCreated := startDate
+	timeUpdated := startDate
+
+	return ocitaskclient.OciTask{
+		Id:          &taskId,
+		Title:       &title,
+		Description: &desc,
+		Priority:    &priority,
+		Completed:   &completed,
+		StartDate:   &startDate,
+		DueDate:     &dueDate,
+		TimeCreated: &timeCreated,
+		TimeUpdated: &timeUpdated,
+	}
+}
@@ -0,0 +1,370 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"fmt"
+	"ocitaskclient"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/**
+ * @brief Grant role to user or group on Task or Project in OCI Task System
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains permission defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskPermissionCreate(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskPermissionCreate", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	permission := expandOciTaskPermission(rd)
+
+	ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+	ociResponse, err := ociClient.GrantPermission(ctx, permission)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to grant permission",
+			Detail:   err.Error(),
+		})
+	} else {
+		if ociResponse.Err != nil {
+			ociErr, _ := ociResponse.Err.Serialize()
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to grant permission",
+				Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+			})
+		} else if ociResponse.Permission == nil || ociResponse.Permission.Id == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to grant permission",
+				Detail:   "OCI Task Service returned no permission Id" + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+			})
+		} else {
+			rd.SetId(formatOciTaskPermissionId(*permission.TargetType, *permission.TargetId, *ociResponse.Permission.Id))
+			diags = append(diags, ociTaskOperation.OciTaskPermissionRead(ctx, rd, m)...)
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Read permission in OCI Task System. Removes permission from state if it was revoked.
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains permission Identifier in form <task|project>/<target_id>/<permission_id>
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskPermissionRead(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskPermissionRead", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	targetType, targetId, permissionId, err := parseOciTaskPermissionId(rd.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to get Id from resource data",
+			Detail:   err.Error(),
+		})
+	} else {
+		ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+		ociResponse, err := ociClient.GetPermission(ctx, targetType, &targetId, &permissionId)
+		if ocitaskclient.IsOciTaskNotFound(err) {
+			rd.SetId("")
+		} else if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read permission",
+				Detail:   err.Error(),
+			})
+		} else {
+			if ociResponse.Err != nil {
+				ociErr, _ := ociResponse.Err.Serialize()
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to read permission",
+					Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else if ociResponse.Permission == nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to read permission",
+					Detail:   "OCI Task Service returned no permission" + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else {
+				permission := ociResponse.Permission.Flatten()
+				permission["task_id"] = 0
+				permission["project_id"] = 0
+				if targetType == ocitaskclient.OciTaskPermissionTargetTask {
+					permission["task_id"] = int(targetId)
+				} else {
+					permission["project_id"] = int(targetId)
+				}
+
+				// E-mail addresses are case-insensitive, keep configured spelling
+				if permission["principal_type"] == ocitaskclient.OciTaskPrincipalUser {
+					permission["principal"] = reconcileOciTaskAssignee(rd.Get("principal").(string), permission["principal"].(string))
+				}
+
+				delete(permission, "id")
+				delete(permission, "target_type")
+				delete(permission, "target_id")
+
+				for key, value := range permission {
+					err := rd.Set(key, value)
+					if err != nil {
+						diags = append(diags, diag.Diagnostic{
+							Severity: diag.Error,
+							Summary:  "Failed to set permission into resource data",
+							Detail:   err.Error(),
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Revoke permission in OCI Task System
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains permission Identifier in form <task|project>/<target_id>/<permission_id>
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskPermissionDelete(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskPermissionDelete", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	targetType, targetId, permissionId, err := parseOciTaskPermissionId(rd.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to get Id from resource data",
+			Detail:   err.Error(),
+		})
+	} else {
+		ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+		ociResponse, err := ociClient.RevokePermission(ctx, targetType, &targetId, &permissionId)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to revoke permission",
+				Detail:   err.Error(),
+			})
+		} else {
+			if ociResponse.Err != nil {
+				ociErr, _ := ociResponse.Err.Serialize()
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to revoke permission",
+					Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else {
+				rd.SetId("")
+			}
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Read effective permission of user or group on Task or Project in OCI Task System for effective permissions data source
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains target and principal defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskEffectivePermissionsRead(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	targetType, targetId := expandOciTaskPermissionTarget(rd)
+	principalType := rd.Get("principal_type").(string)
+	principal := rd.Get("principal").(string)
+	if principalType == ocitaskclient.OciTaskPrincipalUser {
+		principal = ocitaskclient.NormalizeOciTaskUserEmail(principal)
+	}
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskEffectivePermissionsRead", fmt.Sprintf("%s/%d", targetType, targetId))
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+	ociResponse, err := ociClient.ListEffectivePermissions(ctx, targetType, &targetId, principalType, principal)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read effective permissions",
+			Detail:   err.Error(),
+		})
+	} else if ociResponse.Err != nil {
+		ociErr, _ := ociResponse.Err.Serialize()
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read effective permissions",
+			Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+		})
+	} else {
+		effective := ocitaskclient.ResolveOciTaskEffectivePermission(ociResponse.Permissions)
+
+		sources := make([]interface{}, 0, len(effective.Sources))
+		for i := range effective.Sources {
+			source := effective.Sources[i].Flatten()
+			delete(source, "time_created")
+			sources = append(sources, source)
+		}
+
+		values := map[string]interface{}{
+			"role":    effective.Role,
+			"actions": ocitaskclient.FlattenOciTaskStringList(effective.Actions),
+			"sources": sources,
+		}
+		for key, value := range values {
+			err := rd.Set(key, value)
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to set effective permissions into resource data",
+					Detail:   err.Error(),
+				})
+			}
+		}
+
+		if !diags.HasError() {
+			rd.SetId(fmt.Sprintf("%s/%d/%s/%s", targetType, targetId, principalType, principal))
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Check principal of permission at plan time. Users must exist in OCI Task System.
+ * @param ctx Context to Terraform Provider
+ * @param rdiff Planned changes of permission resource
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Instance of error if user is unknown, or users can't be read
+ */
+func customizeOciTaskPermission(ctx context.Context, rdiff *schema.ResourceDiff, m interface{}) error {
+	ociClient, ok := m.(ocitaskclient.OciTaskServClientInterface)
+	if !ok {
+		return nil
+	}
+
+	if !rdiff.HasChange("principal") || !rdiff.NewValueKnown("principal") || !rdiff.NewValueKnown("principal_type") {
+		return nil
+	}
+
+	if rdiff.Get("principal_type").(string) != ocitaskclient.OciTaskPrincipalUser {
+		return nil
+	}
+
+	principal := rdiff.Get("principal").(string)
+	err := ocitaskclient.ValidateOciTaskUserEmail(principal)
+	if err != nil {
+		return err
+	}
+
+	return ocitaskclient.ValidateOciTaskUsers(ctx, ociClient, []string{principal})
+}
+
+/**
+ * @brief Suppress diff of principal which only differs in case of e-mail address
+ * @param key Attribute key
+ * @param old Principal in state
+ * @param new Principal in configuration
+ * @param rd Resource data of permission resource
+ * @return True if principal is user and both name the same user
+ */
+func suppressOciTaskPrincipalCase(key string, old string, new string, rd *schema.ResourceData) bool {
+	return rd.Get("principal_type").(string) == ocitaskclient.OciTaskPrincipalUser && strings.EqualFold(strings.TrimSpace(old), strings.TrimSpace(new))
+}
+
+/**
+ * @brief Read target of permission from resource data
+ * @param rd Resource data with task_id or project_id
+ * @return Kind of target, task or project
+ * @return Identifier of target
+ */
+func expandOciTaskPermissionTarget(rd *schema.ResourceData) (string, int64) {
+	if taskId, _ := rd.Get("task_id").(int); taskId != 0 {
+		return ocitaskclient.OciTaskPermissionTargetTask, int64(taskId)
+	}
+
+	projectId, _ := rd.Get("project_id").(int)
+	return ocitaskclient.OciTaskPermissionTargetProject, int64(projectId)
+}
+
+/**
+ * @brief Build OciTaskPermission from resource data of permission resource
+ * @param rd Resource data of permission resource
+ * @return Instance of OciTaskPermission
+ */
+func expandOciTaskPermission(rd *schema.ResourceData) *ocitaskclient.OciTaskPermission {
+	targetType, targetId := expandOciTaskPermissionTarget(rd)
+	principalType := rd.Get("principal_type").(string)
+	principal := strings.TrimSpace(rd.Get("principal").(string))
+	if principalType == ocitaskclient.OciTaskPrincipalUser {
+		principal = ocitaskclient.NormalizeOciTaskUserEmail(principal)
+	}
+	role := rd.Get("role").(string)
+
+	return &ocitaskclient.OciTaskPermission{
+		TargetType:    &targetType,
+		TargetId:      &targetId,
+		PrincipalType: &principalType,
+		Principal:     &principal,
+		Role:          &role,
+	}
+}
+
+/**
+ * @brief Build Identifier of permission resource
+ * @param targetType Kind of target, task or project
+ * @param targetId Identifier of Task or Project
+ * @param permissionId Identifier of permission
+ * @return Identifier in form <task|project>/<target_id>/<permission_id>
+ */
+func formatOciTaskPermissionId(targetType string, targetId int64, permissionId int64) string {
+	return fmt.Sprintf("%s/%d/%d", targetType, targetId, permissionId)
+}
+
+/**
+ * @brief Split Identifier of permission resource
+ * @param id Identifier in form <task|project>/<target_id>/<permission_id>
+ * @return Kind of target, task or project
+ * @return Identifier of Task or Project
+ * @return Identifier of permission
+ * @return Instance of error if Identifier is malformed
+ */
+func parseOciTaskPermissionId(id string) (string, int64, int64, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) == 3 && (parts[0] == ocitaskclient.OciTaskPermissionTargetTask || parts[0] == ocitaskclient.OciTaskPermissionTargetProject) {
+		targetId, targetErr := strconv.ParseInt(parts[1], 10, 64)
+		permissionId, permissionErr := strconv.ParseInt(parts[2], 10, 64)
+		if targetErr == nil && permissionErr == nil {
+			return parts[0], targetId, permissionId, nil
+		}
+	}
+
+	return "", 0, 0, fmt.Errorf("Invalid permission Id %q - expected <task|project>/<target_id>/<permission_id>", id)
+}
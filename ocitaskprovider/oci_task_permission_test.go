@@ -0,0 +1,174 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"net/http"
+	"ocitaskclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func makeTestOciTaskPermission(id int64, targetType string, targetId int64, principalType string, principal string, role string) ocitaskclient.OciTaskPermission {
+	return ocitaskclient.OciTaskPermission{Id: &id, TargetType: &targetType, TargetId: &targetId, PrincipalType: &principalType, Principal: &principal, Role: &role}
+}
+
+func TestCreatePermissionOperationOnTask(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	permission := makeTestOciTaskPermission(9, "task", 1001, "user", "jdoe@example.com", "editor")
+
+	ociTaskServClientMock.On("GrantPermission", mock.Anything, mock.MatchedBy(func(request *ocitaskclient.OciTaskPermission) bool {
+		return *request.TargetType == "task" && *request.TargetId == 1001 && *request.Principal == "jdoe@example.com" && *request.Role == "editor"
+	})).Return(&ocitaskclient.OciTaskServResponse{Permission: &permission}, nil).Once()
+	ociTaskServClientMock.On("GetPermission", mock.Anything, "task", permission.TargetId, permission.Id).Return(&ocitaskclient.OciTaskServResponse{Permission: &permission}, nil).Once()
+
+	testData := map[string]interface{}{"task_id": 1001, "principal_type": "user", "principal": "JDoe@Example.com", "role": "editor"}
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskPermission().Schema, testData)
+
+	diags := ociTaskOperation.OciTaskPermissionCreate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestCreatePermissionOperationOnTask Failed: No Diagnostics expected")
+	assert.Equal(test, "task/1001/9", rd.Id(), "TestCreatePermissionOperationOnTask Failed: Wrong resource Id")
+	assert.Equal(test, "JDoe@Example.com", rd.Get("principal"), "TestCreatePermissionOperationOnTask Failed: Configured principal casing expected")
+	assert.Equal(test, 0, rd.Get("project_id"), "TestCreatePermissionOperationOnTask Failed: No project expected")
+}
+
+func TestReadPermissionOperationImportProject(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	permission := makeTestOciTaskPermission(9, "project", 42, "group", "platform", "owner")
+	ociTaskServClientMock.On("GetPermission", mock.Anything, "project", mock.Anything, mock.Anything).Return(&ocitaskclient.OciTaskServResponse{Permission: &permission}, nil).Once()
+
+	rd := MakeOciTaskResource().ResourceOciTaskPermission().Data(nil)
+	rd.SetId("project/42/9")
+
+	diags := ociTaskOperation.OciTaskPermissionRead(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 0, len(diags), "TestReadPermissionOperationImportProject Failed: No Diagnostics expected")
+	assert.Equal(test, 42, rd.Get("project_id"), "TestReadPermissionOperationImportProject Failed: Wrong project")
+	assert.Equal(test, "platform", rd.Get("principal"), "TestReadPermissionOperationImportProject Failed: Wrong principal")
+	assert.Equal(test, "owner", rd.Get("role"), "TestReadPermissionOperationImportProject Failed: Wrong role")
+}
+
+func TestReadPermissionOperationRevoked(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	ociTaskServClientMock.On("GetPermission", mock.Anything, "task", mock.Anything, mock.Anything).Return(nil, &ocitaskclient.OciTaskServError{Operation: "GetPermission", StatusCode: http.StatusNotFound}).Once()
+
+	rd := MakeOciTaskResource().ResourceOciTaskPermission().Data(nil)
+	rd.SetId("task/1001/9")
+
+	diags := ociTaskOperation.OciTaskPermissionRead(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 0, len(diags), "TestReadPermissionOperationRevoked Failed: No Diagnostics expected")
+	assert.Equal(test, "", rd.Id(), "TestReadPermissionOperationRevoked Failed: Revoked permission expected to be removed from state")
+}
+
+func TestReadPermissionOperationMalformedId(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	rd := MakeOciTaskResource().ResourceOciTaskPermission().Data(nil)
+	rd.SetId("board/42/9")
+
+	diags := ociTaskOperation.OciTaskPermissionRead(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 1, len(diags), "TestReadPermissionOperationMalformedId Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Failed to get Id from resource data", diags[0].Summary, "TestReadPermissionOperationMalformedId Failed: Wrong Diagnostic Summary expected")
+}
+
+func TestDeletePermissionOperationSuccess(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	targetId, permissionId := int64(1001), int64(9)
+	ociTaskServClientMock.On("RevokePermission", mock.Anything, "task", &targetId, &permissionId).Return(&ocitaskclient.OciTaskServResponse{}, nil).Once()
+
+	rd := MakeOciTaskResource().ResourceOciTaskPermission().Data(nil)
+	rd.SetId("task/1001/9")
+
+	diags := ociTaskOperation.OciTaskPermissionDelete(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestDeletePermissionOperationSuccess Failed: No Diagnostics expected")
+	assert.Equal(test, "", rd.Id(), "TestDeletePermissionOperationSuccess Failed: Id should be cleared")
+}
+
+func TestCustomizeDiffPermissionUnknownUser(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+
+	ociTaskServClientMock.On("GetUserByEmail", mock.Anything, "ghost@example.com").Return(&ocitaskclient.OciTaskServResponse{}, nil).Once()
+
+	resource := MakeOciTaskResource().ResourceOciTaskPermission()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"task_id": 1001, "principal_type": "user", "principal": "ghost@example.com", "role": "viewer"})
+
+	_, err := resource.Diff(context.Background(), nil, config, &ociTaskServClientMock)
+
+	assert.Error(test, err, "TestCustomizeDiffPermissionUnknownUser Failed: Error expected")
+	assert.Contains(test, err.Error(), "not found", "TestCustomizeDiffPermissionUnknownUser Failed: Wrong error")
+}
+
+func TestCustomizeDiffPermissionGroupNotLookedUp(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+
+	resource := MakeOciTaskResource().ResourceOciTaskPermission()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{"project_id": 42, "principal_type": "group", "principal": "platform", "role": "viewer"})
+
+	_, err := resource.Diff(context.Background(), nil, config, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestCustomizeDiffPermissionGroupNotLookedUp Failed: No error expected")
+}
+
+func TestReadEffectivePermissionsOperationSuccess(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	taskId := int64(1001)
+	grants := []ocitaskclient.OciTaskPermission{
+		makeTestOciTaskPermission(3, "task", 1001, "user", "jdoe@example.com", "viewer"),
+		makeTestOciTaskPermission(1, "project", 42, "group", "platform", "editor"),
+	}
+	ociTaskServClientMock.On("ListEffectivePermissions", mock.Anything, "task", &taskId, "user", "jdoe@example.com").Return(&ocitaskclient.OciTaskServResponse{Permissions: grants}, nil).Once()
+
+	testData := map[string]interface{}{"task_id": 1001, "principal_type": "user", "principal": "JDoe@Example.com"}
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskDataSource().DataSourceOciTaskEffectivePermissions().Schema, testData)
+
+	diags := ociTaskOperation.OciTaskEffectivePermissionsRead(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestReadEffectivePermissionsOperationSuccess Failed: No Diagnostics expected")
+	assert.Equal(test, "task/1001/user/jdoe@example.com", rd.Id(), "TestReadEffectivePermissionsOperationSuccess Failed: Wrong data source Id")
+	assert.Equal(test, "editor", rd.Get("role"), "TestReadEffectivePermissionsOperationSuccess Failed: Most privileged role expected")
+	assert.Equal(test, []interface{}{"comment", "read", "update"}, rd.Get("actions"), "TestReadEffectivePermissionsOperationSuccess Failed: Wrong actions")
+	assert.Equal(test, 2, rd.Get("sources.#"), "TestReadEffectivePermissionsOperationSuccess Failed: Two sources expected")
+	assert.Equal(test, "platform", rd.Get("sources.0.principal"), "TestReadEffectivePermissionsOperationSuccess Failed: Most privileged source expected first")
+}
+
+func TestReadEffectivePermissionsOperationNoAccess(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	ociTaskServClientMock.On("ListEffectivePermissions", mock.Anything, "project", mock.Anything, "group", "contractors").Return(&ocitaskclient.OciTaskServResponse{}, nil).Once()
+
+	testData := map[string]interface{}{"project_id": 42, "principal_type": "group", "principal": "contractors"}
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskDataSource().DataSourceOciTaskEffectivePermissions().Schema, testData)
+
+	diags := ociTaskOperation.OciTaskEffectivePermissionsRead(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 0, len(diags), "TestReadEffectivePermissionsOperationNoAccess Failed: No Diagnostics expected")
+	assert.Equal(test, "", rd.Get("role"), "TestReadEffectivePermissionsOperationNoAccess Failed: No role expected")
+	assert.Equal(test, 0, rd.Get("actions.#"), "TestReadEffectivePermissionsOperationNoAccess Failed: No actions expected")
+}
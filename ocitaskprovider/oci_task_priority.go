@@ -0,0 +1,184 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"ocitaskclient"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/**
+ * @brief Read priority level names configured on provider
+ * @param m Contains ociTaskProviderMeta pluged into Terraform Provider
+ * @return Instance of OciTaskPriorityLevels, default mapping if none configured
+ */
+func ociTaskPriorityLevels(m interface{}) ocitaskclient.OciTaskPriorityLevels {
+	if meta, ok := m.(*ociTaskProviderMeta); ok && meta.priorities != nil {
+		return meta.priorities
+	}
+
+	return ocitaskclient.MakeOciTaskPriorityLevels()
+}
+
+/**
+ * @brief Expand priority_levels map of provider configuration. Configured levels override default levels.
+ * @param src Value of priority_levels map from provider resource data
+ * @return Instance of OciTaskPriorityLevels, nil if map not configured
+ */
+func expandOciTaskPriorityLevels(src interface{}) ocitaskclient.OciTaskPriorityLevels {
+	srcMap, ok := src.(map[string]interface{})
+	if !ok || len(srcMap) == 0 {
+		return nil
+	}
+
+	priorities := ocitaskclient.MakeOciTaskPriorityLevels()
+	for name, priority := range srcMap {
+		priorities[strings.ToLower(strings.TrimSpace(name))] = priority.(int)
+	}
+
+	return priorities
+}
+
+/**
+ * @brief Validate priority_levels map of provider configuration
+ * @param i Priority levels configured in Terraform scripts
+ * @param path Path to priority_levels attribute
+ * @return Collection of diag.Diagnostics instances if invalid, otherwise empty
+ */
+func validateOciTaskPriorityLevels(i interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	srcMap, _ := i.(map[string]interface{})
+	for name, priority := range srcMap {
+		var err error
+		if _, errAtoi := strconv.Atoi(strings.TrimSpace(name)); errAtoi == nil || strings.TrimSpace(name) == "" {
+			err = fmt.Errorf("Invalid priority level name %q - name must not be empty or an integer", name)
+		} else if value, errValue := ociTaskMapInt(priority); errValue != nil {
+			err = fmt.Errorf("Invalid priority of level %q - %s", name, errValue)
+		} else {
+			err = ocitaskclient.ValidateOciTaskPriority(value)
+		}
+
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid priority levels",
+				Detail:        err.Error(),
+				AttributePath: path,
+			})
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Convert value of map of integers as passed to validation into integer
+ * @param value Map value, validation receives raw configuration values
+ * @return Integer value if succeeded
+ * @return Instance of error if failed
+ */
+func ociTaskMapInt(value interface{}) (int, error) {
+	switch v := value.(type) {
+	case int:
+		return v, nil
+	case float64:
+		return int(v), nil
+	case json.Number:
+		i, err := v.Int64()
+		return int(i), err
+	case string:
+		return strconv.Atoi(v)
+	}
+
+	return 0, fmt.Errorf("unexpected value %v", value)
+}
+
+/**
+ * @brief Convert priority of Task item, given as integer or level name, into integer before it is sent to OCI Task Service
+ * @param item Task item from resource data
+ * @param priorities Priority level names configured on provider
+ * @return Task item carrying integer priority, without priority if not set
+ * @return Instance of error if priority is invalid
+ */
+func withOciTaskPriority(item interface{}, priorities ocitaskclient.OciTaskPriorityLevels) (interface{}, error) {
+	ociTask, ok := item.(map[string]interface{})
+	if !ok {
+		return item, nil
+	}
+
+	value, _ := ociTask["priority"].(string)
+	if value == "" {
+		delete(ociTask, "priority")
+		return ociTask, nil
+	}
+
+	priority, err := priorities.Normalize(value)
+	if err != nil {
+		return item, err
+	}
+	ociTask["priority"] = priority
+
+	return ociTask, nil
+}
+
+/**
+ * @brief Build priority to store in resource data. Keeps configured form if it maps to priority of Task.
+ * @param configured Priority as configured, integer or level name
+ * @param priority Integer priority returned by OCI Task Service
+ * @param priorities Priority level names configured on provider
+ * @return Priority to store in resource data
+ */
+func reconcileOciTaskPriority(configured string, priority *int, priorities ocitaskclient.OciTaskPriorityLevels) string {
+	if priority == nil {
+		return ""
+	}
+
+	if configuredPriority, err := priorities.Normalize(configured); err == nil && configuredPriority == *priority {
+		return configured
+	}
+
+	return strconv.Itoa(*priority)
+}
+
+/**
+ * @brief Find level name of priority of Task
+ * @param priority Integer priority returned by OCI Task Service
+ * @param priorities Priority level names configured on provider
+ * @return Level name, empty if priority not set or maps to no level
+ */
+func ociTaskPriorityName(priority *int, priorities ocitaskclient.OciTaskPriorityLevels) string {
+	if priority == nil {
+		return ""
+	}
+
+	return priorities.Name(*priority)
+}
+
+/**
+ * @brief Validate priority of Task at plan time against range and priority levels configured on provider
+ * @param ctx Context to Terraform Provider
+ * @param rdiff Planned changes of Task resource
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Instance of error if priority is invalid
+ */
+func customizeOciTaskPriority(ctx context.Context, rdiff *schema.ResourceDiff, m interface{}) error {
+	if !rdiff.NewValueKnown("items.0.priority") {
+		return nil
+	}
+
+	value, _ := rdiff.Get("items.0.priority").(string)
+	if value == "" || !rdiff.HasChange("items.0.priority") {
+		return nil
+	}
+
+	_, err := ociTaskPriorityLevels(m).Normalize(value)
+
+	return err
+}
@@ -0,0 +1,135 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"ocitaskclient"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWithOciTaskPriority(test *testing.T) {
+	priorities := ocitaskclient.MakeOciTaskPriorityLevels()
+
+	item, err := withOciTaskPriority(map[string]interface{}{"priority": "critical"}, priorities)
+	assert.NoError(test, err, "TestWithOciTaskPriority Failed: No error expected")
+	assert.Equal(test, 10, item.(map[string]interface{})["priority"], "TestWithOciTaskPriority Failed: Integer priority expected for level name")
+
+	item, err = withOciTaskPriority(map[string]interface{}{"priority": ""}, priorities)
+	assert.NoError(test, err, "TestWithOciTaskPriority Failed: No error expected")
+	assert.NotContains(test, item.(map[string]interface{}), "priority", "TestWithOciTaskPriority Failed: No priority expected if not set")
+
+	_, err = withOciTaskPriority(map[string]interface{}{"priority": "42"}, priorities)
+	assert.Error(test, err, "TestWithOciTaskPriority Failed: Out of range priority expected to be rejected")
+}
+
+func TestReconcileOciTaskPriority(test *testing.T) {
+	priorities := ocitaskclient.MakeOciTaskPriorityLevels()
+	priority := 8
+
+	assert.Equal(test, "high", reconcileOciTaskPriority("high", &priority, priorities), "TestReconcileOciTaskPriority Failed: Configured level name expected")
+	assert.Equal(test, "8", reconcileOciTaskPriority("low", &priority, priorities), "TestReconcileOciTaskPriority Failed: Integer priority expected if level differs")
+	assert.Equal(test, "8", reconcileOciTaskPriority("", &priority, priorities), "TestReconcileOciTaskPriority Failed: Integer priority expected if not configured")
+	assert.Equal(test, "", reconcileOciTaskPriority("high", nil, priorities), "TestReconcileOciTaskPriority Failed: No priority expected")
+}
+
+func TestValidateOciTaskPriorityLevels(test *testing.T) {
+	diags := validateOciTaskPriorityLevels(map[string]interface{}{"high": 9, "urgent": 10}, cty.GetAttrPath("priority_levels"))
+	assert.Equal(test, 0, len(diags), "TestValidateOciTaskPriorityLevels Failed: No Diagnostics expected")
+
+	diags = validateOciTaskPriorityLevels(map[string]interface{}{"high": 11}, cty.GetAttrPath("priority_levels"))
+	assert.Equal(test, 1, len(diags), "TestValidateOciTaskPriorityLevels Failed: Out of range priority expected to be rejected")
+
+	diags = validateOciTaskPriorityLevels(map[string]interface{}{"3": 3}, cty.GetAttrPath("priority_levels"))
+	assert.Equal(test, 1, len(diags), "TestValidateOciTaskPriorityLevels Failed: Integer level name expected to be rejected")
+}
+
+func TestCustomizeDiffPriority(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	providerMeta := ociTaskProviderMeta{OciTaskServClientInterface: &ociTaskServClientMock, priorities: ocitaskclient.OciTaskPriorityLevels{"urgent": 10}}
+
+	resource := MakeOciTaskResource().ResourceOciTask()
+
+	makeConfig := func(priority string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"items": []interface{}{
+				map[string]interface{}{
+					"title":    "Test Task 1",
+					"priority": priority,
+				},
+			},
+		})
+	}
+
+	_, err := resource.Diff(context.Background(), nil, makeConfig("Urgent"), &providerMeta)
+	assert.NoError(test, err, "TestCustomizeDiffPriority Failed: Configured level name expected to be accepted")
+
+	_, err = resource.Diff(context.Background(), nil, makeConfig("7"), &providerMeta)
+	assert.NoError(test, err, "TestCustomizeDiffPriority Failed: Integer priority expected to be accepted")
+
+	_, err = resource.Diff(context.Background(), nil, makeConfig("high"), &providerMeta)
+	assert.Error(test, err, "TestCustomizeDiffPriority Failed: Level name not configured expected to be rejected")
+
+	_, err = resource.Diff(context.Background(), nil, makeConfig("0"), &providerMeta)
+	assert.Error(test, err, "TestCustomizeDiffPriority Failed: Out of range priority expected to be rejected")
+}
+
+func TestReadTaskOperationPriorityName(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	task := makeTestOciTask(1001)
+	priority := 8
+	task.Priority = &priority
+
+	readResponse := ocitaskclient.OciTaskServResponse{}
+	readResponse.Task = &task
+
+	srcTask := make(map[string]interface{})
+	srcTask["title"] = *task.Title
+	srcTask["priority"] = "High"
+
+	testData := make(map[string]interface{})
+	testData["items"] = []interface{}{srcTask}
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTask().Schema, testData)
+	rd.SetId("1001")
+
+	ociTaskServClientMock.On("GetTask", mock.Anything, task.Id).Return(&readResponse, nil).Once()
+
+	diags := ociTaskOperation.OciTaskRead(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestReadTaskOperationPriorityName Failed: No Diagnostics expected")
+	assert.Equal(test, "High", rd.Get("items.0.priority"), "TestReadTaskOperationPriorityName Failed: Configured level name expected")
+	assert.Equal(test, "high", rd.Get("items.0.priority_name"), "TestReadTaskOperationPriorityName Failed: Wrong priority name")
+}
+
+func TestUpgradeOciTaskStateV0(test *testing.T) {
+	rawState := map[string]interface{}{
+		"id": "1001",
+		"items": []interface{}{
+			map[string]interface{}{
+				"title":    "Test Task 1",
+				"priority": float64(5),
+			},
+		},
+	}
+
+	upgraded, err := upgradeOciTaskStateV0(context.Background(), rawState, nil)
+
+	assert.NoError(test, err, "TestUpgradeOciTaskStateV0 Failed: No error expected")
+	assert.Equal(test, "5", upgraded["items"].([]interface{})[0].(map[string]interface{})["priority"], "TestUpgradeOciTaskStateV0 Failed: String priority expected")
+
+	v0Type := MakeOciTaskResource().resourceOciTaskV0Type()
+	priorityType := v0Type.AttributeType("items").ElementType().AttributeType("priority")
+
+	assert.Equal(test, cty.Number, priorityType, "TestUpgradeOciTaskStateV0 Failed: Integer priority expected in version 0 state")
+	assert.False(test, v0Type.HasAttribute("tags_all"), "TestUpgradeOciTaskStateV0 Failed: Attributes added after version 0 not expected in version 0 state")
+	assert.False(test, v0Type.AttributeType("items").ElementType().HasAttribute("tags"), "TestUpgradeOciTaskStateV0 Failed: Item attributes added after version 0 not expected in version 0 state")
+}
@@ -0,0 +1,302 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"fmt"
+	"ocitaskclient"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/**
+ * @brief Deletion policies of Project resource. refuse keeps Project which still has Tasks, cascade deletes its Tasks too.
+ */
+const (
+	OciTaskProjectDeletionRefuse  string = "refuse"
+	OciTaskProjectDeletionCascade string = "cascade"
+)
+
+/**
+ * @brief Create new Project in OCI Task System
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains Project defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskProjectCreate(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskProjectCreate", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+	ociResponse, err := ociClient.CreateProject(ctx, expandOciTaskProject(rd))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create project",
+			Detail:   err.Error(),
+		})
+	} else {
+		if ociResponse.Err != nil {
+			ociErr, _ := ociResponse.Err.Serialize()
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to create project",
+				Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+			})
+		} else if ociResponse.Project == nil || ociResponse.Project.Id == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to create project",
+				Detail:   "OCI Task Service returned no project Id" + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+			})
+		} else {
+			rd.SetId(strconv.FormatInt(*ociResponse.Project.Id, 10))
+			diags = append(diags, ociTaskOperation.OciTaskProjectRead(ctx, rd, m)...)
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Read Project in OCI Task System. Removes Project from state if it no longer exists.
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains Project Identifier defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskProjectRead(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskProjectRead", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	projectId, err := strconv.ParseInt(rd.Id(), 10, 64)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to get Id from resource data",
+			Detail:   err.Error(),
+		})
+	} else {
+		ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+		ociResponse, err := ociClient.GetProject(ctx, &projectId)
+		if ocitaskclient.IsOciTaskNotFound(err) {
+			rd.SetId("")
+		} else if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read project",
+				Detail:   err.Error(),
+			})
+		} else {
+			if ociResponse.Err != nil {
+				ociErr, _ := ociResponse.Err.Serialize()
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to read project",
+					Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else if ociResponse.Project == nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to read project",
+					Detail:   "OCI Task Service returned no project" + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else {
+				project := ociResponse.Project.Flatten()
+
+				// Deletion policy lives in Terraform only, imported Projects start with the safe default
+				if rd.Get("deletion_policy").(string) == "" {
+					project["deletion_policy"] = OciTaskProjectDeletionRefuse
+				}
+
+				for key, value := range project {
+					err := rd.Set(key, value)
+					if err != nil {
+						diags = append(diags, diag.Diagnostic{
+							Severity: diag.Error,
+							Summary:  "Failed to set project into resource data",
+							Detail:   err.Error(),
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Update existing Project in OCI Task System
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains Project defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskProjectUpdate(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskProjectUpdate", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	// Deletion policy is not stored by OCI Task Service
+	if !rd.HasChanges("name", "description", "owner", "archived") {
+		return diags
+	}
+
+	projectId, err := strconv.ParseInt(rd.Id(), 10, 64)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to get Id from resource data",
+			Detail:   err.Error(),
+		})
+	} else {
+		ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+		ociResponse, err := ociClient.UpdateProject(ctx, &projectId, expandOciTaskProject(rd))
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to update project",
+				Detail:   err.Error(),
+			})
+		} else {
+			if ociResponse.Err != nil {
+				ociErr, _ := ociResponse.Err.Serialize()
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to update project",
+					Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else {
+				diags = append(diags, ociTaskOperation.OciTaskProjectRead(ctx, rd, m)...)
+			}
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Delete Project in OCI Task System following its deletion policy.
+ *			Refuses to delete Project which still has Tasks unless deletion policy is cascade.
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains Project Identifier and deletion policy defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskProjectDelete(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskProjectDelete", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	projectId, err := strconv.ParseInt(rd.Id(), 10, 64)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to get Id from resource data",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+	cascade := rd.Get("deletion_policy").(string) == OciTaskProjectDeletionCascade
+	if !cascade {
+		err := checkOciTaskProjectEmpty(ctx, ociClient, projectId)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Project still has tasks",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	ociResponse, err := ociClient.DeleteProject(ctx, &projectId, cascade)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to delete project",
+			Detail:   err.Error(),
+		})
+	} else {
+		if ociResponse.Err != nil {
+			ociErr, _ := ociResponse.Err.Serialize()
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to delete project",
+				Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+			})
+		} else {
+			rd.SetId("")
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Check that Project has no Tasks left
+ * @param ctx Context to Terraform Provider
+ * @param ociClient Client to OCI Task Service
+ * @param projectId Identifier of Project
+ * @return Instance of error listing remaining Tasks, or if Tasks can't be listed
+ */
+func checkOciTaskProjectEmpty(ctx context.Context, ociClient ocitaskclient.OciTaskServClientInterface, projectId int64) error {
+	filter := ocitaskclient.MakeOciTaskListFilter()
+	filter.ProjectId = &projectId
+
+	ociResponse, err := ociClient.ListTasks(ctx, filter)
+	if err == nil && ociResponse.Err != nil {
+		ociErr, _ := ociResponse.Err.Serialize()
+		err = fmt.Errorf("%s%s", ociErr, ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId))
+	}
+	if err != nil {
+		return fmt.Errorf("Failed to list tasks of project %d: %s", projectId, err.Error())
+	}
+
+	if len(ociResponse.Tasks) == 0 {
+		return nil
+	}
+
+	taskIds := make([]int64, 0, len(ociResponse.Tasks))
+	for _, ociTask := range ociResponse.Tasks {
+		if ociTask.Id != nil {
+			taskIds = append(taskIds, *ociTask.Id)
+		}
+	}
+
+	return fmt.Errorf("Project %d still has %d task(s) %v. Move or delete them first, or set deletion_policy = %q.", projectId, len(ociResponse.Tasks), taskIds, OciTaskProjectDeletionCascade)
+}
+
+/**
+ * @brief Build OciTaskProject from resource data of Project resource
+ * @param rd Resource data of Project resource
+ * @return Instance of OciTaskProject
+ */
+func expandOciTaskProject(rd *schema.ResourceData) *ocitaskclient.OciTaskProject {
+	name := rd.Get("name").(string)
+	description := rd.Get("description").(string)
+	owner := rd.Get("owner").(string)
+	archived := rd.Get("archived").(bool)
+
+	return &ocitaskclient.OciTaskProject{
+		Name:        &name,
+		Description: &description,
+		Owner:       &owner,
+		Archived:    &archived,
+	}
+}
@@ -0,0 +1,157 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"net/http"
+	"ocitaskclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func makeTestOciTaskProject(id int64) ocitaskclient.OciTaskProject {
+	name := "Platform"
+	owner := "jdoe"
+	archived := false
+
+	return ocitaskclient.OciTaskProject{Id: &id, Name: &name, Owner: &owner, Archived: &archived}
+}
+
+func matchProjectFilter(projectId int64) interface{} {
+	return mock.MatchedBy(func(filter *ocitaskclient.OciTaskListFilter) bool {
+		return filter.ProjectId != nil && *filter.ProjectId == projectId
+	})
+}
+
+func TestCreateProjectOperationSuccess(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	project := makeTestOciTaskProject(42)
+
+	ociTaskServClientMock.On("CreateProject", mock.Anything, mock.MatchedBy(func(request *ocitaskclient.OciTaskProject) bool {
+		return *request.Name == "Platform" && *request.Owner == "jdoe" && !*request.Archived
+	})).Return(&ocitaskclient.OciTaskServResponse{Project: &project}, nil).Once()
+	ociTaskServClientMock.On("GetProject", mock.Anything, project.Id).Return(&ocitaskclient.OciTaskServResponse{Project: &project}, nil).Once()
+
+	testData := map[string]interface{}{"name": "Platform", "owner": "jdoe"}
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskProject().Schema, testData)
+
+	diags := ociTaskOperation.OciTaskProjectCreate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestCreateProjectOperationSuccess Failed: No Diagnostics expected")
+	assert.Equal(test, "42", rd.Id(), "TestCreateProjectOperationSuccess Failed: Wrong resource Id")
+	assert.Equal(test, OciTaskProjectDeletionRefuse, rd.Get("deletion_policy"), "TestCreateProjectOperationSuccess Failed: Wrong deletion policy")
+}
+
+func TestReadProjectOperationImportSetsDeletionPolicy(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	project := makeTestOciTaskProject(42)
+	ociTaskServClientMock.On("GetProject", mock.Anything, mock.Anything).Return(&ocitaskclient.OciTaskServResponse{Project: &project}, nil).Once()
+
+	rd := MakeOciTaskResource().ResourceOciTaskProject().Data(nil)
+	rd.SetId("42")
+
+	diags := ociTaskOperation.OciTaskProjectRead(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 0, len(diags), "TestReadProjectOperationImportSetsDeletionPolicy Failed: No Diagnostics expected")
+	assert.Equal(test, "Platform", rd.Get("name"), "TestReadProjectOperationImportSetsDeletionPolicy Failed: Wrong name")
+	assert.Equal(test, OciTaskProjectDeletionRefuse, rd.Get("deletion_policy"), "TestReadProjectOperationImportSetsDeletionPolicy Failed: Wrong deletion policy")
+}
+
+func TestReadProjectOperationGone(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	ociTaskServClientMock.On("GetProject", mock.Anything, mock.Anything).Return(nil, &ocitaskclient.OciTaskServError{Operation: "GetProject", StatusCode: http.StatusNotFound}).Once()
+
+	rd := MakeOciTaskResource().ResourceOciTaskProject().Data(nil)
+	rd.SetId("42")
+
+	diags := ociTaskOperation.OciTaskProjectRead(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 0, len(diags), "TestReadProjectOperationGone Failed: No Diagnostics expected")
+	assert.Equal(test, "", rd.Id(), "TestReadProjectOperationGone Failed: Project expected to be removed from state")
+}
+
+func TestDeleteProjectOperationRefused(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	ociTaskServClientMock.On("ListTasks", mock.Anything, matchProjectFilter(42)).Return(&ocitaskclient.OciTaskServResponse{Tasks: []ocitaskclient.OciTask{makeTestOciTask(1001)}}, nil).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskProject().Schema, map[string]interface{}{"name": "Platform"})
+	rd.SetId("42")
+
+	diags := ociTaskOperation.OciTaskProjectDelete(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertNotCalled(test, "DeleteProject", mock.Anything, mock.Anything, mock.Anything)
+
+	assert.Equal(test, 1, len(diags), "TestDeleteProjectOperationRefused Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Project still has tasks", diags[0].Summary, "TestDeleteProjectOperationRefused Failed: Wrong Diagnostic Summary expected")
+	assert.Contains(test, diags[0].Detail, "[1001]", "TestDeleteProjectOperationRefused Failed: Remaining Tasks expected in Diagnostic Detail")
+	assert.Equal(test, "42", rd.Id(), "TestDeleteProjectOperationRefused Failed: Resource Id expected to be kept")
+}
+
+func TestDeleteProjectOperationEmpty(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	ociTaskServClientMock.On("ListTasks", mock.Anything, matchProjectFilter(42)).Return(&ocitaskclient.OciTaskServResponse{}, nil).Once()
+	ociTaskServClientMock.On("DeleteProject", mock.Anything, mock.Anything, false).Return(&ocitaskclient.OciTaskServResponse{}, nil).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskProject().Schema, map[string]interface{}{"name": "Platform"})
+	rd.SetId("42")
+
+	diags := ociTaskOperation.OciTaskProjectDelete(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestDeleteProjectOperationEmpty Failed: No Diagnostics expected")
+	assert.Equal(test, "", rd.Id(), "TestDeleteProjectOperationEmpty Failed: Resource Id expected to be cleared")
+}
+
+func TestDeleteProjectOperationCascade(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	ociTaskServClientMock.On("DeleteProject", mock.Anything, mock.Anything, true).Return(&ocitaskclient.OciTaskServResponse{}, nil).Once()
+
+	testData := map[string]interface{}{"name": "Platform", "deletion_policy": OciTaskProjectDeletionCascade}
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskProject().Schema, testData)
+	rd.SetId("42")
+
+	diags := ociTaskOperation.OciTaskProjectDelete(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+	ociTaskServClientMock.AssertNotCalled(test, "ListTasks", mock.Anything, mock.Anything)
+
+	assert.Equal(test, 0, len(diags), "TestDeleteProjectOperationCascade Failed: No Diagnostics expected")
+	assert.Equal(test, "", rd.Id(), "TestDeleteProjectOperationCascade Failed: Resource Id expected to be cleared")
+}
+
+func TestReadTasksOperationByProject(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	projectId := int64(42)
+	task := makeTestOciTask(1001)
+	task.ProjectId = &projectId
+
+	ociTaskServClientMock.On("ListTasks", mock.Anything, matchProjectFilter(42)).Return(&ocitaskclient.OciTaskServResponse{Tasks: []ocitaskclient.OciTask{task}}, nil).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskDataSource().DataSourceOciTasks().Schema, map[string]interface{}{"project_id": 42})
+
+	diags := ociTaskOperation.OciTasksRead(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestReadTasksOperationByProject Failed: No Diagnostics expected")
+	assert.Equal(test, 42, rd.Get("items.0.project_id"), "TestReadTasksOperationByProject Failed: Wrong Project Id")
+}
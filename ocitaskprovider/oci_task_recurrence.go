@@ -0,0 +1,503 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"ocitaskclient"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/**
+ * @brief Clock used to find current occurrence of recurring Task, replaced in tests
+ */
+var ociTaskNow = time.Now
+
+/**
+ * @brief Create recurring Task and materialise its current occurrence as Task in OCI Task System
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains recurring Task defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskRecurringTaskCreate(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskRecurringTaskCreate", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	// Recurrence itself lives in Terraform state only, OCI Task Service stores Tasks of its occurrences
+	recurringTaskId, err := uuid.GenerateUUID()
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to generate recurring task Id",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	diags = append(diags, ociTaskOperation.materialiseOciTaskOccurrence(ctx, rd, m)...)
+	if !diags.HasError() {
+		rd.SetId(recurringTaskId)
+		diags = append(diags, ociTaskOperation.OciTaskRecurringTaskRead(ctx, rd, m)...)
+	}
+
+	return diags
+}
+
+/**
+ * @brief Read Task materialised for current occurrence of recurring Task.
+ *			If Task no longer exists, current occurrence is cleared so the next plan materialises it again.
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains recurring Task defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskRecurringTaskRead(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskRecurringTaskRead", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	recurrence, err := makeOciTaskRecurrence(rd)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid recurrence",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	err = rd.Set("next_occurrence", nextOciTaskOccurrence(recurrence))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to set recurring task into resource data",
+			Detail:   err.Error(),
+		})
+	}
+
+	taskId := int64(rd.Get("task_id").(int))
+	if taskId == 0 {
+		return diags
+	}
+
+	ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+	ociResponse, err := ociClient.GetTask(ctx, &taskId)
+	if ocitaskclient.IsOciTaskNotFound(err) {
+		for key, value := range map[string]interface{}{"task_id": 0, "current_occurrence": ""} {
+			err := rd.Set(key, value)
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to set recurring task into resource data",
+					Detail:   err.Error(),
+				})
+			}
+		}
+	} else if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read task",
+			Detail:   err.Error(),
+		})
+	} else if ociResponse.Err != nil {
+		ociErr, _ := ociResponse.Err.Serialize()
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read task",
+			Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+		})
+	} else if ociResponse.Task == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read task",
+			Detail:   "OCI Task Service returned no task" + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+		})
+	}
+
+	return diags
+}
+
+/**
+ * @brief Update recurring Task. Materialises new Task once current occurrence moves on,
+ *			otherwise applies template to Task of current occurrence.
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains recurring Task defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskRecurringTaskUpdate(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskRecurringTaskUpdate", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	taskId := int64(rd.Get("task_id").(int))
+	if rd.HasChange("current_occurrence") || taskId == 0 {
+		diags = append(diags, ociTaskOperation.materialiseOciTaskOccurrence(ctx, rd, m)...)
+	} else if rd.HasChange("template") {
+		occurrence, _, err := ocitaskclient.ParseOciTaskRecurrenceTime(rd.Get("current_occurrence").(string), time.UTC)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Invalid recurrence",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+
+		ociRequest, err := makeOciTaskOccurrenceRequest(rd, occurrence, m)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to make OCI Task Service Request",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+
+		// Progress made on Task of current occurrence is kept
+		ociRequest.Status = nil
+		ociRequest.Completed = nil
+
+		ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+		ociResponse, err := ociClient.UpdateTask(ctx, &taskId, ociRequest)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to update task",
+				Detail:   err.Error(),
+			})
+		} else if ociResponse.Err != nil {
+			ociErr, _ := ociResponse.Err.Serialize()
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to update task",
+				Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+			})
+		}
+	}
+
+	if !diags.HasError() {
+		diags = append(diags, ociTaskOperation.OciTaskRecurringTaskRead(ctx, rd, m)...)
+	}
+
+	return diags
+}
+
+/**
+ * @brief Delete recurring Task together with Task of current occurrence. Tasks of past occurrences are kept.
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains recurring Task defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskRecurringTaskDelete(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskRecurringTaskDelete", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	taskId := int64(rd.Get("task_id").(int))
+	if taskId != 0 {
+		ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+		ociResponse, err := ociClient.DeleteTask(ctx, &taskId)
+		if err != nil {
+			// Current occurrence already gone in OCI Task System counts as deleted
+			if !ocitaskclient.IsOciTaskNotFound(err) {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to delete task",
+					Detail:   err.Error(),
+				})
+			}
+		} else if ociResponse.Err != nil {
+			ociErr, _ := ociResponse.Err.Serialize()
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to delete task",
+				Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+			})
+		}
+	}
+
+	if !diags.HasError() {
+		rd.SetId("")
+	}
+
+	return diags
+}
+
+/**
+ * @brief Read upcoming occurrences of recurrence rule for occurrences data source
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains recurrence defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskOccurrencesRead(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskOccurrencesRead", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	recurrence, err := makeOciTaskRecurrence(rd)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid recurrence",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	from := ociTaskNow().In(recurrence.GetStart().Location())
+	if value := rd.Get("from").(string); value != "" {
+		from, _, err = ocitaskclient.ParseOciTaskRecurrenceTime(value, recurrence.GetStart().Location())
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Invalid recurrence",
+				Detail:   err.Error(),
+			})
+			return diags
+		}
+	}
+
+	occurrences := make([]interface{}, 0)
+	for _, occurrence := range recurrence.Occurrences(from, rd.Get("limit").(int)) {
+		occurrences = append(occurrences, occurrence.Format(time.RFC3339))
+	}
+
+	err = rd.Set("occurrences", occurrences)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to set occurrences into resource data",
+			Detail:   err.Error(),
+		})
+	} else {
+		key := strings.Join([]string{rd.Get("rrule").(string), rd.Get("dtstart").(string), rd.Get("timezone").(string), from.Format(time.RFC3339)}, "\n")
+		digest := sha256.Sum256([]byte(key))
+		rd.SetId(hex.EncodeToString(digest[:]))
+	}
+
+	return diags
+}
+
+/**
+ * @brief Plan materialisation of new Task once current occurrence of recurring Task moves on
+ * @param ctx Context to Terraform Provider
+ * @param rdiff Planned changes of recurring Task resource
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Instance of error if recurrence is invalid or has no occurrences
+ */
+func customizeOciTaskRecurrence(ctx context.Context, rdiff *schema.ResourceDiff, m interface{}) error {
+	for _, key := range []string{"rrule", "dtstart", "timezone", "exdates"} {
+		if !rdiff.NewValueKnown(key) {
+			return rdiff.SetNewComputed("current_occurrence")
+		}
+	}
+
+	recurrence, err := makeOciTaskRecurrence(rdiff)
+	if err != nil {
+		return err
+	}
+
+	current, found := recurrence.Current(ociTaskNow())
+	if !found {
+		return fmt.Errorf("Recurrence rule %q has no occurrences", rdiff.Get("rrule").(string))
+	}
+
+	currentOccurrence := current.Format(time.RFC3339)
+	oldOccurrence, _ := rdiff.GetChange("current_occurrence")
+	if oldOccurrence.(string) == currentOccurrence {
+		return nil
+	}
+
+	for _, key := range []string{"task_id", "next_occurrence"} {
+		err := rdiff.SetNewComputed(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return rdiff.SetNew("current_occurrence", currentOccurrence)
+}
+
+/**
+ * @brief Private method to create Task for current occurrence of recurring Task
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains recurring Task defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) materialiseOciTaskOccurrence(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	recurrence, err := makeOciTaskRecurrence(rd)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid recurrence",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	// Occurrence planned by customizeOciTaskRecurrence wins, so apply matches plan even if clock moved on meanwhile
+	occurrence, found := recurrence.Current(ociTaskNow())
+	if planned := rd.Get("current_occurrence").(string); planned != "" {
+		occurrence, _, err = ocitaskclient.ParseOciTaskRecurrenceTime(planned, recurrence.GetStart().Location())
+		found = err == nil
+	}
+	if !found {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Invalid recurrence",
+			Detail:   fmt.Sprintf("Recurrence rule %q has no occurrences", rd.Get("rrule").(string)),
+		})
+		return diags
+	}
+
+	ociRequest, err := makeOciTaskOccurrenceRequest(rd, occurrence, m)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to make OCI Task Service Request",
+			Detail:   err.Error(),
+		})
+		return diags
+	}
+
+	ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+	ociResponse, err := ociClient.CreateTask(ctx, ociRequest)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create task",
+			Detail:   err.Error(),
+		})
+	} else if ociResponse.Err != nil {
+		ociErr, _ := ociResponse.Err.Serialize()
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create task",
+			Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+		})
+	} else if ociResponse.TaskId == nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create task",
+			Detail:   "OCI Task Service returned no task Id" + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+		})
+	} else {
+		values := map[string]interface{}{
+			"task_id":            int(*ociResponse.TaskId),
+			"current_occurrence": occurrence.Format(time.RFC3339),
+		}
+		for key, value := range values {
+			err := rd.Set(key, value)
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to set recurring task into resource data",
+					Detail:   err.Error(),
+				})
+			}
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Build request to create or update Task of occurrence from template of recurring Task.
+ *			Task starts at occurrence and is due due_after later.
+ * @param rd Contains recurring Task defined in Terraform scripts
+ * @param occurrence Time of occurrence
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Instance of OciTaskServRequest if succeeded
+ * @return Instance of error if failed
+ */
+func makeOciTaskOccurrenceRequest(rd *schema.ResourceData, occurrence time.Time, m interface{}) (*ocitaskclient.OciTaskServRequest, error) {
+	templates := rd.Get("template").([]interface{})
+	if len(templates) == 0 || templates[0] == nil {
+		return nil, fmt.Errorf("Invalid Argument - No template found")
+	}
+	template := templates[0].(map[string]interface{})
+
+	dueDate := occurrence
+	if dueAfter := template["due_after"].(string); dueAfter != "" {
+		duration, err := time.ParseDuration(dueAfter)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid due_after %q: %w", dueAfter, err)
+		}
+		dueDate = occurrence.Add(duration)
+	}
+
+	var item interface{} = map[string]interface{}{
+		"title":       template["title"],
+		"description": template["description"],
+		"priority":    template["priority"],
+		"completed":   false,
+		"status":      ocitaskclient.OciTaskStatusTodo,
+		"start_date":  occurrence.Format(time.RFC3339),
+		"due_date":    dueDate.Format(time.RFC3339),
+		"tags":        template["tags"],
+		"project_id":  template["project_id"],
+		"assignee":    template["assignee"],
+	}
+
+	item = withOciTaskDefaultTags(item, ociTaskDefaultTags(m))
+
+	item, err := withOciTaskPriority(item, ociTaskPriorityLevels(m))
+	if err != nil {
+		return nil, err
+	}
+
+	return ocitaskclient.MakeOciTaskServRequest(&item)
+}
+
+/**
+ * @brief Build recurrence from resource data or planned changes
+ * @param rd Resource data or planned changes holding rrule, dtstart, timezone and exdates
+ * @return Instance of OciTaskRecurrence if succeeded
+ * @return Instance of error if recurrence is invalid
+ */
+func makeOciTaskRecurrence(rd ociTaskConfigReader) (*ocitaskclient.OciTaskRecurrence, error) {
+	return ocitaskclient.MakeOciTaskRecurrence(
+		rd.Get("rrule").(string),
+		rd.Get("dtstart").(string),
+		rd.Get("timezone").(string),
+		ocitaskclient.ExpandOciTaskStringList(rd.Get("exdates")),
+	)
+}
+
+/**
+ * @brief Find next occurrence after now
+ * @param recurrence Instance of OciTaskRecurrence
+ * @return Next occurrence in RFC3339 format, empty if recurrence has ended
+ */
+func nextOciTaskOccurrence(recurrence *ocitaskclient.OciTaskRecurrence) string {
+	occurrences := recurrence.Occurrences(ociTaskNow().Truncate(time.Second).Add(time.Second), 1)
+	if len(occurrences) == 0 {
+		return ""
+	}
+
+	return occurrences[0].Format(time.RFC3339)
+}
@@ -0,0 +1,240 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"net/http"
+	"ocitaskclient"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func setTestOciTaskNow(test *testing.T, now time.Time) {
+	previous := ociTaskNow
+	ociTaskNow = func() time.Time { return now }
+	test.Cleanup(func() { ociTaskNow = previous })
+}
+
+func makeTestOciTaskRecurringTaskData() map[string]interface{} {
+	return map[string]interface{}{
+		"template": []interface{}{map[string]interface{}{
+			"title":     "Rotate certificates",
+			"priority":  "7",
+			"due_after": "72h",
+		}},
+		"rrule":    "FREQ=MONTHLY;BYMONTHDAY=1",
+		"dtstart":  "2023-01-01T09:00:00",
+		"timezone": "Europe/Berlin",
+	}
+}
+
+func TestCreateRecurringTaskOperationSuccess(test *testing.T) {
+	setTestOciTaskNow(test, time.Date(2023, 3, 15, 0, 0, 0, 0, time.UTC))
+
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	taskId := int64(1001)
+	task := makeTestOciTask(taskId)
+	ociTaskServClientMock.On("CreateTask", mock.Anything, mock.MatchedBy(func(request *ocitaskclient.OciTaskServRequest) bool {
+		return *request.Title == "Rotate certificates" && *request.Priority == 7 && *request.Status == ocitaskclient.OciTaskStatusTodo &&
+			*request.StartDate == "2023-03-01T09:00:00+01:00" && *request.DueDate == "2023-03-04T09:00:00+01:00"
+	})).Return(&ocitaskclient.OciTaskServResponse{TaskId: &taskId}, nil).Once()
+	ociTaskServClientMock.On("GetTask", mock.Anything, &taskId).Return(&ocitaskclient.OciTaskServResponse{Task: &task}, nil).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskRecurringTask().Schema, makeTestOciTaskRecurringTaskData())
+
+	diags := ociTaskOperation.OciTaskRecurringTaskCreate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestCreateRecurringTaskOperationSuccess Failed: No Diagnostics expected")
+	assert.NotEmpty(test, rd.Id(), "TestCreateRecurringTaskOperationSuccess Failed: Resource Id expected")
+	assert.Equal(test, 1001, rd.Get("task_id"), "TestCreateRecurringTaskOperationSuccess Failed: Wrong Task Id")
+	assert.Equal(test, "2023-03-01T09:00:00+01:00", rd.Get("current_occurrence"), "TestCreateRecurringTaskOperationSuccess Failed: Wrong current occurrence")
+	assert.Equal(test, "2023-04-01T09:00:00+02:00", rd.Get("next_occurrence"), "TestCreateRecurringTaskOperationSuccess Failed: Wrong next occurrence")
+}
+
+func TestReadRecurringTaskOperationTaskGone(test *testing.T) {
+	setTestOciTaskNow(test, time.Date(2023, 3, 15, 0, 0, 0, 0, time.UTC))
+
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	ociTaskServClientMock.On("GetTask", mock.Anything, mock.Anything).Return(nil, &ocitaskclient.OciTaskServError{Operation: "GetTask", StatusCode: http.StatusNotFound}).Once()
+
+	testData := makeTestOciTaskRecurringTaskData()
+	testData["task_id"] = 1001
+	testData["current_occurrence"] = "2023-03-01T09:00:00+01:00"
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskRecurringTask().Schema, testData)
+	rd.SetId("recurring")
+
+	diags := ociTaskOperation.OciTaskRecurringTaskRead(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 0, len(diags), "TestReadRecurringTaskOperationTaskGone Failed: No Diagnostics expected")
+	assert.Equal(test, "recurring", rd.Id(), "TestReadRecurringTaskOperationTaskGone Failed: Recurring Task expected to be kept")
+	assert.Equal(test, 0, rd.Get("task_id"), "TestReadRecurringTaskOperationTaskGone Failed: Task Id expected to be cleared")
+	assert.Equal(test, "", rd.Get("current_occurrence"), "TestReadRecurringTaskOperationTaskGone Failed: Current occurrence expected to be cleared")
+}
+
+func TestUpdateRecurringTaskOperationTemplateKeepsStatus(test *testing.T) {
+	setTestOciTaskNow(test, time.Date(2023, 3, 15, 0, 0, 0, 0, time.UTC))
+
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	taskId := int64(1001)
+	task := makeTestOciTask(taskId)
+	ociTaskServClientMock.On("UpdateTask", mock.Anything, &taskId, mock.MatchedBy(func(request *ocitaskclient.OciTaskServRequest) bool {
+		return *request.Title == "Rotate certificates" && request.Status == nil && request.Completed == nil
+	})).Return(&ocitaskclient.OciTaskServResponse{}, nil).Once()
+	ociTaskServClientMock.On("GetTask", mock.Anything, &taskId).Return(&ocitaskclient.OciTaskServResponse{Task: &task}, nil).Once()
+
+	resource := MakeOciTaskResource().ResourceOciTaskRecurringTask()
+	state := &terraform.InstanceState{ID: "recurring", Attributes: map[string]string{
+		"id": "recurring", "rrule": "FREQ=MONTHLY;BYMONTHDAY=1", "dtstart": "2023-01-01T09:00:00", "timezone": "Europe/Berlin",
+		"template.#": "1", "template.0.title": "Rotate certs", "template.0.priority": "7", "template.0.due_after": "72h",
+		"task_id": "1001", "current_occurrence": "2023-03-01T09:00:00+01:00",
+	}}
+	config := terraform.NewResourceConfigRaw(makeTestOciTaskRecurringTaskData())
+	diff, err := resource.Diff(context.Background(), state, config, &ociTaskServClientMock)
+	assert.NoError(test, err, "TestUpdateRecurringTaskOperationTemplateKeepsStatus Failed: No error expected")
+
+	rd, _ := schema.InternalMap(resource.Schema).Data(state, diff)
+
+	diags := ociTaskOperation.OciTaskRecurringTaskUpdate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestUpdateRecurringTaskOperationTemplateKeepsStatus Failed: No Diagnostics expected")
+	assert.Equal(test, 1001, rd.Get("task_id"), "TestUpdateRecurringTaskOperationTemplateKeepsStatus Failed: Task of current occurrence expected to be kept")
+}
+
+func TestUpdateRecurringTaskOperationNextOccurrence(test *testing.T) {
+	setTestOciTaskNow(test, time.Date(2023, 4, 2, 0, 0, 0, 0, time.UTC))
+
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	taskId := int64(1002)
+	task := makeTestOciTask(taskId)
+	ociTaskServClientMock.On("CreateTask", mock.Anything, mock.MatchedBy(func(request *ocitaskclient.OciTaskServRequest) bool {
+		return *request.StartDate == "2023-04-01T09:00:00+02:00"
+	})).Return(&ocitaskclient.OciTaskServResponse{TaskId: &taskId}, nil).Once()
+	ociTaskServClientMock.On("GetTask", mock.Anything, &taskId).Return(&ocitaskclient.OciTaskServResponse{Task: &task}, nil).Once()
+
+	resource := MakeOciTaskResource().ResourceOciTaskRecurringTask()
+	state := &terraform.InstanceState{ID: "recurring", Attributes: map[string]string{
+		"id": "recurring", "rrule": "FREQ=MONTHLY;BYMONTHDAY=1", "dtstart": "2023-01-01T09:00:00", "timezone": "Europe/Berlin",
+		"template.#": "1", "template.0.title": "Rotate certificates", "template.0.priority": "7", "template.0.due_after": "72h",
+		"task_id": "1001", "current_occurrence": "2023-03-01T09:00:00+01:00", "next_occurrence": "2023-04-01T09:00:00+02:00",
+	}}
+	config := terraform.NewResourceConfigRaw(makeTestOciTaskRecurringTaskData())
+	diff, err := resource.Diff(context.Background(), state, config, &ociTaskServClientMock)
+
+	assert.NoError(test, err, "TestUpdateRecurringTaskOperationNextOccurrence Failed: No error expected")
+	assert.Equal(test, "2023-04-01T09:00:00+02:00", diff.Attributes["current_occurrence"].New, "TestUpdateRecurringTaskOperationNextOccurrence Failed: Current occurrence expected to move on")
+	assert.True(test, diff.Attributes["task_id"].NewComputed, "TestUpdateRecurringTaskOperationNextOccurrence Failed: New Task expected")
+
+	rd, _ := schema.InternalMap(resource.Schema).Data(state, diff)
+
+	diags := ociTaskOperation.OciTaskRecurringTaskUpdate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestUpdateRecurringTaskOperationNextOccurrence Failed: No Diagnostics expected")
+	assert.Equal(test, 1002, rd.Get("task_id"), "TestUpdateRecurringTaskOperationNextOccurrence Failed: Task of new occurrence expected")
+	assert.Equal(test, "2023-05-01T09:00:00+02:00", rd.Get("next_occurrence"), "TestUpdateRecurringTaskOperationNextOccurrence Failed: Wrong next occurrence")
+}
+
+func TestCustomizeDiffRecurrenceUnchanged(test *testing.T) {
+	setTestOciTaskNow(test, time.Date(2023, 3, 15, 0, 0, 0, 0, time.UTC))
+
+	resource := MakeOciTaskResource().ResourceOciTaskRecurringTask()
+	state := &terraform.InstanceState{ID: "recurring", Attributes: map[string]string{
+		"id": "recurring", "rrule": "FREQ=MONTHLY;BYMONTHDAY=1", "dtstart": "2023-01-01T09:00:00", "timezone": "Europe/Berlin",
+		"template.#": "1", "template.0.title": "Rotate certificates", "template.0.priority": "7", "template.0.due_after": "72h",
+		"task_id": "1001", "current_occurrence": "2023-03-01T09:00:00+01:00",
+	}}
+	config := terraform.NewResourceConfigRaw(makeTestOciTaskRecurringTaskData())
+
+	diff, err := resource.Diff(context.Background(), state, config, nil)
+
+	assert.NoError(test, err, "TestCustomizeDiffRecurrenceUnchanged Failed: No error expected")
+	assert.True(test, diff == nil || diff.Empty(), "TestCustomizeDiffRecurrenceUnchanged Failed: No changes expected")
+}
+
+func TestCustomizeDiffRecurrenceNoOccurrences(test *testing.T) {
+	testData := makeTestOciTaskRecurringTaskData()
+	testData["rrule"] = "FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30"
+
+	resource := MakeOciTaskResource().ResourceOciTaskRecurringTask()
+	_, err := resource.Diff(context.Background(), nil, terraform.NewResourceConfigRaw(testData), nil)
+
+	assert.Error(test, err, "TestCustomizeDiffRecurrenceNoOccurrences Failed: Error expected")
+	assert.Contains(test, err.Error(), "has no occurrences", "TestCustomizeDiffRecurrenceNoOccurrences Failed: Wrong error")
+}
+
+func TestDeleteRecurringTaskOperationSuccess(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	taskId := int64(1001)
+	ociTaskServClientMock.On("DeleteTask", mock.Anything, &taskId).Return(&ocitaskclient.OciTaskServResponse{}, nil).Once()
+
+	testData := makeTestOciTaskRecurringTaskData()
+	testData["task_id"] = 1001
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskRecurringTask().Schema, testData)
+	rd.SetId("recurring")
+
+	diags := ociTaskOperation.OciTaskRecurringTaskDelete(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestDeleteRecurringTaskOperationSuccess Failed: No Diagnostics expected")
+	assert.Equal(test, "", rd.Id(), "TestDeleteRecurringTaskOperationSuccess Failed: Resource should be removed from state")
+}
+
+func TestDeleteRecurringTaskOperationTaskGone(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	taskId := int64(1001)
+	ociTaskServClientMock.On("DeleteTask", mock.Anything, &taskId).Return(nil, &ocitaskclient.OciTaskServError{Operation: "DeleteTask", StatusCode: http.StatusNotFound}).Once()
+
+	testData := makeTestOciTaskRecurringTaskData()
+	testData["task_id"] = 1001
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskRecurringTask().Schema, testData)
+	rd.SetId("recurring")
+
+	diags := ociTaskOperation.OciTaskRecurringTaskDelete(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestDeleteRecurringTaskOperationTaskGone Failed: No Diagnostics expected")
+	assert.Equal(test, "", rd.Id(), "TestDeleteRecurringTaskOperationTaskGone Failed: Resource should be removed from state")
+}
+
+func TestReadOccurrencesOperationSuccess(test *testing.T) {
+	setTestOciTaskNow(test, time.Date(2023, 3, 15, 0, 0, 0, 0, time.UTC))
+
+	ociTaskOperation := OciTaskOperation{}
+
+	testData := map[string]interface{}{
+		"rrule":   "FREQ=WEEKLY;BYDAY=MO,TH",
+		"dtstart": "2023-01-02T10:00:00Z",
+		"exdates": []interface{}{"2023-03-16"},
+		"limit":   3,
+	}
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskDataSource().DataSourceOciTaskOccurrences().Schema, testData)
+
+	diags := ociTaskOperation.OciTaskOccurrencesRead(context.Background(), rd, nil)
+
+	assert.Equal(test, 0, len(diags), "TestReadOccurrencesOperationSuccess Failed: No Diagnostics expected")
+	assert.NotEmpty(test, rd.Id(), "TestReadOccurrencesOperationSuccess Failed: Data source Id expected")
+	assert.Equal(test, []interface{}{"2023-03-20T10:00:00Z", "2023-03-23T10:00:00Z", "2023-03-27T10:00:00Z"}, rd.Get("occurrences"), "TestReadOccurrencesOperationSuccess Failed: Wrong occurrences")
+}
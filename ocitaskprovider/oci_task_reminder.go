@@ -0,0 +1,340 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"ocitaskclient"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/**
+ * @brief Bring reminders of Task in OCI Task System in line with reminder blocks of Task resource.
+ *			Reminders are matched by position: existing ones are updated, extra blocks are created, dropped blocks deleted.
+ *			If sync stops on error, reminders synced so far and reminders not reached yet are still written into state,
+ *			so reminders created by this call aren't created again on next apply.
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains Task instance defined in Terraform scripts
+ * @param taskId Identifier of the Task
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func syncOciTaskReminders(ctx context.Context, rd *schema.ResourceData, taskId int64, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	oldValue, newValue := rd.GetChange("reminder")
+	oldReminders := oldValue.([]interface{})
+	newReminders := newValue.([]interface{})
+
+	ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+	synced := make([]interface{}, 0, len(newReminders))
+	for i, item := range newReminders {
+		reminder, err := expandOciTaskReminder(item)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Invalid reminder",
+				Detail:   err.Error(),
+			})
+			break
+		}
+
+		reminderId := int64(0)
+		if i < len(oldReminders) {
+			if oldReminder, ok := oldReminders[i].(map[string]interface{}); ok {
+				reminderId = int64(oldReminder["id"].(int))
+			}
+		}
+
+		summary := "Failed to create task reminder"
+		var ociResponse *ocitaskclient.OciTaskServResponse
+		if reminderId != 0 {
+			summary = "Failed to update task reminder"
+			ociResponse, err = ociClient.UpdateTaskReminder(ctx, &taskId, &reminderId, reminder)
+		} else {
+			ociResponse, err = ociClient.CreateTaskReminder(ctx, &taskId, reminder)
+		}
+
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  summary,
+				Detail:   err.Error(),
+			})
+			break
+		} else {
+			if ociResponse.Err != nil {
+				ociErr, _ := ociResponse.Err.Serialize()
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  summary,
+					Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+				break
+			} else if ociResponse.Reminder == nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  summary,
+					Detail:   "OCI Task Service returned no reminder" + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+				break
+			} else {
+				synced = append(synced, ociResponse.Reminder.Flatten())
+			}
+		}
+	}
+
+	if len(diags) == 0 {
+		for i := len(newReminders); i < len(oldReminders); i++ {
+			oldReminder, ok := oldReminders[i].(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			reminderId := int64(oldReminder["id"].(int))
+			ociResponse, err := ociClient.DeleteTaskReminder(ctx, &taskId, &reminderId)
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to delete task reminder",
+					Detail:   err.Error(),
+				})
+				synced = append(synced, oldReminder)
+			} else {
+				if ociResponse.Err != nil {
+					ociErr, _ := ociResponse.Err.Serialize()
+					diags = append(diags, diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  "Failed to delete task reminder",
+						Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+					})
+					synced = append(synced, oldReminder)
+				}
+			}
+		}
+	} else if len(synced) < len(oldReminders) {
+		// Reminders not reached yet are unchanged in OCI Task System
+		synced = append(synced, oldReminders[len(synced):]...)
+	}
+
+	err := rd.Set("reminder", synced)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to set task reminders into resource data",
+			Detail:   err.Error(),
+		})
+	}
+
+	return diags
+}
+
+/**
+ * @brief Read reminders of Task and compute when they fire next.
+ *			Reminders are only listed if Task resource manages any, so Tasks without reminders need no extra call.
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains Task instance defined in Terraform scripts
+ * @param taskId Identifier of the Task
+ * @param dueDate Due date of the Task in epoch milliseconds, used by offset reminders
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func readOciTaskReminders(ctx context.Context, rd *schema.ResourceData, taskId int64, dueDate *int64, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	nextReminderAt := ""
+	if len(rd.Get("reminder").([]interface{})) > 0 {
+		ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+		ociResponse, err := ociClient.ListTaskReminders(ctx, &taskId)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read task reminders",
+				Detail:   err.Error(),
+			})
+			return diags
+		} else if ociResponse.Err != nil {
+			ociErr, _ := ociResponse.Err.Serialize()
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read task reminders",
+				Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+			})
+			return diags
+		}
+
+		now := ociTaskNow()
+		var earliest time.Time
+		reminders := make([]interface{}, 0, len(ociResponse.Reminders))
+		for _, reminder := range ociResponse.Reminders {
+			item := reminder.Flatten()
+			item["next_reminder_at"] = ""
+
+			next, err := reminder.Next(dueDate, now)
+			if err != nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Warning,
+					Summary:  "Failed to compute next reminder",
+					Detail:   err.Error(),
+				})
+			} else if !next.IsZero() {
+				item["next_reminder_at"] = next.Format(time.RFC3339)
+				if earliest.IsZero() || next.Before(earliest) {
+					earliest = next
+				}
+			}
+
+			reminders = append(reminders, item)
+		}
+
+		err = rd.Set("reminder", reminders)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to set task reminders into resource data",
+				Detail:   err.Error(),
+			})
+		}
+
+		if !earliest.IsZero() {
+			nextReminderAt = earliest.UTC().Format(time.RFC3339)
+		}
+	}
+
+	err := rd.Set("next_reminder_at", nextReminderAt)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to set next reminder into resource data",
+			Detail:   err.Error(),
+		})
+	}
+
+	return diags
+}
+
+/**
+ * @brief Check reminder blocks of Task at plan time: each reminder fires either on cron schedule or at offset.
+ *			Marks next reminder unknown if reminders or due date change.
+ * @param ctx Context to Terraform Provider
+ * @param rdiff Planned changes of Task resource
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Instance of error if reminder is invalid
+ */
+func customizeOciTaskReminders(ctx context.Context, rdiff *schema.ResourceDiff, m interface{}) error {
+	reminders, _ := rdiff.Get("reminder").([]interface{})
+	for i := range reminders {
+		cronKey, offsetKey := fmt.Sprintf("reminder.%d.cron", i), fmt.Sprintf("reminder.%d.offset", i)
+		if !rdiff.NewValueKnown(cronKey) || !rdiff.NewValueKnown(offsetKey) {
+			continue
+		}
+
+		cron, _ := rdiff.Get(cronKey).(string)
+		offset, _ := rdiff.Get(offsetKey).(string)
+		if (cron == "") == (offset == "") {
+			return fmt.Errorf("reminder.%d: exactly one of cron or offset must be set", i)
+		}
+	}
+
+	if rdiff.Id() != "" && (ociTaskRemindersChanged(rdiff) || rdiff.HasChange("items.0.due_date")) {
+		return rdiff.SetNewComputed("next_reminder_at")
+	}
+
+	return nil
+}
+
+/**
+ * @brief Check if plan changes reminders of Task. Unlike HasChange, offsets which only differ in format are equal.
+ * @param rdiff Planned changes of Task resource
+ * @return True if any reminder is added, removed or changed
+ */
+func ociTaskRemindersChanged(rdiff *schema.ResourceDiff) bool {
+	oldValue, newValue := rdiff.GetChange("reminder")
+	oldReminders, _ := oldValue.([]interface{})
+	newReminders, _ := newValue.([]interface{})
+	if len(oldReminders) != len(newReminders) {
+		return true
+	}
+
+	for i := range newReminders {
+		oldReminder, _ := oldReminders[i].(map[string]interface{})
+		newReminder, _ := newReminders[i].(map[string]interface{})
+		for _, key := range []string{"cron", "timezone", "channel"} {
+			if oldReminder[key] != newReminder[key] {
+				return true
+			}
+		}
+
+		oldOffset, _ := oldReminder["offset"].(string)
+		newOffset, _ := newReminder["offset"].(string)
+		if oldOffset != newOffset && !suppressOciTaskDurationDiff("offset", oldOffset, newOffset, nil) {
+			return true
+		}
+	}
+
+	return false
+}
+
+/**
+ * @brief Suppress difference between durations which only differ in format, e.g. 1h and 1h0m0s
+ * @return True if both durations are equal
+ */
+func suppressOciTaskDurationDiff(k string, old string, new string, rd *schema.ResourceData) bool {
+	oldDuration, err := time.ParseDuration(old)
+	if err != nil {
+		return false
+	}
+
+	newDuration, err := time.ParseDuration(new)
+	if err != nil {
+		return false
+	}
+
+	return oldDuration == newDuration
+}
+
+/**
+ * @brief Convert reminder block of Task resource into OciTaskReminder instance
+ * @param item Reminder block from resource data
+ * @return Instance of OciTaskReminder if succeeded
+ * @return Instance of error if reminder has no schedule or offset is malformed
+ */
+func expandOciTaskReminder(item interface{}) (*ocitaskclient.OciTaskReminder, error) {
+	data, ok := item.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("Reminder requires exactly one of cron or offset")
+	}
+
+	cron, _ := data["cron"].(string)
+	offset, _ := data["offset"].(string)
+	if (cron == "") == (offset == "") {
+		return nil, errors.New("Reminder requires exactly one of cron or offset")
+	}
+
+	reminder := ocitaskclient.OciTaskReminder{}
+	if cron != "" {
+		reminder.Cron = &cron
+	} else {
+		duration, err := time.ParseDuration(offset)
+		if err != nil {
+			return nil, err
+		}
+		offsetSeconds := int64(duration / time.Second)
+		reminder.OffsetSeconds = &offsetSeconds
+	}
+
+	if timeZone, _ := data["timezone"].(string); timeZone != "" {
+		reminder.TimeZone = &timeZone
+	}
+
+	if channel, _ := data["channel"].(string); channel != "" {
+		reminder.Channel = &channel
+	}
+
+	return &reminder, nil
+}
@@ -0,0 +1,193 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"errors"
+	"ocitaskclient"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func makeTestOciTaskReminderResponse(id int64, cron string, offsetSeconds int64, channel string) *ocitaskclient.OciTaskServResponse {
+	reminder := ocitaskclient.OciTaskReminder{Id: &id, Channel: &channel}
+	if cron != "" {
+		reminder.Cron = &cron
+	} else {
+		reminder.OffsetSeconds = &offsetSeconds
+	}
+
+	return &ocitaskclient.OciTaskServResponse{Reminder: &reminder}
+}
+
+func makeTestOciTaskReminderState() *terraform.InstanceState {
+	state := makeTestOciTaskState("todo", "false")
+	state.Attributes["reminder.#"] = "2"
+	state.Attributes["reminder.0.id"] = "1"
+	state.Attributes["reminder.0.cron"] = "0 9 * * MON-FRI"
+	state.Attributes["reminder.0.timezone"] = "UTC"
+	state.Attributes["reminder.0.channel"] = "email"
+	state.Attributes["reminder.1.id"] = "2"
+	state.Attributes["reminder.1.offset"] = "24h0m0s"
+	state.Attributes["reminder.1.timezone"] = "UTC"
+	state.Attributes["reminder.1.channel"] = "slack"
+	state.Attributes["next_reminder_at"] = "2023-03-16T09:00:00Z"
+
+	return state
+}
+
+func TestCreateTaskOperationWithReminders(test *testing.T) {
+	setTestOciTaskNow(test, time.Date(2023, 3, 15, 10, 0, 0, 0, time.UTC))
+
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	taskId := int64(1001)
+	task := makeTestOciTask(taskId)
+	dueDate := time.Date(2023, 3, 16, 12, 0, 0, 0, time.UTC).UnixMilli()
+	task.DueDate = &dueDate
+
+	cronReminder := makeTestOciTaskReminderResponse(1, "0 9 * * MON-FRI", 0, "email")
+	offsetReminder := makeTestOciTaskReminderResponse(2, "", 7200, "slack")
+
+	ociTaskServClientMock.On("CreateTask", mock.Anything, mock.Anything).Return(&ocitaskclient.OciTaskServResponse{TaskId: &taskId}, nil).Once()
+	ociTaskServClientMock.On("CreateTaskReminder", mock.Anything, &taskId, mock.MatchedBy(func(reminder *ocitaskclient.OciTaskReminder) bool {
+		return reminder.Cron != nil && *reminder.Cron == "0 9 * * MON-FRI" && reminder.OffsetSeconds == nil
+	})).Return(cronReminder, nil).Once()
+	ociTaskServClientMock.On("CreateTaskReminder", mock.Anything, &taskId, mock.MatchedBy(func(reminder *ocitaskclient.OciTaskReminder) bool {
+		return reminder.Cron == nil && *reminder.OffsetSeconds == 7200
+	})).Return(offsetReminder, nil).Once()
+	ociTaskServClientMock.On("GetTask", mock.Anything, &taskId).Return(&ocitaskclient.OciTaskServResponse{Task: &task}, nil).Once()
+	ociTaskServClientMock.On("ListTaskReminders", mock.Anything, &taskId).Return(&ocitaskclient.OciTaskServResponse{
+		Reminders: []ocitaskclient.OciTaskReminder{*cronReminder.Reminder, *offsetReminder.Reminder},
+	}, nil).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTask().Schema, map[string]interface{}{
+		"items": []interface{}{map[string]interface{}{"title": "Test Task 1"}},
+		"reminder": []interface{}{
+			map[string]interface{}{"cron": "0 9 * * MON-FRI", "channel": "email"},
+			map[string]interface{}{"offset": "2h", "channel": "slack"},
+		},
+	})
+
+	diags := ociTaskOperation.OciTaskCreate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestCreateTaskOperationWithReminders Failed: No Diagnostics expected")
+	assert.Equal(test, 1, rd.Get("reminder.0.id"), "TestCreateTaskOperationWithReminders Failed: Reminder Id expected")
+	assert.Equal(test, "2023-03-16T09:00:00Z", rd.Get("reminder.0.next_reminder_at"), "TestCreateTaskOperationWithReminders Failed: Wrong next cron reminder")
+	assert.Equal(test, "2023-03-16T10:00:00Z", rd.Get("reminder.1.next_reminder_at"), "TestCreateTaskOperationWithReminders Failed: Wrong next offset reminder")
+	assert.Equal(test, "2023-03-16T09:00:00Z", rd.Get("next_reminder_at"), "TestCreateTaskOperationWithReminders Failed: Earliest reminder expected")
+}
+
+func TestUpdateTaskOperationSyncsReminders(test *testing.T) {
+	setTestOciTaskNow(test, time.Date(2023, 3, 15, 10, 0, 0, 0, time.UTC))
+
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	taskId, firstId, secondId := int64(1001), int64(1), int64(2)
+	task := makeTestOciTask(taskId)
+	updatedReminder := makeTestOciTaskReminderResponse(firstId, "@daily", 0, "webhook")
+
+	ociTaskServClientMock.On("UpdateTask", mock.Anything, &taskId, mock.Anything).Return(&ocitaskclient.OciTaskServResponse{TaskId: &taskId}, nil).Once()
+	ociTaskServClientMock.On("UpdateTaskReminder", mock.Anything, &taskId, &firstId, mock.MatchedBy(func(reminder *ocitaskclient.OciTaskReminder) bool {
+		return *reminder.Cron == "@daily" && *reminder.Channel == "webhook"
+	})).Return(updatedReminder, nil).Once()
+	ociTaskServClientMock.On("DeleteTaskReminder", mock.Anything, &taskId, &secondId).Return(&ocitaskclient.OciTaskServResponse{}, nil).Once()
+	ociTaskServClientMock.On("GetTask", mock.Anything, &taskId).Return(&ocitaskclient.OciTaskServResponse{Task: &task}, nil).Once()
+	ociTaskServClientMock.On("ListTaskReminders", mock.Anything, &taskId).Return(&ocitaskclient.OciTaskServResponse{
+		Reminders: []ocitaskclient.OciTaskReminder{*updatedReminder.Reminder},
+	}, nil).Once()
+
+	resource := MakeOciTaskResource().ResourceOciTask()
+	state := makeTestOciTaskReminderState()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"items":    []interface{}{map[string]interface{}{"title": "Test Task 1", "status": "todo"}},
+		"reminder": []interface{}{map[string]interface{}{"cron": "@daily", "channel": "webhook"}},
+	})
+	diff, err := resource.Diff(context.Background(), state, config, &ociTaskServClientMock)
+
+	assert.NoError(test, err, "TestUpdateTaskOperationSyncsReminders Failed: No error expected")
+	assert.True(test, diff.Attributes["next_reminder_at"].NewComputed, "TestUpdateTaskOperationSyncsReminders Failed: Next reminder expected to be recomputed")
+
+	rd, _ := schema.InternalMap(resource.Schema).Data(state, diff)
+
+	diags := ociTaskOperation.OciTaskUpdate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestUpdateTaskOperationSyncsReminders Failed: No Diagnostics expected")
+	assert.Equal(test, 1, len(rd.Get("reminder").([]interface{})), "TestUpdateTaskOperationSyncsReminders Failed: One reminder expected")
+	assert.Equal(test, "2023-03-16T00:00:00Z", rd.Get("next_reminder_at"), "TestUpdateTaskOperationSyncsReminders Failed: Wrong next reminder")
+}
+
+func TestSyncTaskRemindersKeepsSyncedOnFailure(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+
+	taskId := int64(1001)
+	cronReminder := makeTestOciTaskReminderResponse(1, "0 9 * * MON-FRI", 0, "email")
+
+	ociTaskServClientMock.On("CreateTaskReminder", mock.Anything, &taskId, mock.MatchedBy(func(reminder *ocitaskclient.OciTaskReminder) bool {
+		return reminder.Cron != nil
+	})).Return(cronReminder, nil).Once()
+	ociTaskServClientMock.On("CreateTaskReminder", mock.Anything, &taskId, mock.MatchedBy(func(reminder *ocitaskclient.OciTaskReminder) bool {
+		return reminder.Cron == nil
+	})).Return(nil, errors.New("connection reset")).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTask().Schema, map[string]interface{}{
+		"items": []interface{}{map[string]interface{}{"title": "Test Task 1"}},
+		"reminder": []interface{}{
+			map[string]interface{}{"cron": "0 9 * * MON-FRI", "channel": "email"},
+			map[string]interface{}{"offset": "2h", "channel": "slack"},
+		},
+	})
+
+	diags := syncOciTaskReminders(context.Background(), rd, taskId, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 1, len(diags), "TestSyncTaskRemindersKeepsSyncedOnFailure Failed: One Diagnostic expected")
+	assert.Equal(test, "Failed to create task reminder", diags[0].Summary, "TestSyncTaskRemindersKeepsSyncedOnFailure Failed: Wrong Diagnostic")
+	assert.Equal(test, 1, len(rd.Get("reminder").([]interface{})), "TestSyncTaskRemindersKeepsSyncedOnFailure Failed: Created reminder expected in state")
+	assert.Equal(test, 1, rd.Get("reminder.0.id"), "TestSyncTaskRemindersKeepsSyncedOnFailure Failed: Created reminder Id expected")
+}
+
+func TestCustomizeDiffReminderFailedBothSchedules(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+
+	resource := MakeOciTaskResource().ResourceOciTask()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"items":    []interface{}{map[string]interface{}{"title": "Test Task 1", "status": "todo"}},
+		"reminder": []interface{}{map[string]interface{}{"cron": "@daily", "offset": "1h", "channel": "email"}},
+	})
+
+	_, err := resource.Diff(context.Background(), makeTestOciTaskState("todo", "false"), config, &ociTaskServClientMock)
+
+	assert.Error(test, err, "TestCustomizeDiffReminderFailedBothSchedules Failed: Error expected")
+	assert.Contains(test, err.Error(), "exactly one of cron or offset", "TestCustomizeDiffReminderFailedBothSchedules Failed: Wrong error")
+}
+
+func TestCustomizeDiffReminderOffsetFormat(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+
+	resource := MakeOciTaskResource().ResourceOciTask()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"items": []interface{}{map[string]interface{}{"title": "Test Task 1", "status": "todo"}},
+		"reminder": []interface{}{
+			map[string]interface{}{"cron": "0 9 * * MON-FRI", "channel": "email"},
+			map[string]interface{}{"offset": "24h", "channel": "slack"},
+		},
+	})
+
+	diff, err := resource.Diff(context.Background(), makeTestOciTaskReminderState(), config, &ociTaskServClientMock)
+
+	assert.NoError(test, err, "TestCustomizeDiffReminderOffsetFormat Failed: No error expected")
+	assert.Nil(test, diff.Attributes["reminder.1.offset"], "TestCustomizeDiffReminderOffsetFormat Failed: No change expected for equal offsets")
+	assert.Nil(test, diff.Attributes["next_reminder_at"], "TestCustomizeDiffReminderOffsetFormat Failed: Next reminder expected to be kept")
+}
@@ -1,7 +1,11 @@
 package ocitaskprovider
 
 import (
+	"ocitaskclient"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 /**
@@ -31,23 +35,473 @@ func (ociTaskResource *OciTaskResource) ResourceOciTask() *schema.Resource {
 		ReadContext:   ociTaskResource.ociTaskOperation.OciTaskRead,
 		UpdateContext: ociTaskResource.ociTaskOperation.OciTaskUpdate,
 		DeleteContext: ociTaskResource.ociTaskOperation.OciTaskDelete,
+		CustomizeDiff: customdiff.All(customizeOciTaskTagsAll, customizeOciTaskStatus, customizeOciTaskPriority, customizeOciTaskParent, customizeOciTaskAssignee, customizeOciTaskReminders, customizeOciTaskChecklist, customizeOciTaskCustomFields, customizeOciTaskArchived),
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{
+			{
+				Version: 0,
+				Type:    ociTaskResource.resourceOciTaskV0Type(),
+				Upgrade: upgradeOciTaskStateV0,
+			},
+		},
+		Schema: ociTaskResource.resourceOciTaskSchema(),
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+/**
+ * @brief Build attributes of Task resource in OCI Task System
+ * @return Map of attribute name and schema
+ */
+func (ociTaskResource *OciTaskResource) resourceOciTaskSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"last_updated": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Computed: true,
+		},
+		"tags_all": {
+			Type:        schema.TypeMap,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Effective tags of Task, including default tags configured on provider.",
+		},
+		"reminder": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Reminders of Task. Each reminder fires either on cron schedule or once at offset before due date.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"cron": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						ValidateDiagFunc: validateOciTaskCron,
+						Description:      "Cron expression with five fields, e.g. 0 9 * * MON-FRI, or macro such as @daily.",
+					},
+					"offset": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						ValidateDiagFunc: validateOciTaskDuration,
+						DiffSuppressFunc: suppressOciTaskDurationDiff,
+						Description:      "Time before due date of Task the reminder fires, e.g. 24h.",
+					},
+					"timezone": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						Default:          "UTC",
+						ValidateDiagFunc: validateOciTaskTimeZone,
+						Description:      "IANA time zone cron expression is evaluated in.",
+					},
+					"channel": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(ocitaskclient.OciTaskReminderChannels, false),
+						Description:  "Channel reminder is delivered to.",
+					},
+					"next_reminder_at": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Time reminder fires next in RFC3339 format, empty if it won't fire again.",
+					},
+				},
+			},
+		},
+		"next_reminder_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Time any reminder of Task fires next in RFC3339 format, empty if none will fire.",
+		},
+		"deletion_mode": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{OciTaskDeletionModeDelete, OciTaskDeletionModeArchive}, false)),
+			Description:      "What happens to Task when it is destroyed: delete (default) removes it for good, archive keeps it with its history so it can be restored by importing it again.",
+		},
+		"deletion_protection": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "True to refuse destroying Task. Must be set to false and applied before Task can be destroyed.",
+		},
+		"archived": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "True if Task is archived. Archived Task is restored on next apply.",
+		},
+		"checklist": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Description: "Ordered checklist items of Task. Moved or edited items keep their identity in OCI Task System.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeInt,
+						Computed: true,
+					},
+					"text": {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotWhiteSpace,
+						Description:  "Text of checklist item.",
+					},
+					"done": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Default:     false,
+						Description: "True if checklist item is done.",
+					},
+				},
+			},
+		},
+		"checklist_progress": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "Percentage of checklist items which are done, from 0 to 100.",
+		},
+		"items": {
+			Type:     schema.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:     schema.TypeInt,
+						Optional: true,
+						Computed: true,
+					},
+					"title": {
+						Type:     schema.TypeString,
+						Required: true,
+					},
+					"description": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+					"priority": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Computed:    true,
+						Description: "Priority as integer from 1 to 10 or as level name: low, medium, high or critical. Level names are mapped to integers by priority levels configured on provider.",
+					},
+					"priority_name": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Level name of priority, empty if priority maps to no level.",
+					},
+					"completed": {
+						Type:        schema.TypeBool,
+						Optional:    true,
+						Computed:    true,
+						Deprecated:  "Use status instead.",
+						Description: "True if status is done. Setting it moves Task to done, or back to todo, unless status is set.",
+					},
+					"status": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						Computed:         true,
+						ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(ocitaskclient.OciTaskStatuses, false)),
+						Description:      "Status of Task: todo, in_progress, blocked, done or cancelled. Changes must follow status transitions configured on provider.",
+					},
+					"status_changed_at": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "Time of last status change in RFC3339 format.",
+					},
+					"start_date": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+					"due_date": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+					"time_updated": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+					"time_created": {
+						Type:     schema.TypeString,
+						Optional: true,
+						Computed: true,
+					},
+					"parent_id": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "Identifier of parent Task. Moving Task under one of its own descendants is rejected.",
+					},
+					"project_id": {
+						Type:        schema.TypeInt,
+						Optional:    true,
+						Description: "Identifier of Project the Task belongs to. Task belongs to no Project if not set.",
+					},
+					"assignee": {
+						Type:             schema.TypeString,
+						Optional:         true,
+						ValidateDiagFunc: validateOciTaskUserEmail,
+						Description:      "E-mail address of user the Task is assigned to. User must exist in OCI Task System.",
+					},
+					"estimate_minutes": {
+						Type:         schema.TypeInt,
+						Optional:     true,
+						ValidateFunc: validation.IntAtLeast(0),
+						Description:  "Estimated effort of Task in minutes, excluding its subtasks.",
+					},
+					"logged_minutes": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Minutes logged on Task and all its subtasks. Only rolled up for Tasks with estimate_minutes, 0 otherwise.",
+					},
+					"remaining_minutes": {
+						Type:        schema.TypeInt,
+						Computed:    true,
+						Description: "Estimates of Task and all its subtasks less logged minutes, never below 0. Only rolled up for Tasks with estimate_minutes, 0 otherwise.",
+					},
+					"watchers": {
+						Type:        schema.TypeSet,
+						Optional:    true,
+						Description: "E-mail addresses of users notified about changes of Task. Users must exist in OCI Task System.",
+						Elem: &schema.Schema{
+							Type:             schema.TypeString,
+							ValidateDiagFunc: validateOciTaskUserEmail,
+						},
+					},
+					"tags": {
+						Type:             schema.TypeMap,
+						Optional:         true,
+						Elem:             &schema.Schema{Type: schema.TypeString},
+						ValidateDiagFunc: validateOciTaskTags,
+						Description:      "Free-form labels. Keys are case-insensitive and stored in lower case. Override default tags configured on provider.",
+					},
+					"custom_fields": {
+						Type:        schema.TypeMap,
+						Optional:    true,
+						Elem:        &schema.Schema{Type: schema.TypeString},
+						Description: "Values of custom fields defined on Project of Task, by name. Values of custom fields already defined are checked at plan time, undefined and missing required custom fields at apply, as custom fields may be defined in the same plan.",
+					},
+				},
+			},
+		},
+	}
+}
+
+/**
+ * @brief Build schema for Task dependency resource in OCI Task System
+ * @return Instance of schema.Resource contains schema for Task dependency resource in OCI Task System
+ */
+func (ociTaskResource *OciTaskResource) ResourceOciTaskDependency() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ociTaskResource.ociTaskOperation.OciTaskDependencyCreate,
+		ReadContext:   ociTaskResource.ociTaskOperation.OciTaskDependencyRead,
+		DeleteContext: ociTaskResource.ociTaskOperation.OciTaskDependencyDelete,
+		CustomizeDiff: customizeOciTaskDependency,
 		Schema: map[string]*schema.Schema{
-			"last_updated": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
-			},
-			"items": {
-				Type:     schema.TypeList,
-				Required: true,
-				MaxItems: 1,
+			"blocker_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of Task which must be finished first.",
+			},
+			"blocked_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of Task which waits for blocker Task.",
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          ocitaskclient.OciTaskDependencyBlocks,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(ocitaskclient.OciTaskDependencyTypes, false)),
+				Description:      "Type of dependency: blocks or relates_to. Only blocks dependencies order Tasks and may not form a cycle. Cycle with stored dependencies is reported at plan. To report cycle among dependencies created in the same apply at plan too, pass them as planned_dependency to ocitask_task_dependency_graph data source, otherwise it is reported at apply and only the dependency with lowest Identifier is kept.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+/**
+ * @brief Build schema for Project resource in OCI Task System
+ * @return Instance of schema.Resource contains schema for Project resource in OCI Task System
+ */
+func (ociTaskResource *OciTaskResource) ResourceOciTaskProject() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ociTaskResource.ociTaskOperation.OciTaskProjectCreate,
+		ReadContext:   ociTaskResource.ociTaskOperation.OciTaskProjectRead,
+		UpdateContext: ociTaskResource.ociTaskOperation.OciTaskProjectUpdate,
+		DeleteContext: ociTaskResource.ociTaskOperation.OciTaskProjectDelete,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Name of Project.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of Project.",
+			},
+			"owner": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "User responsible for Project.",
+			},
+			"archived": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "True if Project is archived.",
+			},
+			"deletion_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          OciTaskProjectDeletionRefuse,
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{OciTaskProjectDeletionRefuse, OciTaskProjectDeletionCascade}, false)),
+				Description:      "What happens to Tasks of Project when Project is destroyed: refuse fails while Project still has Tasks, cascade deletes them with Project.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+/**
+ * @brief Build schema for Task comment resource in OCI Task System
+ * @return Instance of schema.Resource contains schema for Task comment resource in OCI Task System
+ */
+func (ociTaskResource *OciTaskResource) ResourceOciTaskComment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ociTaskResource.ociTaskOperation.OciTaskCommentCreate,
+		ReadContext:   ociTaskResource.ociTaskOperation.OciTaskCommentRead,
+		UpdateContext: ociTaskResource.ociTaskOperation.OciTaskCommentUpdate,
+		DeleteContext: ociTaskResource.ociTaskOperation.OciTaskCommentDelete,
+		Schema: map[string]*schema.Schema{
+			"task_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of Task the comment belongs to.",
+			},
+			"body": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Text of comment.",
+			},
+			"author": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "E-mail address of user who wrote the comment.",
+			},
+			"time_created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time comment was added in RFC3339 format.",
+			},
+			"time_updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time comment was last edited in RFC3339 format.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+/**
+ * @brief Build schema for Task attachment resource in OCI Task System
+ * @return Instance of schema.Resource contains schema for Task attachment resource in OCI Task System
+ */
+func (ociTaskResource *OciTaskResource) ResourceOciTaskAttachment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ociTaskResource.ociTaskOperation.OciTaskAttachmentCreate,
+		ReadContext:   ociTaskResource.ociTaskOperation.OciTaskAttachmentRead,
+		DeleteContext: ociTaskResource.ociTaskOperation.OciTaskAttachmentDelete,
+		CustomizeDiff: customizeOciTaskAttachmentSource,
+		Schema: map[string]*schema.Schema{
+			"task_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of Task the file is attached to.",
+			},
+			"source": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Path to local file to upload.",
+			},
+			"file_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Name of attached file. Defaults to base name of source.",
+			},
+			"source_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 of source at the time it was uploaded. Attachment is replaced when content of source changes.",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Media type of attached file as detected by OCI Task Service.",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Size of attached file in bytes.",
+			},
+			"sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-256 of attached file as stored by OCI Task Service.",
+			},
+			"time_created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time file was attached in RFC3339 format.",
+			},
+		},
+	}
+}
+
+/**
+ * @brief Build schema for recurring Task resource in OCI Task System
+ * @return Instance of schema.Resource contains schema for recurring Task resource in OCI Task System
+ */
+func (ociTaskResource *OciTaskResource) ResourceOciTaskRecurringTask() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ociTaskResource.ociTaskOperation.OciTaskRecurringTaskCreate,
+		ReadContext:   ociTaskResource.ociTaskOperation.OciTaskRecurringTaskRead,
+		UpdateContext: ociTaskResource.ociTaskOperation.OciTaskRecurringTaskUpdate,
+		DeleteContext: ociTaskResource.ociTaskOperation.OciTaskRecurringTaskDelete,
+		CustomizeDiff: customizeOciTaskRecurrence,
+		Schema: map[string]*schema.Schema{
+			"template": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Task created for each occurrence.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"id": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Computed: true,
-						},
 						"title": {
 							Type:     schema.TypeString,
 							Required: true,
@@ -55,41 +509,294 @@ func (ociTaskResource *OciTaskResource) ResourceOciTask() *schema.Resource {
 						"description": {
 							Type:     schema.TypeString,
 							Optional: true,
-							Computed: true,
 						},
 						"priority": {
-							Type:     schema.TypeInt,
-							Optional: true,
-							Computed: true,
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Priority as integer from 1 to 10 or as level name configured on provider.",
 						},
-						"completed": {
-							Type:     schema.TypeBool,
-							Optional: true,
-							Computed: true,
+						"project_id": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Identifier of Project the Tasks belong to.",
 						},
-						"start_date": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+						"assignee": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateOciTaskUserEmail,
+							Description:      "E-mail address of user the Tasks are assigned to.",
 						},
-						"due_date": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+						"tags": {
+							Type:             schema.TypeMap,
+							Optional:         true,
+							Elem:             &schema.Schema{Type: schema.TypeString},
+							ValidateDiagFunc: validateOciTaskTags,
+							Description:      "Free-form labels of the Tasks.",
 						},
-						"time_updated": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
-						},
-						"time_created": {
-							Type:     schema.TypeString,
-							Optional: true,
-							Computed: true,
+						"due_after": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ValidateDiagFunc: validateOciTaskDuration,
+							Description:      "Time from occurrence until Task is due, e.g. 72h. Task is due at occurrence if not set.",
 						},
 					},
 				},
 			},
+			"rrule": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOciTaskRecurrenceRule,
+				Description:      "Recurrence rule in RFC 5545 RRULE format, e.g. FREQ=MONTHLY;INTERVAL=3;BYDAY=1MO. Supports FREQ of DAILY, WEEKLY, MONTHLY and YEARLY with INTERVAL, COUNT, UNTIL, BYDAY, BYMONTHDAY, BYMONTH and WKST.",
+			},
+			"dtstart": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOciTaskRecurrenceTime,
+				Description:      "Start of recurrence. Its time of day is the time of day of every occurrence. RFC3339, or local time (2006-01-02T15:04:05) or date (2006-01-02) in timezone.",
+			},
+			"timezone": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "UTC",
+				ValidateDiagFunc: validateOciTaskTimeZone,
+				Description:      "IANA name of time zone occurrences are computed in, e.g. Europe/Berlin.",
+			},
+			"exdates": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Excluded occurrences (EXDATE). Date without time excludes any occurrence on that day.",
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: validateOciTaskRecurrenceTime,
+				},
+			},
+			"task_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Identifier of Task created for current occurrence.",
+			},
+			"current_occurrence": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Latest occurrence which already started, or first occurrence if recurrence hasn't started yet, in RFC3339 format. New Task is created when it moves on.",
+			},
+			"next_occurrence": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Next occurrence in RFC3339 format, empty if recurrence has ended.",
+			},
+		},
+	}
+}
+
+/**
+ * @brief Build schema for time entry resource in OCI Task System
+ * @return Instance of schema.Resource contains schema for time entry resource in OCI Task System
+ */
+func (ociTaskResource *OciTaskResource) ResourceOciTaskTimeEntry() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ociTaskResource.ociTaskOperation.OciTaskTimeEntryCreate,
+		ReadContext:   ociTaskResource.ociTaskOperation.OciTaskTimeEntryRead,
+		UpdateContext: ociTaskResource.ociTaskOperation.OciTaskTimeEntryUpdate,
+		DeleteContext: ociTaskResource.ociTaskOperation.OciTaskTimeEntryDelete,
+		Schema: map[string]*schema.Schema{
+			"task_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of Task time is logged on.",
+			},
+			"minutes": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Logged time in minutes.",
+			},
+			"date": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: validateOciTaskDate,
+				Description:      "Date time was spent on, in YYYY-MM-DD format.",
+			},
+			"note": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "What time was spent on.",
+			},
+			"author": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "E-mail address of user who logged the time.",
+			},
+			"time_created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time entry was added in RFC3339 format.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+/**
+ * @brief Build schema for custom field resource in OCI Task System
+ * @return Instance of schema.Resource contains schema for custom field resource in OCI Task System
+ */
+func (ociTaskResource *OciTaskResource) ResourceOciTaskCustomField() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ociTaskResource.ociTaskOperation.OciTaskCustomFieldCreate,
+		ReadContext:   ociTaskResource.ociTaskOperation.OciTaskCustomFieldRead,
+		UpdateContext: ociTaskResource.ociTaskOperation.OciTaskCustomFieldUpdate,
+		DeleteContext: ociTaskResource.ociTaskOperation.OciTaskCustomFieldDelete,
+		CustomizeDiff: customizeOciTaskCustomField,
+		Schema: map[string]*schema.Schema{
+			"project_id": {
+				Type:        schema.TypeInt,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Identifier of Project the custom field is defined on.",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotWhiteSpace,
+				Description:  "Name of custom field, used as key in custom_fields of Tasks.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ocitaskclient.OciTaskCustomFieldTypes, false),
+				Description:  "Type of custom field: string, number, bool, enum or date. Dates are in YYYY-MM-DD format.",
+			},
+			"options": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Allowed values of enum custom field.",
+			},
+			"required": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "True if Tasks of Project must set custom field.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "What custom field is used for.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+/**
+ * @brief Build schema for webhook subscription resource in OCI Task System
+ * @return Instance of schema.Resource contains schema for webhook subscription resource in OCI Task System
+ */
+func (ociTaskResource *OciTaskResource) ResourceOciTaskWebhook() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ociTaskResource.ociTaskOperation.OciTaskWebhookCreate,
+		ReadContext:   ociTaskResource.ociTaskOperation.OciTaskWebhookRead,
+		UpdateContext: ociTaskResource.ociTaskOperation.OciTaskWebhookUpdate,
+		DeleteContext: ociTaskResource.ociTaskOperation.OciTaskWebhookDelete,
+		Schema: map[string]*schema.Schema{
+			"url": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				Description:  "URL OCI Task System posts events to.",
+			},
+			"events": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringInSlice(ocitaskclient.OciTaskWebhookEvents, false)},
+				Description: "Events to be notified of: task.created, task.updated, task.deleted, task.archived, task.restored or comment.created.",
+			},
+			"secret": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(16, 256),
+				Description:  "Secret payloads are signed with. Signature is sent in X-OciTask-Signature header as sha256=<hex encoded HMAC-SHA256 of payload>. OCI Task System never returns it, so imported webhooks rotate it on next apply.",
+			},
+			"active": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "False to pause deliveries without removing subscription.",
+			},
+			"time_created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time webhook was subscribed in RFC3339 format.",
+			},
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+	}
+}
+
+/**
+ * @brief Build schema for permission resource in OCI Task System
+ * @return Instance of schema.Resource contains schema for permission resource in OCI Task System
+ */
+func (ociTaskResource *OciTaskResource) ResourceOciTaskPermission() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: ociTaskResource.ociTaskOperation.OciTaskPermissionCreate,
+		ReadContext:   ociTaskResource.ociTaskOperation.OciTaskPermissionRead,
+		DeleteContext: ociTaskResource.ociTaskOperation.OciTaskPermissionDelete,
+		CustomizeDiff: customizeOciTaskPermission,
+		Schema: map[string]*schema.Schema{
+			"task_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"task_id", "project_id"},
+				Description:  "Identifier of Task to grant role on.",
+			},
+			"project_id": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"task_id", "project_id"},
+				Description:  "Identifier of Project to grant role on. Role applies to all Tasks of Project.",
+			},
+			"principal_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ocitaskclient.OciTaskPrincipalTypes, false),
+				Description:  "Kind of principal role is granted to: user or group.",
+			},
+			"principal": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsNotWhiteSpace,
+				DiffSuppressFunc: suppressOciTaskPrincipalCase,
+				Description:      "E-mail address of user or name of group role is granted to.",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(ocitaskclient.OciTaskRoles, false),
+				Description:  "Role granted: viewer can read, editor can also comment and update, owner can also delete and share.",
+			},
+			"time_created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time role was granted in RFC3339 format.",
+			},
 		},
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
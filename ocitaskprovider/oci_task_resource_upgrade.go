@@ -0,0 +1,112 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/**
+ * @brief Build type of Task resource state at schema version 0, where priority was an integer.
+ *			Schema is frozen as it was at version 0, later changes to Task resource must not leak into it.
+ * @param ociTaskResource Instance of OciTaskResource
+ * @return Type of state at schema version 0
+ */
+func (ociTaskResource *OciTaskResource) resourceOciTaskV0Type() cty.Type {
+	resource := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"last_updated": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"items": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"title": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"priority": {
+							Type:     schema.TypeInt,
+							Optional: true,
+							Computed: true,
+						},
+						"completed": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"start_date": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"due_date": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"time_updated": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+						"time_created": {
+							Type:     schema.TypeString,
+							Optional: true,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	return resource.CoreConfigSchema().ImpliedType()
+}
+
+/**
+ * @brief Upgrade Task resource state from schema version 0. Converts integer priority into string.
+ * @param ctx Context to Terraform Provider
+ * @param rawState State at schema version 0
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return State at schema version 1
+ * @return Instance of error if failed
+ */
+func upgradeOciTaskStateV0(ctx context.Context, rawState map[string]interface{}, m interface{}) (map[string]interface{}, error) {
+	items, _ := rawState["items"].([]interface{})
+	for _, item := range items {
+		ociTask, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		switch priority := ociTask["priority"].(type) {
+		case float64:
+			ociTask["priority"] = strconv.FormatInt(int64(priority), 10)
+		case int:
+			ociTask["priority"] = strconv.Itoa(priority)
+		case json.Number:
+			ociTask["priority"] = priority.String()
+		}
+	}
+
+	return rawState, nil
+}
@@ -2,18 +2,55 @@ package ocitaskprovider
 
 import (
 	"context"
+	"fmt"
+	"log"
 	"ocitaskclient"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+/**
+ * @brief Version of Terraform Provider. Set at build time with -ldflags "-X ocitaskprovider.Version=<version>"
+ */
+var Version string = "dev"
+
+/**
+ * @brief Name of Terraform Provider reported in User-Agent header
+ */
+const OciTaskProviderName string = "terraform-provider-ocitask"
+
+/**
+ * @brief Environment variable with path of JSON file to write client metrics to at shutdown
+ */
+const OciTaskMetricsFileEnv string = "OCITASK_METRICS_FILE"
+
 /**
  * @brief Terraform Provider for OCI Task Service
  */
 type OciTaskServProvider struct {
-	resource   *OciTaskResource
-	dataSource *OciTaskDataSource
+	resource    *OciTaskResource
+	dataSource  *OciTaskDataSource
+	tracing     *OciTaskTracing
+	tracingOnce sync.Once
+	metrics     *ocitaskclient.OciTaskMetrics
+	provider    *schema.Provider
+}
+
+/**
+ * @brief Meta passed by Terraform Provider to resources and data sources. Embeds Client to OCI Task Service
+ *			and carries provider-level settings applied to Tasks.
+ */
+type ociTaskProviderMeta struct {
+	ocitaskclient.OciTaskServClientInterface
+	defaultTags map[string]string
+	transitions ocitaskclient.OciTaskStatusTransitions
+	priorities  ocitaskclient.OciTaskPriorityLevels
 }
 
 /**
@@ -24,6 +61,7 @@ func MakeOciTaskServProvider() *OciTaskServProvider {
 	return &OciTaskServProvider{
 		resource:   MakeOciTaskResource(),
 		dataSource: MakeOciTaskDataSource(),
+		metrics:    ocitaskclient.MakeOciTaskMetrics(),
 	}
 }
 
@@ -32,34 +70,120 @@ func MakeOciTaskServProvider() *OciTaskServProvider {
  * @return Instance of schema.Provider
  */
 func (ociTaskServProvider *OciTaskServProvider) Provider() *schema.Provider {
-	return &schema.Provider{
+	ociTaskServProvider.provider = &schema.Provider{
 		Schema: map[string]*schema.Schema{
 			"ocitask_host": {
 				Type:     schema.TypeString,
 				Required: true,
 			},
+			"user_agent_suffix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Text appended to User-Agent header sent to OCI Task Service, e.g. name of the automation using the provider.",
+			},
+			"status_transitions": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Allowed status transitions of Tasks. Replaces default workflow if set; statuses without block can't be left.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"from": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(ocitaskclient.OciTaskStatuses, false)),
+							Description:      "Status Task moves from.",
+						},
+						"to": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Description: "Statuses Task may move to.",
+							Elem: &schema.Schema{
+								Type:             schema.TypeString,
+								ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice(ocitaskclient.OciTaskStatuses, false)),
+							},
+						},
+					},
+				},
+			},
+			"priority_levels": {
+				Type:             schema.TypeMap,
+				Optional:         true,
+				Elem:             &schema.Schema{Type: schema.TypeInt},
+				ValidateDiagFunc: validateOciTaskPriorityLevels,
+				Description:      "Integer priority of level names accepted as Task priority. Overrides defaults low = 1, medium = 5, high = 8 and critical = 10, other names add levels.",
+			},
+			"default_tags": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Tags applied to every Task managed by this provider.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Type:             schema.TypeMap,
+							Optional:         true,
+							Elem:             &schema.Schema{Type: schema.TypeString},
+							ValidateDiagFunc: validateOciTaskTags,
+							Description:      "Tags merged into tags of every Task. Tags configured on Task take precedence.",
+						},
+					},
+				},
+			},
 		},
 		ResourcesMap: map[string]*schema.Resource{
-			"ocitask_task": ociTaskServProvider.resource.ResourceOciTask(),
+			"ocitask_task":            ociTaskServProvider.resource.ResourceOciTask(),
+			"ocitask_task_dependency": ociTaskServProvider.resource.ResourceOciTaskDependency(),
+			"ocitask_project":         ociTaskServProvider.resource.ResourceOciTaskProject(),
+			"ocitask_task_comment":    ociTaskServProvider.resource.ResourceOciTaskComment(),
+			"ocitask_task_attachment": ociTaskServProvider.resource.ResourceOciTaskAttachment(),
+			"ocitask_recurring_task":  ociTaskServProvider.resource.ResourceOciTaskRecurringTask(),
+			"ocitask_time_entry":      ociTaskServProvider.resource.ResourceOciTaskTimeEntry(),
+			"ocitask_custom_field":    ociTaskServProvider.resource.ResourceOciTaskCustomField(),
+			"ocitask_webhook":         ociTaskServProvider.resource.ResourceOciTaskWebhook(),
+			"ocitask_task_permission": ociTaskServProvider.resource.ResourceOciTaskPermission(),
 		},
 		DataSourcesMap: map[string]*schema.Resource{
-			"ocitask_tasks": ociTaskServProvider.dataSource.DataSourceOciTasks(),
+			"ocitask_tasks":                  ociTaskServProvider.dataSource.DataSourceOciTasks(),
+			"ocitask_task_tree":              ociTaskServProvider.dataSource.DataSourceOciTaskTree(),
+			"ocitask_task_dependency_graph":  ociTaskServProvider.dataSource.DataSourceOciTaskDependencyGraph(),
+			"ocitask_user":                   ociTaskServProvider.dataSource.DataSourceOciTaskUser(),
+			"ocitask_task_comments":          ociTaskServProvider.dataSource.DataSourceOciTaskComments(),
+			"ocitask_task_attachment":        ociTaskServProvider.dataSource.DataSourceOciTaskAttachment(),
+			"ocitask_recurrence_occurrences": ociTaskServProvider.dataSource.DataSourceOciTaskOccurrences(),
+			"ocitask_archived_tasks":         ociTaskServProvider.dataSource.DataSourceOciArchivedTasks(),
+			"ocitask_task_history":           ociTaskServProvider.dataSource.DataSourceOciTaskHistory(),
+			"ocitask_effective_permissions":  ociTaskServProvider.dataSource.DataSourceOciTaskEffectivePermissions(),
 		},
 		ConfigureContextFunc: ociTaskServProvider.providerConfigure,
 	}
+
+	return ociTaskServProvider.provider
 }
 
 /**
  * @brief Configure Terraform Provider for OCI Task Service and build Client to OCI Task Service
  * @param ctx Terraform Provider context
  * @param rd Instance of schema.ResourceData contains provider configuration from Terraform scripts
- * @return Generic interface instance equivalent to Client to OCI Task Service if succeeded
+ * @return Generic interface instance equivalent to ociTaskProviderMeta wrapping Client to OCI Task Service if succeeded
  * @return Instance of diag.Diagnostics collection with error details if failed
  */
 func (ociTaskServProvider *OciTaskServProvider) providerConfigure(ctx context.Context, rd *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	// Warning or errors can be collected in a slice type
 	var diags diag.Diagnostics
 
+	ociTaskServProvider.tracingOnce.Do(func() {
+		tracing, err := MakeOciTaskTracing(ctx)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  "Failed to set up tracing",
+				Detail:   err.Error(),
+			})
+		} else {
+			ociTaskServProvider.tracing = tracing
+		}
+	})
+
 	var ociTaskHost *string
 
 	hVal, ok := rd.GetOk("ocitask_host")
@@ -71,5 +195,84 @@ func (ociTaskServProvider *OciTaskServProvider) providerConfigure(ctx context.Co
 	}
 
 	ociTaskClient := ocitaskclient.MakeOciTaskServClient(ociTaskHost)
-	return ociTaskClient, diags
+	ociTaskClient.SetMetrics(ociTaskServProvider.metrics)
+	userAgentSuffix, _ := rd.Get("user_agent_suffix").(string)
+	ociTaskClient.SetUserAgent(ociTaskServProvider.userAgent(userAgentSuffix))
+	return &ociTaskProviderMeta{
+		OciTaskServClientInterface: ociTaskClient,
+		defaultTags:                expandOciTaskDefaultTags(rd.Get("default_tags")),
+		transitions:                expandOciTaskStatusTransitions(rd.Get("status_transitions")),
+		priorities:                 expandOciTaskPriorityLevels(rd.Get("priority_levels")),
+	}, diags
+}
+
+/**
+ * @brief Build User-Agent header with provider, SDK, Terraform CLI and Go versions
+ * @param suffix Text configured by user to append, optional
+ * @return User-Agent header value
+ */
+func (ociTaskServProvider *OciTaskServProvider) userAgent(suffix string) string {
+	userAgent := ""
+	if ociTaskServProvider.provider != nil {
+		userAgent = ociTaskServProvider.provider.UserAgent(OciTaskProviderName, Version)
+	} else {
+		userAgent = OciTaskProviderName + "/" + Version
+	}
+
+	userAgent += fmt.Sprintf(" (%s; %s/%s)", runtime.Version(), runtime.GOOS, runtime.GOARCH)
+
+	suffix = strings.TrimSpace(suffix)
+	if suffix != "" {
+		userAgent += " " + suffix
+	}
+
+	return userAgent
+}
+
+/**
+ * @brief Release resources held by Terraform Provider. Flushes pending trace spans and
+ *			dumps client metrics summary to log, and to JSON file if OCITASK_METRICS_FILE is set.
+ * @param ctx Context for shutdown
+ * @return Instance of error if failed
+ */
+func (ociTaskServProvider *OciTaskServProvider) Shutdown(ctx context.Context) error {
+	err := ociTaskServProvider.dumpMetrics()
+
+	if ociTaskServProvider.tracing != nil {
+		if errTracing := ociTaskServProvider.tracing.Shutdown(ctx); errTracing != nil {
+			err = errTracing
+		}
+	}
+
+	return err
+}
+
+/**
+ * @brief Write client metrics summary to log and to JSON file if OCITASK_METRICS_FILE is set
+ * @return Instance of error if failed
+ */
+func (ociTaskServProvider *OciTaskServProvider) dumpMetrics() error {
+	if len(ociTaskServProvider.metrics.Snapshot()) == 0 {
+		return nil
+	}
+
+	// Shutdown runs after plugin server stopped, so no tflog logger is available in context
+	log.Println(ociTaskServProvider.metrics.Summary())
+
+	metricsFilePath := os.Getenv(OciTaskMetricsFileEnv)
+	if metricsFilePath == "" {
+		return nil
+	}
+
+	data, err := ociTaskServProvider.metrics.Serialize()
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(metricsFilePath, []byte(data), 0600)
+	if err != nil {
+		return fmt.Errorf("Failed to write metrics file %s - error=%s", metricsFilePath, err)
+	}
+
+	return nil
 }
@@ -1,8 +1,16 @@
 package ocitaskprovider
 
 import (
+	"bytes"
+	"context"
+	"log"
 	"ocitaskclient"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/stretchr/testify/assert"
@@ -56,7 +64,7 @@ func TestProvider(test *testing.T) {
 	assert.Equal(test, true, resourceItem.Schema["description"].Optional, "TestProvider Failed: Resource Item Description Optional flag doesn't match with expected value")
 	assert.Equal(test, true, resourceItem.Schema["description"].Computed, "TestProvider Failed: Resource Item Description Computed flag doesn't match with expected value")
 
-	assert.Equal(test, schema.TypeInt, resourceItem.Schema["priority"].Type, "TestProvider Failed: Resource Item Priority Type doesn't match with expected value")
+	assert.Equal(test, schema.TypeString, resourceItem.Schema["priority"].Type, "TestProvider Failed: Resource Item Priority Type doesn't match with expected value")
 	assert.Equal(test, true, resourceItem.Schema["priority"].Optional, "TestProvider Failed: Resource Item Priority Optional flag doesn't match with expected value")
 	assert.Equal(test, true, resourceItem.Schema["priority"].Computed, "TestProvider Failed: Resource Item Priority Computed flag doesn't match with expected value")
 
@@ -80,6 +88,9 @@ func TestProvider(test *testing.T) {
 	assert.Equal(test, true, resourceItem.Schema["time_updated"].Optional, "TestProvider Failed: Resource Item time_updated Optional flag doesn't match with expected value")
 	assert.Equal(test, true, resourceItem.Schema["time_updated"].Computed, "TestProvider Failed: Resource Item time_updated Computed flag doesn't match with expected value")
 
+	assert.Equal(test, schema.TypeMap, resourceItem.Schema["tags"].Type, "TestProvider Failed: Resource Item tags Type doesn't match with expected value")
+	assert.Equal(test, true, resourceItem.Schema["tags"].Optional, "TestProvider Failed: Resource Item tags Optional flag doesn't match with expected value")
+
 	dataSource := provider.DataSourcesMap["ocitask_tasks"]
 
 	assert.NotNil(test, dataSource, "TestProvider Failed: DataSource expected")
@@ -91,7 +102,10 @@ func TestProvider(test *testing.T) {
 	dataSourceSchema := dataSource.Schema
 
 	assert.Equal(test, schema.TypeInt, dataSourceSchema["id"].Type, "TestProvider Failed: DataSource Schema Id Type doesn't match with expected value")
-	assert.Equal(test, true, dataSourceSchema["id"].Required, "TestProvider Failed: DataSource Schema id Required flag doesn't match with expected value")
+	assert.Equal(test, true, dataSourceSchema["id"].Optional, "TestProvider Failed: DataSource Schema id Optional flag doesn't match with expected value")
+	assert.Equal(test, true, dataSourceSchema["id"].Computed, "TestProvider Failed: DataSource Schema id Computed flag doesn't match with expected value")
+	assert.Equal(test, schema.TypeMap, dataSourceSchema["tags"].Type, "TestProvider Failed: DataSource Schema tags Type doesn't match with expected value")
+	assert.Equal(test, true, dataSourceSchema["tags"].Optional, "TestProvider Failed: DataSource Schema tags Optional flag doesn't match with expected value")
 	assert.Equal(test, schema.TypeList, dataSourceSchema["items"].Type, "TestProvider Failed: DataSource Schema items Type doesn't match with expected value")
 	assert.Equal(test, true, dataSourceSchema["items"].Computed, "TestProvider Failed: DataSource Schema items Computed flag doesn't match with expected value")
 
@@ -147,7 +161,7 @@ func TestProvider(test *testing.T) {
 
 	assert.NotNil(test, iOciTaskClient, "TestProvider Failed: Generic OciTaskClient expected from ConfigureContextFunc")
 
-	ociTaskClient := iOciTaskClient.(*ocitaskclient.OciTaskServClient)
+	ociTaskClient := iOciTaskClient.(*ociTaskProviderMeta).OciTaskServClientInterface.(*ocitaskclient.OciTaskServClient)
 
 	assert.NotNil(test, ociTaskClient, "TestProvider Failed: OciTaskClient expected from ConfigureContextFunc")
 
@@ -176,11 +190,12 @@ func TestProviderConfigureSuccess(test *testing.T) {
 
 	assert.NotNil(test, iOciTaskClient, "TestProviderConfigureSuccess Failed: Generic OciTaskClient expected from ConfigureContextFunc")
 
-	ociTaskClient := iOciTaskClient.(*ocitaskclient.OciTaskServClient)
+	ociTaskClient := iOciTaskClient.(*ociTaskProviderMeta).OciTaskServClientInterface.(*ocitaskclient.OciTaskServClient)
 
 	assert.NotNil(test, ociTaskClient, "TestProviderConfigureSuccess Failed: OciTaskClient expected from ConfigureContextFunc")
 
 	assert.Equal(test, "http://localhost", ociTaskClient.GetUrl(), "TestProviderConfigureSuccess Failed: OciTaskClient Host URL doesn't match with expected value")
+	assert.Equal(test, ociTaskServProvider.metrics, ociTaskClient.GetMetrics(), "TestProviderConfigureSuccess Failed: OciTaskClient should share provider metrics")
 }
 
 func TestProviderConfigureFailed(test *testing.T) {
@@ -204,3 +219,162 @@ func TestProviderConfigureFailed(test *testing.T) {
 
 	assert.Nil(test, iOciTaskClient, "TestProviderConfigureFailed Failed: Generic OciTaskClient not expected from ConfigureContextFunc")
 }
+
+func TestProviderShutdownWritesMetrics(test *testing.T) {
+	metricsFilePath := filepath.Join(test.TempDir(), "metrics.json")
+	test.Setenv(OciTaskMetricsFileEnv, metricsFilePath)
+
+	ociTaskServProvider := MakeOciTaskServProvider()
+	ociTaskServProvider.metrics.Record("GetTask", 200, 20*time.Millisecond, nil)
+
+	var logOutput bytes.Buffer
+	log.SetOutput(&logOutput)
+	defer log.SetOutput(os.Stderr)
+
+	err := ociTaskServProvider.Shutdown(context.Background())
+
+	assert.NoError(test, err, "TestProviderShutdownWritesMetrics Failed: No error expected")
+	assert.Contains(test, logOutput.String(), "GetTask", "TestProviderShutdownWritesMetrics Failed: Metrics summary expected in log")
+
+	data, err := os.ReadFile(metricsFilePath)
+
+	assert.NoError(test, err, "TestProviderShutdownWritesMetrics Failed: Metrics file expected")
+	assert.Contains(test, string(data), "\"GetTask\"", "TestProviderShutdownWritesMetrics Failed: GetTask metrics expected in file")
+}
+
+func TestProviderShutdownNoMetrics(test *testing.T) {
+	metricsFilePath := filepath.Join(test.TempDir(), "metrics.json")
+	test.Setenv(OciTaskMetricsFileEnv, metricsFilePath)
+
+	ociTaskServProvider := MakeOciTaskServProvider()
+
+	err := ociTaskServProvider.Shutdown(context.Background())
+
+	assert.NoError(test, err, "TestProviderShutdownNoMetrics Failed: No error expected")
+
+	_, err = os.Stat(metricsFilePath)
+
+	assert.True(test, os.IsNotExist(err), "TestProviderShutdownNoMetrics Failed: Metrics file not expected")
+}
+
+func TestProviderConfigureUserAgent(test *testing.T) {
+	ociTaskServProvider := MakeOciTaskServProvider()
+
+	provider := ociTaskServProvider.Provider()
+	provider.TerraformVersion = "1.3.6"
+
+	suffixSchema := provider.Schema["user_agent_suffix"]
+
+	assert.Equal(test, schema.TypeString, suffixSchema.Type, "TestProviderConfigureUserAgent Failed: user_agent_suffix Schema Type doesn't match with expected value")
+	assert.Equal(test, true, suffixSchema.Optional, "TestProviderConfigureUserAgent Failed: user_agent_suffix Schema Optional flag doesn't match with expected value")
+
+	config := make(map[string]interface{})
+	config["ocitask_host"] = "http://localhost"
+	config["user_agent_suffix"] = "ci-pipeline"
+
+	rd := schema.TestResourceDataRaw(test, provider.Schema, config)
+
+	iOciTaskClient, _ := provider.ConfigureContextFunc(context.Background(), rd)
+
+	ociTaskClient := iOciTaskClient.(*ociTaskProviderMeta).OciTaskServClientInterface.(*ocitaskclient.OciTaskServClient)
+	userAgent := ociTaskClient.GetUserAgent()
+
+	assert.Contains(test, userAgent, "Terraform/1.3.6", "TestProviderConfigureUserAgent Failed: Terraform version expected in User-Agent")
+	assert.Contains(test, userAgent, "Terraform-Plugin-SDK/", "TestProviderConfigureUserAgent Failed: SDK version expected in User-Agent")
+	assert.Contains(test, userAgent, OciTaskProviderName+"/"+Version, "TestProviderConfigureUserAgent Failed: Provider version expected in User-Agent")
+	assert.Contains(test, userAgent, runtime.Version(), "TestProviderConfigureUserAgent Failed: Go version expected in User-Agent")
+	assert.True(test, strings.HasSuffix(userAgent, " ci-pipeline"), "TestProviderConfigureUserAgent Failed: Suffix expected at end of User-Agent")
+}
+
+func TestProviderConfigureDefaultTags(test *testing.T) {
+	ociTaskServProvider := MakeOciTaskServProvider()
+
+	provider := ociTaskServProvider.Provider()
+
+	defaultTagsSchema := provider.Schema["default_tags"]
+
+	assert.Equal(test, schema.TypeList, defaultTagsSchema.Type, "TestProviderConfigureDefaultTags Failed: default_tags Schema Type doesn't match with expected value")
+	assert.Equal(test, 1, defaultTagsSchema.MaxItems, "TestProviderConfigureDefaultTags Failed: default_tags Schema MaxItems doesn't match with expected value")
+
+	config := make(map[string]interface{})
+	config["ocitask_host"] = "http://localhost"
+	config["default_tags"] = []interface{}{
+		map[string]interface{}{
+			"tags": map[string]interface{}{"owner": "platform"},
+		},
+	}
+
+	rd := schema.TestResourceDataRaw(test, provider.Schema, config)
+
+	iOciTaskClient, _ := provider.ConfigureContextFunc(context.Background(), rd)
+
+	providerMeta := iOciTaskClient.(*ociTaskProviderMeta)
+
+	assert.Equal(test, map[string]string{"owner": "platform"}, providerMeta.defaultTags, "TestProviderConfigureDefaultTags Failed: Default tags expected on provider meta")
+}
+
+func TestProviderInternalValidate(test *testing.T) {
+	provider := MakeOciTaskServProvider().Provider()
+
+	assert.NoError(test, provider.InternalValidate(), "TestProviderInternalValidate Failed: Provider schema expected to be valid")
+}
+
+func TestProviderConfigureStatusTransitions(test *testing.T) {
+	ociTaskServProvider := MakeOciTaskServProvider()
+
+	provider := ociTaskServProvider.Provider()
+
+	config := make(map[string]interface{})
+	config["ocitask_host"] = "http://localhost"
+	config["status_transitions"] = []interface{}{
+		map[string]interface{}{
+			"from": "todo",
+			"to":   []interface{}{"done"},
+		},
+		map[string]interface{}{
+			"from": "done",
+			"to":   []interface{}{},
+		},
+	}
+
+	rd := schema.TestResourceDataRaw(test, provider.Schema, config)
+
+	iOciTaskClient, _ := provider.ConfigureContextFunc(context.Background(), rd)
+
+	transitions := ociTaskStatusTransitions(iOciTaskClient)
+
+	assert.NoError(test, transitions.Validate("todo", "done"), "TestProviderConfigureStatusTransitions Failed: Configured transition expected to be allowed")
+	assert.Error(test, transitions.Validate("todo", "in_progress"), "TestProviderConfigureStatusTransitions Failed: Transition not configured expected to be rejected")
+	assert.Error(test, transitions.Validate("done", "todo"), "TestProviderConfigureStatusTransitions Failed: Transition from final status expected to be rejected")
+}
+
+func TestProviderConfigureDefaultStatusTransitions(test *testing.T) {
+	provider := MakeOciTaskServProvider().Provider()
+
+	config := make(map[string]interface{})
+	config["ocitask_host"] = "http://localhost"
+
+	rd := schema.TestResourceDataRaw(test, provider.Schema, config)
+
+	iOciTaskClient, _ := provider.ConfigureContextFunc(context.Background(), rd)
+
+	assert.Equal(test, ocitaskclient.MakeOciTaskStatusTransitions(), ociTaskStatusTransitions(iOciTaskClient), "TestProviderConfigureDefaultStatusTransitions Failed: Default workflow expected")
+}
+
+func TestProviderConfigurePriorityLevels(test *testing.T) {
+	provider := MakeOciTaskServProvider().Provider()
+
+	config := make(map[string]interface{})
+	config["ocitask_host"] = "http://localhost"
+	config["priority_levels"] = map[string]interface{}{"High": 9, "urgent": 10}
+
+	rd := schema.TestResourceDataRaw(test, provider.Schema, config)
+
+	iOciTaskClient, _ := provider.ConfigureContextFunc(context.Background(), rd)
+
+	priorities := ociTaskPriorityLevels(iOciTaskClient)
+
+	assert.Equal(test, 9, priorities["high"], "TestProviderConfigurePriorityLevels Failed: Configured level expected to override default")
+	assert.Equal(test, 10, priorities["urgent"], "TestProviderConfigurePriorityLevels Failed: Configured level expected to be added")
+	assert.Equal(test, 1, priorities["low"], "TestProviderConfigurePriorityLevels Failed: Default level expected to be kept")
+}
@@ -0,0 +1,189 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"fmt"
+	"ocitaskclient"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/**
+ * @brief Read status workflow configured on provider
+ * @param m Contains ociTaskProviderMeta pluged into Terraform Provider
+ * @return Instance of OciTaskStatusTransitions, default workflow if none configured
+ */
+func ociTaskStatusTransitions(m interface{}) ocitaskclient.OciTaskStatusTransitions {
+	if meta, ok := m.(*ociTaskProviderMeta); ok && meta.transitions != nil {
+		return meta.transitions
+	}
+
+	return ocitaskclient.MakeOciTaskStatusTransitions()
+}
+
+/**
+ * @brief Expand status_transitions blocks of provider configuration
+ * @param src Value of status_transitions blocks from provider resource data
+ * @return Instance of OciTaskStatusTransitions, nil if no block configured
+ */
+func expandOciTaskStatusTransitions(src interface{}) ocitaskclient.OciTaskStatusTransitions {
+	blocks, ok := src.([]interface{})
+	if !ok || len(blocks) == 0 {
+		return nil
+	}
+
+	transitions := make(ocitaskclient.OciTaskStatusTransitions)
+	for _, block := range blocks {
+		transition, ok := block.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		from := transition["from"].(string)
+		if to, ok := transition["to"].(*schema.Set); ok {
+			for _, status := range to.List() {
+				transitions[from] = append(transitions[from], status.(string))
+			}
+		} else if _, ok := transitions[from]; !ok {
+			transitions[from] = nil
+		}
+	}
+
+	return transitions
+}
+
+/**
+ * @brief Implemented by schema.ResourceData and schema.ResourceDiff
+ */
+type ociTaskConfigReader interface {
+	GetRawConfig() cty.Value
+	HasChange(key string) bool
+	Get(key string) interface{}
+}
+
+/**
+ * @brief Read status and completed flag of Task as written in Terraform scripts.
+ *			Falls back to changed values if raw configuration not available.
+ * @param rd Resource data or planned changes of Task resource
+ * @return Configured status, null if not configured
+ * @return Configured completed flag, null if not configured
+ */
+func configuredOciTaskStatus(rd ociTaskConfigReader) (cty.Value, cty.Value) {
+	status := cty.NullVal(cty.String)
+	completed := cty.NullVal(cty.Bool)
+
+	rawConfig := rd.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() || !rawConfig.Type().IsObjectType() || !rawConfig.Type().HasAttribute("items") {
+		if rd.HasChange("items.0.status") {
+			status = cty.StringVal(rd.Get("items.0.status").(string))
+		}
+
+		if rd.HasChange("items.0.completed") {
+			completed = cty.BoolVal(rd.Get("items.0.completed").(bool))
+		}
+
+		return status, completed
+	}
+
+	items := rawConfig.GetAttr("items")
+	if items.IsNull() || !items.IsKnown() || !items.CanIterateElements() || items.LengthInt() == 0 {
+		return status, completed
+	}
+
+	item := items.Index(cty.NumberIntVal(0))
+	if item.IsNull() || !item.IsKnown() {
+		return status, completed
+	}
+
+	if item.Type().HasAttribute("status") {
+		status = item.GetAttr("status")
+	}
+
+	if item.Type().HasAttribute("completed") {
+		completed = item.GetAttr("completed")
+	}
+
+	return status, completed
+}
+
+/**
+ * @brief Resolve status Task should have after apply. Configured status wins, legacy completed flag
+ *			moves Task to done or back to todo only if it disagrees with current status.
+ * @param currentStatus Status of Task in state, empty if Task not created yet
+ * @param status Configured status, null if not configured
+ * @param completed Configured completed flag, null if not configured
+ * @return Status Task should have, empty if not known yet
+ * @return Instance of error if configured status and completed flag contradict each other
+ */
+func resolveOciTaskStatus(currentStatus string, status cty.Value, completed cty.Value) (string, error) {
+	if !status.IsKnown() || !completed.IsKnown() {
+		return "", nil
+	}
+
+	if !status.IsNull() {
+		newStatus := status.AsString()
+		if !completed.IsNull() && completed.True() != ocitaskclient.OciTaskCompletedFromStatus(newStatus) {
+			return "", fmt.Errorf("Conflicting status %q and completed = %t - remove completed, status takes its place", newStatus, completed.True())
+		}
+
+		return newStatus, nil
+	}
+
+	if !completed.IsNull() {
+		if currentStatus != "" && ocitaskclient.OciTaskCompletedFromStatus(currentStatus) == completed.True() {
+			return currentStatus, nil
+		}
+
+		return ocitaskclient.OciTaskStatusFromCompleted(completed.True()), nil
+	}
+
+	return currentStatus, nil
+}
+
+/**
+ * @brief Set status Task should have after apply into Task item before it is sent to OCI Task Service
+ * @param rd Resource data of Task resource
+ * @param item Task item from resource data
+ * @return Task item carrying resolved status
+ * @return Instance of error if configured status and completed flag contradict each other
+ */
+func withOciTaskStatus(rd *schema.ResourceData, item interface{}) (interface{}, error) {
+	ociTask, ok := item.(map[string]interface{})
+	if !ok {
+		return item, nil
+	}
+
+	currentStatus, _ := rd.GetChange("items.0.status")
+	status, completed := configuredOciTaskStatus(rd)
+
+	newStatus, err := resolveOciTaskStatus(currentStatus.(string), status, completed)
+	if err != nil {
+		return item, err
+	}
+
+	if newStatus != "" {
+		ociTask["status"] = newStatus
+	}
+
+	return ociTask, nil
+}
+
+/**
+ * @brief Validate status change of Task at plan time against status workflow configured on provider
+ * @param ctx Context to Terraform Provider
+ * @param rdiff Planned changes of Task resource
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Instance of error if status change not allowed
+ */
+func customizeOciTaskStatus(ctx context.Context, rdiff *schema.ResourceDiff, m interface{}) error {
+	currentStatus, _ := rdiff.GetChange("items.0.status")
+	status, completed := configuredOciTaskStatus(rdiff)
+
+	newStatus, err := resolveOciTaskStatus(currentStatus.(string), status, completed)
+	if err != nil || newStatus == "" || rdiff.Id() == "" {
+		return err
+	}
+
+	return ociTaskStatusTransitions(m).Validate(currentStatus.(string), newStatus)
+}
@@ -0,0 +1,165 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"ocitaskclient"
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func makeTestOciTaskState(status string, completed string) *terraform.InstanceState {
+	return &terraform.InstanceState{
+		ID: "1001",
+		Attributes: map[string]string{
+			"id":                "1001",
+			"items.#":           "1",
+			"items.0.id":        "1001",
+			"items.0.title":     "Test Task 1",
+			"items.0.status":    status,
+			"items.0.completed": completed,
+		},
+	}
+}
+
+func TestResolveOciTaskStatus(test *testing.T) {
+	nullStatus := cty.NullVal(cty.String)
+	nullCompleted := cty.NullVal(cty.Bool)
+
+	status, err := resolveOciTaskStatus("todo", cty.StringVal("blocked"), nullCompleted)
+	assert.NoError(test, err, "TestResolveOciTaskStatus Failed: No error expected")
+	assert.Equal(test, "blocked", status, "TestResolveOciTaskStatus Failed: Configured status expected")
+
+	status, _ = resolveOciTaskStatus("in_progress", nullStatus, cty.False)
+	assert.Equal(test, "in_progress", status, "TestResolveOciTaskStatus Failed: Status agreeing with completed flag expected to be kept")
+
+	status, _ = resolveOciTaskStatus("in_progress", nullStatus, cty.True)
+	assert.Equal(test, "done", status, "TestResolveOciTaskStatus Failed: done expected for completed Task")
+
+	status, _ = resolveOciTaskStatus("", nullStatus, nullCompleted)
+	assert.Equal(test, "", status, "TestResolveOciTaskStatus Failed: No status expected")
+
+	_, err = resolveOciTaskStatus("todo", cty.StringVal("in_progress"), cty.True)
+	assert.Error(test, err, "TestResolveOciTaskStatus Failed: Conflict between status and completed flag expected")
+}
+
+func TestCustomizeDiffStatusTransitionAllowed(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+
+	resource := MakeOciTaskResource().ResourceOciTask()
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{
+				"title":  "Test Task 1",
+				"status": "in_progress",
+			},
+		},
+	})
+
+	instanceDiff, err := resource.Diff(context.Background(), makeTestOciTaskState("todo", "false"), config, &ociTaskServClientMock)
+
+	assert.NoError(test, err, "TestCustomizeDiffStatusTransitionAllowed Failed: No error expected")
+	assert.Equal(test, "in_progress", instanceDiff.Attributes["items.0.status"].New, "TestCustomizeDiffStatusTransitionAllowed Failed: Status change expected in plan")
+}
+
+func TestCustomizeDiffStatusTransitionRejected(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+
+	resource := MakeOciTaskResource().ResourceOciTask()
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{
+				"title":  "Test Task 1",
+				"status": "done",
+			},
+		},
+	})
+
+	_, err := resource.Diff(context.Background(), makeTestOciTaskState("blocked", "false"), config, &ociTaskServClientMock)
+
+	assert.Error(test, err, "TestCustomizeDiffStatusTransitionRejected Failed: Error expected")
+	assert.Contains(test, err.Error(), "Status transition from \"blocked\" to \"done\" not allowed", "TestCustomizeDiffStatusTransitionRejected Failed: Wrong error")
+}
+
+func TestCustomizeDiffStatusTransitionFromCompleted(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	providerMeta := ociTaskProviderMeta{OciTaskServClientInterface: &ociTaskServClientMock, transitions: ocitaskclient.OciTaskStatusTransitions{"todo": {"in_progress"}}}
+
+	resource := MakeOciTaskResource().ResourceOciTask()
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{
+				"title":     "Test Task 1",
+				"completed": true,
+			},
+		},
+	})
+
+	_, err := resource.Diff(context.Background(), makeTestOciTaskState("todo", "false"), config, &providerMeta)
+
+	assert.Error(test, err, "TestCustomizeDiffStatusTransitionFromCompleted Failed: Completing Task expected to be checked against workflow")
+}
+
+func TestCustomizeDiffStatusConflict(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+
+	resource := MakeOciTaskResource().ResourceOciTask()
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{
+				"title":     "Test Task 1",
+				"status":    "blocked",
+				"completed": true,
+			},
+		},
+	})
+
+	_, err := resource.Diff(context.Background(), nil, config, &ociTaskServClientMock)
+
+	assert.Error(test, err, "TestCustomizeDiffStatusConflict Failed: Error expected")
+	assert.Contains(test, err.Error(), "Conflicting status", "TestCustomizeDiffStatusConflict Failed: Wrong error")
+}
+
+func TestCreateTaskOperationSendsStatus(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	task := makeTestOciTask(1001)
+	status := ocitaskclient.OciTaskStatusBlocked
+	task.Status = &status
+
+	createResponse := ocitaskclient.OciTaskServResponse{}
+	createResponse.TaskId = task.Id
+
+	readResponse := ocitaskclient.OciTaskServResponse{}
+	readResponse.Task = &task
+
+	srcTask := make(map[string]interface{})
+	srcTask["title"] = *task.Title
+	srcTask["status"] = status
+
+	testData := make(map[string]interface{})
+	testData["items"] = []interface{}{srcTask}
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTask().Schema, testData)
+
+	ociTaskServClientMock.On("CreateTask", mock.Anything, mock.MatchedBy(func(request *ocitaskclient.OciTaskServRequest) bool {
+		return *request.Status == ocitaskclient.OciTaskStatusBlocked && !*request.Completed
+	})).Return(&createResponse, nil).Once()
+	ociTaskServClientMock.On("GetTask", mock.Anything, task.Id).Return(&readResponse, nil).Once()
+
+	diags := ociTaskOperation.OciTaskCreate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestCreateTaskOperationSendsStatus Failed: No Diagnostics expected")
+	assert.Equal(test, ocitaskclient.OciTaskStatusBlocked, rd.Get("items.0.status"), "TestCreateTaskOperationSendsStatus Failed: Status expected after Read")
+}
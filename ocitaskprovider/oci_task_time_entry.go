@@ -0,0 +1,299 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"fmt"
+	"ocitaskclient"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/**
+ * @brief Log time on Task in OCI Task System
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains time entry defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskTimeEntryCreate(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskTimeEntryCreate", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	taskId := int64(rd.Get("task_id").(int))
+
+	ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+	ociResponse, err := ociClient.CreateTaskTimeEntry(ctx, &taskId, expandOciTaskTimeEntry(rd))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to create task time entry",
+			Detail:   err.Error(),
+		})
+	} else {
+		if ociResponse.Err != nil {
+			ociErr, _ := ociResponse.Err.Serialize()
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to create task time entry",
+				Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+			})
+		} else if ociResponse.TimeEntry == nil || ociResponse.TimeEntry.Id == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to create task time entry",
+				Detail:   "OCI Task Service returned no time entry Id" + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+			})
+		} else {
+			rd.SetId(formatOciTaskTimeEntryId(taskId, *ociResponse.TimeEntry.Id))
+			diags = append(diags, ociTaskOperation.OciTaskTimeEntryRead(ctx, rd, m)...)
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Read time entry of Task in OCI Task System. Removes time entry from state if it no longer exists.
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains time entry Identifier in form <task_id>/<time_entry_id>
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskTimeEntryRead(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskTimeEntryRead", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	taskId, timeEntryId, err := parseOciTaskTimeEntryId(rd.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to get Id from resource data",
+			Detail:   err.Error(),
+		})
+	} else {
+		ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+		ociResponse, err := ociClient.GetTaskTimeEntry(ctx, &taskId, &timeEntryId)
+		if ocitaskclient.IsOciTaskNotFound(err) {
+			rd.SetId("")
+		} else if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read task time entry",
+				Detail:   err.Error(),
+			})
+		} else {
+			if ociResponse.Err != nil {
+				ociErr, _ := ociResponse.Err.Serialize()
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to read task time entry",
+					Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else if ociResponse.TimeEntry == nil {
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to read time entry",
+					Detail:   "OCI Task Service returned no time entry" + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else {
+				timeEntry := ociResponse.TimeEntry.Flatten()
+				timeEntry["task_id"] = int(taskId)
+				delete(timeEntry, "id")
+
+				for key, value := range timeEntry {
+					err := rd.Set(key, value)
+					if err != nil {
+						diags = append(diags, diag.Diagnostic{
+							Severity: diag.Error,
+							Summary:  "Failed to set task time entry into resource data",
+							Detail:   err.Error(),
+						})
+					}
+				}
+			}
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Correct time entry of Task in OCI Task System
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains time entry defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskTimeEntryUpdate(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskTimeEntryUpdate", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	taskId, timeEntryId, err := parseOciTaskTimeEntryId(rd.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to get Id from resource data",
+			Detail:   err.Error(),
+		})
+	} else {
+		ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+		ociResponse, err := ociClient.UpdateTaskTimeEntry(ctx, &taskId, &timeEntryId, expandOciTaskTimeEntry(rd))
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to update task time entry",
+				Detail:   err.Error(),
+			})
+		} else {
+			if ociResponse.Err != nil {
+				ociErr, _ := ociResponse.Err.Serialize()
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to update task time entry",
+					Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else {
+				diags = append(diags, ociTaskOperation.OciTaskTimeEntryRead(ctx, rd, m)...)
+			}
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Delete time entry of Task in OCI Task System
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains time entry Identifier in form <task_id>/<time_entry_id>
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskTimeEntryDelete(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskTimeEntryDelete", rd.Id())
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	taskId, timeEntryId, err := parseOciTaskTimeEntryId(rd.Id())
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to get Id from resource data",
+			Detail:   err.Error(),
+		})
+	} else {
+		ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+		ociResponse, err := ociClient.DeleteTaskTimeEntry(ctx, &taskId, &timeEntryId)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to delete task time entry",
+				Detail:   err.Error(),
+			})
+		} else {
+			if ociResponse.Err != nil {
+				ociErr, _ := ociResponse.Err.Serialize()
+				diags = append(diags, diag.Diagnostic{
+					Severity: diag.Error,
+					Summary:  "Failed to delete task time entry",
+					Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+				})
+			} else {
+				rd.SetId("")
+			}
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Roll up estimated and logged effort of Task and its descendants into flattened Task.
+ *			Rolling up takes a request per descendant, so it's only done for Tasks with estimate.
+ *			If it fails, values read before are kept and a warning is reported, so refresh of Task isn't blocked.
+ * @param ctx Context to Terraform Provider
+ * @param rd Resource data holding values read before
+ * @param ociTask Flattened Task, receives logged_minutes and remaining_minutes
+ * @param root Task read from OCI Task Service
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances with warning if rolling up failed, otherwise empty
+ */
+func setOciTaskEffort(ctx context.Context, rd *schema.ResourceData, ociTask map[string]interface{}, root *ocitaskclient.OciTask, m interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if root.EstimateMinutes == nil {
+		return diags
+	}
+
+	effort, err := ocitaskclient.ReadOciTaskEffort(ctx, m.(ocitaskclient.OciTaskServClientInterface), *root)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "Failed to roll up task effort",
+			Detail:   err.Error() + " - logged and remaining minutes keep their previous values",
+		})
+		ociTask["logged_minutes"] = rd.Get("items.0.logged_minutes")
+		ociTask["remaining_minutes"] = rd.Get("items.0.remaining_minutes")
+	} else {
+		ociTask["logged_minutes"] = int(effort.LoggedMinutes)
+		ociTask["remaining_minutes"] = int(effort.RemainingMinutes)
+	}
+
+	return diags
+}
+
+/**
+ * @brief Convert time entry resource data into OciTaskTimeEntry instance
+ * @param rd Contains time entry defined in Terraform scripts
+ * @return Instance of OciTaskTimeEntry
+ */
+func expandOciTaskTimeEntry(rd *schema.ResourceData) *ocitaskclient.OciTaskTimeEntry {
+	minutes := int64(rd.Get("minutes").(int))
+	date := rd.Get("date").(string)
+	note := rd.Get("note").(string)
+
+	return &ocitaskclient.OciTaskTimeEntry{Minutes: &minutes, Date: &date, Note: &note}
+}
+
+/**
+ * @brief Build Identifier of time entry resource
+ * @param taskId Identifier of Task
+ * @param timeEntryId Identifier of time entry
+ * @return Identifier in form <task_id>/<time_entry_id>
+ */
+func formatOciTaskTimeEntryId(taskId int64, timeEntryId int64) string {
+	return fmt.Sprintf("%d/%d", taskId, timeEntryId)
+}
+
+/**
+ * @brief Split Identifier of time entry resource
+ * @param id Identifier in form <task_id>/<time_entry_id>
+ * @return Identifier of Task
+ * @return Identifier of time entry
+ * @return Instance of error if Identifier is malformed
+ */
+func parseOciTaskTimeEntryId(id string) (int64, int64, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) == 2 {
+		taskId, taskErr := strconv.ParseInt(parts[0], 10, 64)
+		timeEntryId, timeEntryErr := strconv.ParseInt(parts[1], 10, 64)
+		if taskErr == nil && timeEntryErr == nil {
+			return taskId, timeEntryId, nil
+		}
+	}
+
+	return 0, 0, fmt.Errorf("Invalid time entry Id %q - expected <task_id>/<time_entry_id>", id)
+}
@@ -0,0 +1,192 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"ocitaskclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func makeTestOciTaskTimeEntryResponse(id int64, minutes int64, date string) *ocitaskclient.OciTaskServResponse {
+	taskId, note := int64(1001), "Code review"
+	return &ocitaskclient.OciTaskServResponse{TimeEntry: &ocitaskclient.OciTaskTimeEntry{Id: &id, TaskId: &taskId, Minutes: &minutes, Date: &date, Note: &note}}
+}
+
+func TestCreateTaskTimeEntryOperationSuccess(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	taskId, timeEntryId := int64(1001), int64(4)
+	ociTaskServClientMock.On("CreateTaskTimeEntry", mock.Anything, &taskId, mock.MatchedBy(func(timeEntry *ocitaskclient.OciTaskTimeEntry) bool {
+		return *timeEntry.Minutes == 45 && *timeEntry.Date == "2023-03-15" && *timeEntry.Note == "Code review"
+	})).Return(makeTestOciTaskTimeEntryResponse(timeEntryId, 45, "2023-03-15"), nil).Once()
+	ociTaskServClientMock.On("GetTaskTimeEntry", mock.Anything, &taskId, &timeEntryId).Return(makeTestOciTaskTimeEntryResponse(timeEntryId, 45, "2023-03-15"), nil).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskTimeEntry().Schema, map[string]interface{}{
+		"task_id": 1001,
+		"minutes": 45,
+		"date":    "2023-03-15",
+		"note":    "Code review",
+	})
+
+	diags := ociTaskOperation.OciTaskTimeEntryCreate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestCreateTaskTimeEntryOperationSuccess Failed: No Diagnostics expected")
+	assert.Equal(test, "1001/4", rd.Id(), "TestCreateTaskTimeEntryOperationSuccess Failed: Wrong Time Entry Id")
+	assert.Equal(test, 45, rd.Get("minutes"), "TestCreateTaskTimeEntryOperationSuccess Failed: Wrong Time Entry Minutes")
+}
+
+func TestReadTaskTimeEntryOperationImport(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	taskId, timeEntryId := int64(1001), int64(4)
+	ociTaskServClientMock.On("GetTaskTimeEntry", mock.Anything, &taskId, &timeEntryId).Return(makeTestOciTaskTimeEntryResponse(timeEntryId, 30, "2023-03-16"), nil).Once()
+
+	rd := MakeOciTaskResource().ResourceOciTaskTimeEntry().Data(nil)
+	rd.SetId("1001/4")
+
+	diags := ociTaskOperation.OciTaskTimeEntryRead(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestReadTaskTimeEntryOperationImport Failed: No Diagnostics expected")
+	assert.Equal(test, 1001, rd.Get("task_id"), "TestReadTaskTimeEntryOperationImport Failed: Task Id expected from Identifier")
+	assert.Equal(test, "2023-03-16", rd.Get("date"), "TestReadTaskTimeEntryOperationImport Failed: Wrong Time Entry Date")
+}
+
+func TestReadTaskTimeEntryOperationGone(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	ociTaskServClientMock.On("GetTaskTimeEntry", mock.Anything, mock.Anything, mock.Anything).Return(nil, &ocitaskclient.OciTaskServError{Operation: "GetTaskTimeEntry", StatusCode: http.StatusNotFound}).Once()
+
+	rd := MakeOciTaskResource().ResourceOciTaskTimeEntry().Data(nil)
+	rd.SetId("1001/4")
+
+	diags := ociTaskOperation.OciTaskTimeEntryRead(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 0, len(diags), "TestReadTaskTimeEntryOperationGone Failed: No Diagnostics expected")
+	assert.Equal(test, "", rd.Id(), "TestReadTaskTimeEntryOperationGone Failed: Time Entry expected to be removed from state")
+}
+
+func TestReadTaskTimeEntryOperationFailedBadId(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	rd := MakeOciTaskResource().ResourceOciTaskTimeEntry().Data(nil)
+	rd.SetId("1001")
+
+	diags := ociTaskOperation.OciTaskTimeEntryRead(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 1, len(diags), "TestReadTaskTimeEntryOperationFailedBadId Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Failed to get Id from resource data", diags[0].Summary, "TestReadTaskTimeEntryOperationFailedBadId Failed: Wrong Diagnostic Summary expected")
+}
+
+func TestDeleteTaskTimeEntryOperationFailedDelete(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	taskId, timeEntryId := int64(1001), int64(4)
+	ociTaskServClientMock.On("DeleteTaskTimeEntry", mock.Anything, &taskId, &timeEntryId).Return(nil, errors.New("Delete Time Entry Failed")).Once()
+
+	rd := MakeOciTaskResource().ResourceOciTaskTimeEntry().Data(nil)
+	rd.SetId("1001/4")
+
+	diags := ociTaskOperation.OciTaskTimeEntryDelete(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 1, len(diags), "TestDeleteTaskTimeEntryOperationFailedDelete Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Failed to delete task time entry", diags[0].Summary, "TestDeleteTaskTimeEntryOperationFailedDelete Failed: Wrong Diagnostic Summary expected")
+	assert.Equal(test, "1001/4", rd.Id(), "TestDeleteTaskTimeEntryOperationFailedDelete Failed: Time Entry expected to stay in state")
+}
+
+func TestReadTaskOperationRollsUpEffort(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	taskId, childId := int64(1001), int64(1002)
+	estimate, childEstimate, minutes := int64(120), int64(60), int64(150)
+	task := makeTestOciTask(taskId)
+	task.EstimateMinutes = &estimate
+	child := makeTestOciTask(childId)
+	child.EstimateMinutes = &childEstimate
+	child.ParentId = &taskId
+	timeEntry := ocitaskclient.OciTaskTimeEntry{Minutes: &minutes}
+
+	ociTaskServClientMock.On("GetTask", mock.Anything, &taskId).Return(&ocitaskclient.OciTaskServResponse{Task: &task}, nil).Once()
+	ociTaskServClientMock.On("ListChildTasks", mock.Anything, &taskId).Return(&ocitaskclient.OciTaskServResponse{Tasks: []ocitaskclient.OciTask{child}}, nil).Once()
+	ociTaskServClientMock.On("ListChildTasks", mock.Anything, &childId).Return(&ocitaskclient.OciTaskServResponse{}, nil).Once()
+	ociTaskServClientMock.On("ListTaskTimeEntries", mock.Anything, &taskId).Return(&ocitaskclient.OciTaskServResponse{}, nil).Once()
+	ociTaskServClientMock.On("ListTaskTimeEntries", mock.Anything, &childId).Return(&ocitaskclient.OciTaskServResponse{TimeEntries: []ocitaskclient.OciTaskTimeEntry{timeEntry}}, nil).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTask().Schema, map[string]interface{}{
+		"items": []interface{}{map[string]interface{}{"title": "Test Task 1", "estimate_minutes": 120}},
+	})
+	rd.SetId("1001")
+
+	diags := ociTaskOperation.OciTaskRead(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestReadTaskOperationRollsUpEffort Failed: No Diagnostics expected")
+	assert.Equal(test, 120, rd.Get("items.0.estimate_minutes"), "TestReadTaskOperationRollsUpEffort Failed: Own estimate expected")
+	assert.Equal(test, 150, rd.Get("items.0.logged_minutes"), "TestReadTaskOperationRollsUpEffort Failed: Minutes of subtasks expected to roll up")
+	assert.Equal(test, 30, rd.Get("items.0.remaining_minutes"), "TestReadTaskOperationRollsUpEffort Failed: Wrong remaining minutes")
+}
+
+func TestReadTaskOperationFailedEffort(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	taskId, estimate := int64(1001), int64(120)
+	task := makeTestOciTask(taskId)
+	task.EstimateMinutes = &estimate
+
+	ociTaskServClientMock.On("GetTask", mock.Anything, &taskId).Return(&ocitaskclient.OciTaskServResponse{Task: &task}, nil).Once()
+	ociTaskServClientMock.On("ListChildTasks", mock.Anything, &taskId).Return(nil, errors.New("List Child Tasks Failed")).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTask().Schema, map[string]interface{}{
+		"items": []interface{}{map[string]interface{}{"title": "Test Task 1", "estimate_minutes": 120, "logged_minutes": 45, "remaining_minutes": 75}},
+	})
+	rd.SetId("1001")
+
+	diags := ociTaskOperation.OciTaskRead(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 1, len(diags), "TestReadTaskOperationFailedEffort Failed: One Diagnostic instance expected")
+	assert.Equal(test, diag.Warning, diags[0].Severity, "TestReadTaskOperationFailedEffort Failed: Warning expected, refresh should not fail")
+	assert.Equal(test, "Failed to roll up task effort", diags[0].Summary, "TestReadTaskOperationFailedEffort Failed: Wrong Diagnostic Summary expected")
+	assert.Equal(test, "Test Task 1", rd.Get("items.0.title"), "TestReadTaskOperationFailedEffort Failed: Task expected to be read")
+	assert.Equal(test, 45, rd.Get("items.0.logged_minutes"), "TestReadTaskOperationFailedEffort Failed: Previous logged minutes expected")
+	assert.Equal(test, 75, rd.Get("items.0.remaining_minutes"), "TestReadTaskOperationFailedEffort Failed: Previous remaining minutes expected")
+}
+
+func TestReadTaskOperationSkipsEffortWithoutEstimate(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	taskId := int64(1001)
+	task := makeTestOciTask(taskId)
+
+	ociTaskServClientMock.On("GetTask", mock.Anything, &taskId).Return(&ocitaskclient.OciTaskServResponse{Task: &task}, nil).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTask().Schema, map[string]interface{}{
+		"items": []interface{}{map[string]interface{}{"title": "Test Task 1"}},
+	})
+	rd.SetId("1001")
+
+	diags := ociTaskOperation.OciTaskRead(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertNotCalled(test, "ListChildTasks", mock.Anything, mock.Anything)
+	ociTaskServClientMock.AssertNotCalled(test, "ListTaskTimeEntries", mock.Anything, mock.Anything)
+
+	assert.Equal(test, 0, len(diags), "TestReadTaskOperationSkipsEffortWithoutEstimate Failed: No Diagnostics expected")
+	assert.Equal(test, 0, rd.Get("items.0.logged_minutes"), "TestReadTaskOperationSkipsEffortWithoutEstimate Failed: No logged minutes expected")
+}
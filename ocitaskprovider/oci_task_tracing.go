@@ -0,0 +1,140 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+/**
+ * @brief Instrumentation name used for spans created by Terraform Provider
+ */
+const OciTaskProviderTracerName string = "ocitaskprovider"
+
+/**
+ * @brief Environment variable selecting trace exporter: otlp, stdout or none
+ */
+const OciTaskTracesExporterEnv string = "OTEL_TRACES_EXPORTER"
+
+/**
+ * @brief Environment variable with path of file to write spans to. Enables file exporter, usable offline.
+ */
+const OciTaskTracesFileEnv string = "OCITASK_TRACES_FILE"
+
+/**
+ * @brief Holds OpenTelemetry tracer provider set up for Terraform Provider
+ */
+type OciTaskTracing struct {
+	tracerProvider *sdktrace.TracerProvider
+	traceFile      *os.File
+}
+
+/**
+ * @brief Constructor for OciTaskTracing. Builds exporter selected by environment variables
+ *			and registers tracer provider globally. Tracing stays disabled if no exporter selected.
+ * @param ctx Context to Terraform Provider
+ * @return Instance of OciTaskTracing if succeeded
+ * @return Instance of error if failed
+ */
+func MakeOciTaskTracing(ctx context.Context) (*OciTaskTracing, error) {
+	ociTaskTracing := &OciTaskTracing{}
+
+	var exporter sdktrace.SpanExporter
+	var err error
+
+	exporterName := strings.ToLower(os.Getenv(OciTaskTracesExporterEnv))
+	traceFilePath := os.Getenv(OciTaskTracesFileEnv)
+
+	if traceFilePath != "" {
+		ociTaskTracing.traceFile, err = os.OpenFile(traceFilePath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to open trace file %s - error=%s", traceFilePath, err)
+		}
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(ociTaskTracing.traceFile))
+	} else if exporterName == "otlp" {
+		exporter, err = otlptracehttp.New(ctx)
+	} else if exporterName == "stdout" || exporterName == "console" {
+		exporter, err = stdouttrace.New(stdouttrace.WithPrettyPrint())
+	} else {
+		return ociTaskTracing, nil
+	}
+
+	if err != nil {
+		ociTaskTracing.Shutdown(ctx)
+		return nil, fmt.Errorf("Failed to create trace exporter - error=%s", err)
+	}
+
+	ociTaskResource := resource.NewSchemaless(attribute.String("service.name", "terraform-provider-ocitask"))
+
+	var processor sdktrace.TracerProviderOption
+	if exporterName == "otlp" && traceFilePath == "" {
+		processor = sdktrace.WithBatcher(exporter)
+	} else {
+		processor = sdktrace.WithSyncer(exporter)
+	}
+
+	ociTaskTracing.tracerProvider = sdktrace.NewTracerProvider(processor, sdktrace.WithResource(ociTaskResource))
+
+	otel.SetTracerProvider(ociTaskTracing.tracerProvider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	return ociTaskTracing, nil
+}
+
+/**
+ * @brief Flush pending spans and release exporter resources
+ * @param ctx Context for shutdown
+ * @return Instance of error if failed
+ */
+func (ociTaskTracing *OciTaskTracing) Shutdown(ctx context.Context) error {
+	var err error
+	if ociTaskTracing.tracerProvider != nil {
+		err = ociTaskTracing.tracerProvider.Shutdown(ctx)
+	}
+
+	if ociTaskTracing.traceFile != nil {
+		ociTaskTracing.traceFile.Close()
+	}
+
+	return err
+}
+
+/**
+ * @brief Start span for Terraform Provider operation
+ * @param ctx Context to Terraform Provider
+ * @param name Name of the operation
+ * @param resourceId Identifier of the resource, empty if not known yet
+ * @return Context carrying the new span
+ * @return Instance of trace.Span, caller must end it with endOciTaskSpan
+ */
+func startOciTaskSpan(ctx context.Context, name string, resourceId string) (context.Context, trace.Span) {
+	return otel.Tracer(OciTaskProviderTracerName).Start(ctx, name, trace.WithAttributes(attribute.String("ocitask.resource_id", resourceId)))
+}
+
+/**
+ * @brief Record diagnostics on span and end it
+ * @param span Span started by startOciTaskSpan
+ * @param diags Diagnostics returned by the operation
+ */
+func endOciTaskSpan(span trace.Span, diags diag.Diagnostics) {
+	for _, d := range diags {
+		if d.Severity == diag.Error {
+			span.SetStatus(codes.Error, d.Summary)
+			span.RecordError(fmt.Errorf("%s: %s", d.Summary, d.Detail))
+		}
+	}
+
+	span.End()
+}
@@ -0,0 +1,53 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMakeOciTaskTracingDisabled(test *testing.T) {
+	test.Setenv(OciTaskTracesExporterEnv, "")
+	test.Setenv(OciTaskTracesFileEnv, "")
+
+	ociTaskTracing, err := MakeOciTaskTracing(context.Background())
+
+	assert.NoError(test, err, "TestMakeOciTaskTracingDisabled Failed: No error expected")
+	assert.NotNil(test, ociTaskTracing, "TestMakeOciTaskTracingDisabled Failed: Valid tracing expected")
+	assert.Nil(test, ociTaskTracing.tracerProvider, "TestMakeOciTaskTracingDisabled Failed: Tracer provider not expected")
+	assert.NoError(test, ociTaskTracing.Shutdown(context.Background()), "TestMakeOciTaskTracingDisabled Failed: No error expected on shutdown")
+}
+
+func TestMakeOciTaskTracingFileExporter(test *testing.T) {
+	traceFilePath := filepath.Join(test.TempDir(), "traces.json")
+	test.Setenv(OciTaskTracesExporterEnv, "")
+	test.Setenv(OciTaskTracesFileEnv, traceFilePath)
+
+	ociTaskTracing, err := MakeOciTaskTracing(context.Background())
+
+	assert.NoError(test, err, "TestMakeOciTaskTracingFileExporter Failed: No error expected")
+	assert.NotNil(test, ociTaskTracing.tracerProvider, "TestMakeOciTaskTracingFileExporter Failed: Tracer provider expected")
+
+	_, span := startOciTaskSpan(context.Background(), "OciTaskRead", "1001")
+	endOciTaskSpan(span, nil)
+
+	assert.NoError(test, ociTaskTracing.Shutdown(context.Background()), "TestMakeOciTaskTracingFileExporter Failed: No error expected on shutdown")
+
+	data, err := os.ReadFile(traceFilePath)
+
+	assert.NoError(test, err, "TestMakeOciTaskTracingFileExporter Failed: Trace file expected")
+	assert.Contains(test, string(data), "OciTaskRead", "TestMakeOciTaskTracingFileExporter Failed: Span expected in trace file")
+	assert.Contains(test, string(data), "1001", "TestMakeOciTaskTracingFileExporter Failed: Resource Id expected in trace file")
+}
+
+func TestMakeOciTaskTracingBadFile(test *testing.T) {
+	test.Setenv(OciTaskTracesFileEnv, filepath.Join(test.TempDir(), "missing", "traces.json"))
+
+	ociTaskTracing, err := MakeOciTaskTracing(context.Background())
+
+	assert.Error(test, err, "TestMakeOciTaskTracingBadFile Failed: Error expected")
+	assert.Nil(test, ociTaskTracing, "TestMakeOciTaskTracingBadFile Failed: Tracing not expected")
+}
@@ -0,0 +1,134 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"fmt"
+	"ocitaskclient"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/**
+ * @brief Read Task and all its descendants in OCI Task System for Task tree data source
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains root Task Identifier and max depth defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskTreeRead(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	rootId := int64(rd.Get("root_id").(int))
+	maxDepth := rd.Get("max_depth").(int)
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskTreeRead", strconv.FormatInt(rootId, 10))
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+	treeNodes, err := ocitaskclient.ReadOciTaskTree(ctx, ociClient, rootId, maxDepth)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read task tree",
+			Detail:   err.Error(),
+		})
+	} else {
+		nodes := make([]interface{}, 0, len(treeNodes))
+		for _, treeNode := range treeNodes {
+			nodes = append(nodes, flattenOciTaskTreeNode(treeNode))
+		}
+
+		err := rd.Set("nodes", nodes)
+		if err != nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to set task tree into resource data",
+				Detail:   err.Error(),
+			})
+		} else {
+			rd.SetId(fmt.Sprintf("%d/%d", rootId, maxDepth))
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Convert node of Task tree into generic map for Terraform resource data
+ * @param treeNode Node of Task tree
+ * @return Generic map equivalent to node
+ */
+func flattenOciTaskTreeNode(treeNode ocitaskclient.OciTaskTreeNode) map[string]interface{} {
+	node := make(map[string]interface{})
+	node["id"] = 0
+	if treeNode.Task.Id != nil {
+		node["id"] = int(*treeNode.Task.Id)
+	}
+
+	node["parent_id"] = 0
+	if treeNode.Task.ParentId != nil {
+		node["parent_id"] = int(*treeNode.Task.ParentId)
+	}
+
+	node["title"] = ""
+	if treeNode.Task.Title != nil {
+		node["title"] = *treeNode.Task.Title
+	}
+
+	node["priority"] = 0
+	if treeNode.Task.Priority != nil {
+		node["priority"] = *treeNode.Task.Priority
+	}
+
+	node["status"] = treeNode.Task.GetStatus()
+	node["depth"] = treeNode.Depth
+
+	path := make([]interface{}, 0, len(treeNode.Path))
+	for _, taskId := range treeNode.Path {
+		path = append(path, int(taskId))
+	}
+	node["path"] = path
+
+	return node
+}
+
+/**
+ * @brief Check that Task being updated may move to its new parent without creating cycle
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains Task instance defined in Terraform scripts
+ * @param taskId Identifier of Task being updated
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Instance of error if move would create cycle
+ */
+func checkOciTaskReparent(ctx context.Context, rd *schema.ResourceData, taskId int64, m interface{}) error {
+	if !rd.HasChange("items.0.parent_id") {
+		return nil
+	}
+
+	parentId := int64(rd.Get("items.0.parent_id").(int))
+
+	return ocitaskclient.ValidateOciTaskParent(ctx, m.(ocitaskclient.OciTaskServClientInterface), taskId, parentId)
+}
+
+/**
+ * @brief Reject Task configured as its own parent at plan time. Deeper cycles are checked against OCI Task Service at apply time.
+ * @param ctx Context to Terraform Provider
+ * @param rdiff Planned changes of Task resource
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Instance of error if Task is its own parent
+ */
+func customizeOciTaskParent(ctx context.Context, rdiff *schema.ResourceDiff, m interface{}) error {
+	if rdiff.Id() == "" || !rdiff.HasChange("items.0.parent_id") {
+		return nil
+	}
+
+	parentId, _ := rdiff.Get("items.0.parent_id").(int)
+	if strconv.Itoa(parentId) == rdiff.Id() {
+		return fmt.Errorf("Task %s can't be its own parent", rdiff.Id())
+	}
+
+	return nil
+}
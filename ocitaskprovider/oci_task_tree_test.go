@@ -0,0 +1,109 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"errors"
+	"ocitaskclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReadTaskTreeOperationSuccess(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	root := makeTestOciTask(1001)
+	child := makeTestOciTask(1002)
+	child.ParentId = root.Id
+
+	ociTaskServClientMock.On("GetTask", mock.Anything, root.Id).Return(&ocitaskclient.OciTaskServResponse{Task: &root}, nil).Once()
+	ociTaskServClientMock.On("ListChildTasks", mock.Anything, root.Id).Return(&ocitaskclient.OciTaskServResponse{Tasks: []ocitaskclient.OciTask{child}}, nil).Once()
+
+	testData := make(map[string]interface{})
+	testData["root_id"] = 1001
+	testData["max_depth"] = 1
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskDataSource().DataSourceOciTaskTree().Schema, testData)
+
+	diags := ociTaskOperation.OciTaskTreeRead(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestReadTaskTreeOperationSuccess Failed: No Diagnostics expected")
+	assert.Equal(test, "1001/1", rd.Id(), "TestReadTaskTreeOperationSuccess Failed: Wrong data source Id")
+	assert.Equal(test, 2, rd.Get("nodes.#"), "TestReadTaskTreeOperationSuccess Failed: Two nodes expected")
+	assert.Equal(test, 1001, rd.Get("nodes.1.parent_id"), "TestReadTaskTreeOperationSuccess Failed: Wrong parent of child")
+	assert.Equal(test, 1, rd.Get("nodes.1.depth"), "TestReadTaskTreeOperationSuccess Failed: Wrong depth of child")
+	assert.Equal(test, []interface{}{1001, 1002}, rd.Get("nodes.1.path"), "TestReadTaskTreeOperationSuccess Failed: Wrong path of child")
+	assert.Equal(test, ocitaskclient.OciTaskStatusTodo, rd.Get("nodes.1.status"), "TestReadTaskTreeOperationSuccess Failed: Wrong status of child")
+}
+
+func TestReadTaskTreeOperationFailed(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	testData := make(map[string]interface{})
+	testData["root_id"] = 1001
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskDataSource().DataSourceOciTaskTree().Schema, testData)
+
+	ociTaskServClientMock.On("GetTask", mock.Anything, mock.Anything).Return(nil, errors.New("Get Task Failed")).Once()
+
+	diags := ociTaskOperation.OciTaskTreeRead(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 1, len(diags), "TestReadTaskTreeOperationFailed Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Failed to read task tree", diags[0].Summary, "TestReadTaskTreeOperationFailed Failed: Wrong Diagnostic Summary expected")
+}
+
+func TestUpdateTaskOperationFailedReparentCycle(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	parent := makeTestOciTask(1002)
+	taskId := int64(1001)
+	parent.ParentId = &taskId
+
+	srcTask := make(map[string]interface{})
+	srcTask["title"] = "Test Task 1"
+	srcTask["parent_id"] = 1002
+
+	testData := make(map[string]interface{})
+	testData["items"] = []interface{}{srcTask}
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTask().Schema, testData)
+	rd.SetId("1001")
+
+	ociTaskServClientMock.On("GetTask", mock.Anything, parent.Id).Return(&ocitaskclient.OciTaskServResponse{Task: &parent}, nil).Once()
+
+	diags := ociTaskOperation.OciTaskUpdate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+	ociTaskServClientMock.AssertNotCalled(test, "UpdateTask", mock.Anything, mock.Anything, mock.Anything)
+
+	assert.Equal(test, 1, len(diags), "TestUpdateTaskOperationFailedReparentCycle Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Invalid parent", diags[0].Summary, "TestUpdateTaskOperationFailedReparentCycle Failed: Wrong Diagnostic Summary expected")
+}
+
+func TestCustomizeDiffParentSelf(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+
+	resource := MakeOciTaskResource().ResourceOciTask()
+
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{
+				"title":     "Test Task 1",
+				"parent_id": 1001,
+			},
+		},
+	})
+
+	_, err := resource.Diff(context.Background(), makeTestOciTaskState("todo", "false"), config, &ociTaskServClientMock)
+
+	assert.Error(test, err, "TestCustomizeDiffParentSelf Failed: Error expected")
+	assert.Contains(test, err.Error(), "can't be its own parent", "TestCustomizeDiffParentSelf Failed: Wrong error")
+}
@@ -0,0 +1,163 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"fmt"
+	"ocitaskclient"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+/**
+ * @brief Look up user by e-mail address in OCI Task System for user data source
+ * @param ctx Context to Terraform Provider
+ * @param rd Contains e-mail address defined in Terraform scripts
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Collection of diag.Diagnostics instances if failed, otherwise empty
+ */
+func (ociTaskOperation *OciTaskOperation) OciTaskUserRead(ctx context.Context, rd *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// Warning or errors can be collected in a slice type
+	var diags diag.Diagnostics
+
+	email := rd.Get("email").(string)
+
+	ctx, span := startOciTaskSpan(ctx, "OciTaskUserRead", email)
+	defer func() { endOciTaskSpan(span, diags) }()
+
+	ociClient := m.(ocitaskclient.OciTaskServClientInterface)
+	ociResponse, err := ociClient.GetUserByEmail(ctx, email)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Error,
+			Summary:  "Failed to read user",
+			Detail:   err.Error(),
+		})
+	} else {
+		if ociResponse.Err != nil {
+			ociErr, _ := ociResponse.Err.Serialize()
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "Failed to read user",
+				Detail:   ociErr + ocitaskclient.FormatOciTaskRequestIds(ociResponse.ClientRequestId, ociResponse.ServerRequestId),
+			})
+		} else if ociResponse.User == nil {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Error,
+				Summary:  "User not found",
+				Detail:   fmt.Sprintf("No user with e-mail address %q", email),
+			})
+		} else {
+			user := ociResponse.User
+			values := map[string]interface{}{
+				"name":   "",
+				"active": false,
+			}
+			if user.Name != nil {
+				values["name"] = *user.Name
+			}
+			if user.Active != nil {
+				values["active"] = *user.Active
+			}
+
+			for key, value := range values {
+				err := rd.Set(key, value)
+				if err != nil {
+					diags = append(diags, diag.Diagnostic{
+						Severity: diag.Error,
+						Summary:  "Failed to set user into resource data",
+						Detail:   err.Error(),
+					})
+				}
+			}
+
+			if user.Id != nil {
+				rd.SetId(strconv.FormatInt(*user.Id, 10))
+			} else {
+				rd.SetId(ocitaskclient.NormalizeOciTaskUserEmail(email))
+			}
+		}
+	}
+
+	return diags
+}
+
+/**
+ * @brief Reject assignee or watchers of Task who are unknown to OCI Task Service at plan time.
+ *			Only users added by the plan are looked up.
+ * @param ctx Context to Terraform Provider
+ * @param rdiff Planned changes of Task resource
+ * @param m Contains OCI Task Service Client pluged into Terraform Provider
+ * @return Instance of error if user doesn't exist or can't be looked up
+ */
+func customizeOciTaskAssignee(ctx context.Context, rdiff *schema.ResourceDiff, m interface{}) error {
+	ociClient, ok := m.(ocitaskclient.OciTaskServClientInterface)
+	if !ok {
+		return nil
+	}
+
+	emails := make([]string, 0)
+	if rdiff.HasChange("items.0.assignee") && rdiff.NewValueKnown("items.0.assignee") {
+		assignee, _ := rdiff.Get("items.0.assignee").(string)
+		emails = append(emails, assignee)
+	}
+
+	if rdiff.HasChange("items.0.watchers") && rdiff.NewValueKnown("items.0.watchers") {
+		oldWatchers, newWatchers := rdiff.GetChange("items.0.watchers")
+		existing := make(map[string]bool)
+		for _, watcher := range ocitaskclient.NormalizeOciTaskUserEmails(ocitaskclient.ExpandOciTaskStringList(oldWatchers)) {
+			existing[watcher] = true
+		}
+		for _, watcher := range ocitaskclient.NormalizeOciTaskUserEmails(ocitaskclient.ExpandOciTaskStringList(newWatchers)) {
+			if !existing[watcher] {
+				emails = append(emails, watcher)
+			}
+		}
+	}
+
+	if len(emails) == 0 {
+		return nil
+	}
+
+	return ocitaskclient.ValidateOciTaskUsers(ctx, ociClient, emails)
+}
+
+/**
+ * @brief Build assignee to store in resource data. Keeps configured spelling if it names the same user.
+ * @param configured Assignee as configured
+ * @param assignee Assignee returned by OCI Task Service
+ * @return Assignee to store in resource data
+ */
+func reconcileOciTaskAssignee(configured string, assignee string) string {
+	if strings.EqualFold(strings.TrimSpace(configured), assignee) {
+		return configured
+	}
+
+	return assignee
+}
+
+/**
+ * @brief Build watchers to store in resource data. Keeps configured spelling of each watcher returned by OCI Task Service.
+ * @param configured Watchers as configured
+ * @param watchers Watchers returned by OCI Task Service
+ * @return Watchers to store in resource data
+ */
+func reconcileOciTaskWatchers(configured []string, watchers []string) []interface{} {
+	spelling := make(map[string]string)
+	for _, watcher := range configured {
+		spelling[ocitaskclient.NormalizeOciTaskUserEmail(watcher)] = watcher
+	}
+
+	result := make([]interface{}, 0, len(watchers))
+	for _, watcher := range ocitaskclient.NormalizeOciTaskUserEmails(watchers) {
+		if configuredWatcher, ok := spelling[watcher]; ok {
+			result = append(result, configuredWatcher)
+		} else {
+			result = append(result, watcher)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,150 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"ocitaskclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func makeTestOciTaskUserResponse(email string) *ocitaskclient.OciTaskServResponse {
+	userId := int64(7)
+	name := "John Doe"
+	active := true
+
+	return &ocitaskclient.OciTaskServResponse{User: &ocitaskclient.OciTaskUser{Id: &userId, Email: &email, Name: &name, Active: &active}}
+}
+
+func TestReadUserOperationSuccess(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	ociTaskServClientMock.On("GetUserByEmail", mock.Anything, "JDoe@example.com").Return(makeTestOciTaskUserResponse("jdoe@example.com"), nil).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskDataSource().DataSourceOciTaskUser().Schema, map[string]interface{}{"email": "JDoe@example.com"})
+
+	diags := ociTaskOperation.OciTaskUserRead(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestReadUserOperationSuccess Failed: No Diagnostics expected")
+	assert.Equal(test, "7", rd.Id(), "TestReadUserOperationSuccess Failed: Wrong data source Id")
+	assert.Equal(test, "John Doe", rd.Get("name"), "TestReadUserOperationSuccess Failed: Wrong name")
+	assert.Equal(test, true, rd.Get("active"), "TestReadUserOperationSuccess Failed: User should be active")
+}
+
+func TestReadUserOperationNotFound(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	ociTaskServClientMock.On("GetUserByEmail", mock.Anything, mock.Anything).Return(&ocitaskclient.OciTaskServResponse{}, nil).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskDataSource().DataSourceOciTaskUser().Schema, map[string]interface{}{"email": "ghost@example.com"})
+
+	diags := ociTaskOperation.OciTaskUserRead(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 1, len(diags), "TestReadUserOperationNotFound Failed: One Diagnostic instance expected")
+	assert.Equal(test, "User not found", diags[0].Summary, "TestReadUserOperationNotFound Failed: Wrong Diagnostic Summary expected")
+}
+
+func TestCustomizeDiffAssigneeUnknown(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+
+	ociTaskServClientMock.On("GetUserByEmail", mock.Anything, "ghost@example.com").Return(&ocitaskclient.OciTaskServResponse{}, nil).Once()
+
+	resource := MakeOciTaskResource().ResourceOciTask()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{
+				"title":    "Test Task 1",
+				"status":   "todo",
+				"assignee": "ghost@example.com",
+			},
+		},
+	})
+
+	_, err := resource.Diff(context.Background(), makeTestOciTaskState("todo", "false"), config, &ociTaskServClientMock)
+
+	assert.Error(test, err, "TestCustomizeDiffAssigneeUnknown Failed: Error expected")
+	assert.Contains(test, err.Error(), "not found", "TestCustomizeDiffAssigneeUnknown Failed: Wrong error")
+}
+
+func TestCustomizeDiffWatchersOnlyNewLookedUp(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+
+	ociTaskServClientMock.On("GetUserByEmail", mock.Anything, "new@example.com").Return(makeTestOciTaskUserResponse("new@example.com"), nil).Once()
+
+	state := makeTestOciTaskState("todo", "false")
+	state.Attributes["items.0.watchers.#"] = "1"
+	state.Attributes["items.0.watchers.1234"] = "old@example.com"
+
+	resource := MakeOciTaskResource().ResourceOciTask()
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{
+				"title":    "Test Task 1",
+				"status":   "todo",
+				"watchers": []interface{}{"old@example.com", "new@example.com"},
+			},
+		},
+	})
+
+	_, err := resource.Diff(context.Background(), state, config, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.NoError(test, err, "TestCustomizeDiffWatchersOnlyNewLookedUp Failed: No error expected")
+}
+
+func TestReadTaskOperationKeepsConfiguredAssigneeCase(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	assignee := "jdoe@example.com"
+	task := makeTestOciTask(1001)
+	task.Assignee = &assignee
+	task.Watchers = []string{"asmith@example.com", "bob@example.com"}
+
+	srcTask := map[string]interface{}{
+		"title":    *task.Title,
+		"assignee": "JDoe@Example.com",
+		"watchers": []interface{}{"ASmith@example.com"},
+	}
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTask().Schema, map[string]interface{}{"items": []interface{}{srcTask}})
+	rd.SetId("1001")
+
+	ociTaskServClientMock.On("GetTask", mock.Anything, task.Id).Return(&ocitaskclient.OciTaskServResponse{Task: &task}, nil).Once()
+
+	diags := ociTaskOperation.OciTaskRead(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 0, len(diags), "TestReadTaskOperationKeepsConfiguredAssigneeCase Failed: No Diagnostics expected")
+	assert.Equal(test, "JDoe@Example.com", rd.Get("items.0.assignee"), "TestReadTaskOperationKeepsConfiguredAssigneeCase Failed: Configured assignee casing expected")
+	assert.ElementsMatch(test, []interface{}{"ASmith@example.com", "bob@example.com"}, rd.Get("items.0.watchers").(*schema.Set).List(), "TestReadTaskOperationKeepsConfiguredAssigneeCase Failed: Wrong watchers")
+}
+
+func TestReadTasksOperationByAssignee(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	assignee := "jdoe@example.com"
+	task := makeTestOciTask(1001)
+	task.Assignee = &assignee
+
+	ociTaskServClientMock.On("ListTasks", mock.Anything, mock.MatchedBy(func(filter *ocitaskclient.OciTaskListFilter) bool {
+		return filter.Assignee == assignee
+	})).Return(&ocitaskclient.OciTaskServResponse{Tasks: []ocitaskclient.OciTask{task}}, nil).Once()
+
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskDataSource().DataSourceOciTasks().Schema, map[string]interface{}{"assignee": assignee})
+
+	diags := ociTaskOperation.OciTasksRead(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestReadTasksOperationByAssignee Failed: No Diagnostics expected")
+	assert.Equal(test, assignee, rd.Get("items.0.assignee"), "TestReadTasksOperationByAssignee Failed: Wrong assignee")
+}
@@ -0,0 +1,208 @@
+package ocitaskprovider
+
+import (
+	"fmt"
+	"ocitaskclient"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+)
+
+/**
+ * @brief Validate tags configured on Task. Rejects keys which collide after normalisation.
+ * @param i Tags configured in Terraform scripts
+ * @param path Path to tags attribute
+ * @return Collection of diag.Diagnostics instances if invalid, otherwise empty
+ */
+func validateOciTaskTags(i interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	_, err := ocitaskclient.NormalizeOciTaskTags(ocitaskclient.ExpandOciTaskStringMap(i))
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid tags",
+			Detail:        err.Error(),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+/**
+ * @brief Validate format of e-mail address identifying user. Existence of user is checked at plan time.
+ * @param i E-mail address configured in Terraform scripts
+ * @param path Path to attribute
+ * @return Collection of diag.Diagnostics instances if invalid, otherwise empty
+ */
+func validateOciTaskUserEmail(i interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	email, _ := i.(string)
+	if email == "" {
+		return diags
+	}
+
+	err := ocitaskclient.ValidateOciTaskUserEmail(email)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid user",
+			Detail:        err.Error(),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+/**
+ * @brief Validate recurrence rule in RFC 5545 RRULE format
+ * @param i Recurrence rule configured in Terraform scripts
+ * @param path Path to attribute
+ * @return Collection of diag.Diagnostics instances if invalid, otherwise empty
+ */
+func validateOciTaskRecurrenceRule(i interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	rule, _ := i.(string)
+	_, err := ocitaskclient.ParseOciTaskRecurrenceRule(rule)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid recurrence rule",
+			Detail:        err.Error(),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+/**
+ * @brief Validate date or time of recurrence, e.g. start or excluded occurrence
+ * @param i Date or time configured in Terraform scripts
+ * @param path Path to attribute
+ * @return Collection of diag.Diagnostics instances if invalid, otherwise empty
+ */
+func validateOciTaskRecurrenceTime(i interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	value, _ := i.(string)
+	_, _, err := ocitaskclient.ParseOciTaskRecurrenceTime(value, time.UTC)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid time",
+			Detail:        err.Error(),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+/**
+ * @brief Validate IANA time zone name, e.g. Europe/Berlin
+ * @param i Time zone configured in Terraform scripts
+ * @param path Path to attribute
+ * @return Collection of diag.Diagnostics instances if invalid, otherwise empty
+ */
+func validateOciTaskTimeZone(i interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	timeZone, _ := i.(string)
+	_, err := time.LoadLocation(timeZone)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid time zone",
+			Detail:        err.Error(),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+/**
+ * @brief Validate duration in Go format, e.g. 72h or 90m
+ * @param i Duration configured in Terraform scripts
+ * @param path Path to attribute
+ * @return Collection of diag.Diagnostics instances if invalid, otherwise empty
+ */
+func validateOciTaskDuration(i interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	value, _ := i.(string)
+	if value == "" {
+		return diags
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err == nil && duration < 0 {
+		err = fmt.Errorf("Duration %q must not be negative", value)
+	}
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid duration",
+			Detail:        err.Error(),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+/**
+ * @brief Validate cron expression of reminder, e.g. 0 9 * * MON-FRI or @daily
+ * @param i Cron expression configured in Terraform scripts
+ * @param path Path to attribute
+ * @return Collection of diag.Diagnostics instances if invalid, otherwise empty
+ */
+func validateOciTaskCron(i interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	expression, _ := i.(string)
+	if expression == "" {
+		return diags
+	}
+
+	_, err := ocitaskclient.ParseOciTaskCron(expression)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid cron expression",
+			Detail:        err.Error(),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
+
+/**
+ * @brief Validate calendar date, e.g. 2023-03-15
+ * @param i Date configured in Terraform scripts
+ * @param path Path to attribute
+ * @return Collection of diag.Diagnostics instances if invalid, otherwise empty
+ */
+func validateOciTaskDate(i interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	value, _ := i.(string)
+	_, err := time.Parse(ocitaskclient.OciTaskTimeEntryDateFormat, value)
+	if err != nil {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Error,
+			Summary:       "Invalid date",
+			Detail:        fmt.Sprintf("Date %q must be in YYYY-MM-DD format", value),
+			AttributePath: path,
+		})
+	}
+
+	return diags
+}
@@ -0,0 +1,56 @@
+package ocitaskprovider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateOciTaskTagsSuccess(test *testing.T) {
+	diags := validateOciTaskTags(map[string]interface{}{"Team": "Platform", "sprint": "42"}, cty.GetAttrPath("tags"))
+
+	assert.Equal(test, 0, len(diags), "TestValidateOciTaskTagsSuccess Failed: No Diagnostics expected")
+}
+
+func TestValidateOciTaskTagsFailedDuplicate(test *testing.T) {
+	diags := validateOciTaskTags(map[string]interface{}{"Team": "Platform", "team": "Storage"}, cty.GetAttrPath("tags"))
+
+	assert.Equal(test, 1, len(diags), "TestValidateOciTaskTagsFailedDuplicate Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Invalid tags", diags[0].Summary, "TestValidateOciTaskTagsFailedDuplicate Failed: Wrong Diagnostic Summary expected")
+}
+
+func TestValidateOciTaskRecurrenceRuleFailed(test *testing.T) {
+	diags := validateOciTaskRecurrenceRule("FREQ=MONTHLY;BYSETPOS=-1", cty.GetAttrPath("rrule"))
+
+	assert.Equal(test, 1, len(diags), "TestValidateOciTaskRecurrenceRuleFailed Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Invalid recurrence rule", diags[0].Summary, "TestValidateOciTaskRecurrenceRuleFailed Failed: Wrong Diagnostic Summary expected")
+}
+
+func TestValidateOciTaskTimeZoneFailed(test *testing.T) {
+	diags := validateOciTaskTimeZone("Mars/Olympus_Mons", cty.GetAttrPath("timezone"))
+
+	assert.Equal(test, 1, len(diags), "TestValidateOciTaskTimeZoneFailed Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Invalid time zone", diags[0].Summary, "TestValidateOciTaskTimeZoneFailed Failed: Wrong Diagnostic Summary expected")
+}
+
+func TestValidateOciTaskDurationFailed(test *testing.T) {
+	diags := validateOciTaskDuration("-1h", cty.GetAttrPath("due_after"))
+
+	assert.Equal(test, 1, len(diags), "TestValidateOciTaskDurationFailed Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Invalid duration", diags[0].Summary, "TestValidateOciTaskDurationFailed Failed: Wrong Diagnostic Summary expected")
+}
+
+func TestValidateOciTaskCronFailed(test *testing.T) {
+	diags := validateOciTaskCron("0 25 * * *", cty.GetAttrPath("cron"))
+
+	assert.Equal(test, 1, len(diags), "TestValidateOciTaskCronFailed Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Invalid cron expression", diags[0].Summary, "TestValidateOciTaskCronFailed Failed: Wrong Diagnostic Summary expected")
+}
+
+func TestValidateOciTaskDateFailed(test *testing.T) {
+	diags := validateOciTaskDate("15/03/2023", cty.GetAttrPath("date"))
+
+	assert.Equal(test, 1, len(diags), "TestValidateOciTaskDateFailed Failed: One Diagnostic instance expected")
+	assert.Equal(test, "Invalid date", diags[0].Summary, "TestValidateOciTaskDateFailed Failed: Wrong Diagnostic Summary expected")
+}
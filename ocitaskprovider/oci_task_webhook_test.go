@@ -0,0 +1,113 @@
+package ocitaskprovider
+
+import (
+	"context"
+	"net/http"
+	"ocitaskclient"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func makeTestOciTaskWebhook(id int64) ocitaskclient.OciTaskWebhook {
+	url, active := "https://hooks.example.com/tasks", true
+	return ocitaskclient.OciTaskWebhook{Id: &id, Url: &url, Events: []string{ocitaskclient.OciTaskWebhookEventTaskCreated, ocitaskclient.OciTaskWebhookEventTaskUpdated}, Active: &active}
+}
+
+func TestCreateWebhookOperationSuccess(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	webhook := makeTestOciTaskWebhook(5)
+
+	ociTaskServClientMock.On("CreateWebhook", mock.Anything, mock.MatchedBy(func(request *ocitaskclient.OciTaskWebhook) bool {
+		return *request.Url == *webhook.Url && request.Secret != nil && *request.Secret == "0123456789abcdef" && *request.Active &&
+			assert.ObjectsAreEqual([]string{"task.created", "task.updated"}, request.Events)
+	})).Return(&ocitaskclient.OciTaskServResponse{Webhook: &webhook}, nil).Once()
+	ociTaskServClientMock.On("GetWebhook", mock.Anything, webhook.Id).Return(&ocitaskclient.OciTaskServResponse{Webhook: &webhook}, nil).Once()
+
+	testData := map[string]interface{}{"url": *webhook.Url, "events": []interface{}{"task.updated", "task.created"}, "secret": "0123456789abcdef"}
+	rd := schema.TestResourceDataRaw(test, MakeOciTaskResource().ResourceOciTaskWebhook().Schema, testData)
+
+	diags := ociTaskOperation.OciTaskWebhookCreate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestCreateWebhookOperationSuccess Failed: No Diagnostics expected")
+	assert.Equal(test, "5", rd.Id(), "TestCreateWebhookOperationSuccess Failed: Wrong resource Id")
+	assert.Equal(test, "0123456789abcdef", rd.Get("secret"), "TestCreateWebhookOperationSuccess Failed: Configured secret should be kept")
+}
+
+func TestUpdateWebhookOperationKeepsSecret(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	webhook := makeTestOciTaskWebhook(5)
+
+	ociTaskServClientMock.On("UpdateWebhook", mock.Anything, webhook.Id, mock.MatchedBy(func(request *ocitaskclient.OciTaskWebhook) bool {
+		return request.Secret == nil
+	})).Return(&ocitaskclient.OciTaskServResponse{Webhook: &webhook}, nil).Once()
+	ociTaskServClientMock.On("GetWebhook", mock.Anything, webhook.Id).Return(&ocitaskclient.OciTaskServResponse{Webhook: &webhook}, nil).Once()
+
+	state := &terraform.InstanceState{
+		ID: "5",
+		Attributes: map[string]string{
+			"id":       "5",
+			"url":      *webhook.Url,
+			"events.#": "1",
+			"events.0": "task.created",
+			"secret":   "0123456789abcdef",
+			"active":   "true",
+		},
+	}
+	rd := MakeOciTaskResource().ResourceOciTaskWebhook().Data(state)
+
+	diags := ociTaskOperation.OciTaskWebhookUpdate(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestUpdateWebhookOperationKeepsSecret Failed: No Diagnostics expected")
+	assert.Equal(test, 2, rd.Get("events").(*schema.Set).Len(), "TestUpdateWebhookOperationKeepsSecret Failed: Events should be read back")
+}
+
+func TestReadWebhookOperationGone(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	ociTaskServClientMock.On("GetWebhook", mock.Anything, mock.Anything).Return(nil, &ocitaskclient.OciTaskServError{Operation: "GetWebhook", StatusCode: http.StatusNotFound}).Once()
+
+	rd := MakeOciTaskResource().ResourceOciTaskWebhook().Data(nil)
+	rd.SetId("5")
+
+	diags := ociTaskOperation.OciTaskWebhookRead(context.Background(), rd, &ociTaskServClientMock)
+
+	assert.Equal(test, 0, len(diags), "TestReadWebhookOperationGone Failed: No Diagnostics expected")
+	assert.Equal(test, "", rd.Id(), "TestReadWebhookOperationGone Failed: Removed webhook should leave state")
+}
+
+func TestDeleteWebhookOperationSuccess(test *testing.T) {
+	ociTaskServClientMock := ocitaskclient.OciTaskServClientMock{}
+	ociTaskOperation := OciTaskOperation{}
+
+	webhookId := int64(5)
+	ociTaskServClientMock.On("DeleteWebhook", mock.Anything, &webhookId).Return(&ocitaskclient.OciTaskServResponse{}, nil).Once()
+
+	rd := MakeOciTaskResource().ResourceOciTaskWebhook().Data(nil)
+	rd.SetId("5")
+
+	diags := ociTaskOperation.OciTaskWebhookDelete(context.Background(), rd, &ociTaskServClientMock)
+
+	ociTaskServClientMock.AssertExpectations(test)
+
+	assert.Equal(test, 0, len(diags), "TestDeleteWebhookOperationSuccess Failed: No Diagnostics expected")
+	assert.Equal(test, "", rd.Id(), "TestDeleteWebhookOperationSuccess Failed: Id should be cleared")
+}
+
+func TestResourceWebhookSecretSensitive(test *testing.T) {
+	resource := MakeOciTaskResource().ResourceOciTaskWebhook()
+
+	assert.True(test, resource.Schema["secret"].Sensitive, "TestResourceWebhookSecretSensitive Failed: Secret should be sensitive")
+}